@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/apparentlymart/terraform-provider/tfprovider"
+	"github.com/apparentlymart/terraform-provider/tfprovider/diagtext"
 )
 
 func main() {
@@ -45,16 +46,8 @@ func main() {
 }
 
 func showDiagnosticsMaybeExit(diags tfprovider.Diagnostics, provider tfprovider.Provider) {
-	for _, diag := range diags {
-		switch diag.Severity {
-		case tfprovider.Error:
-			fmt.Fprintf(os.Stderr, "Error: %s; %s", diag.Summary, diag.Detail)
-		case tfprovider.Warning:
-			fmt.Fprintf(os.Stderr, "Warning: %s; %s", diag.Summary, diag.Detail)
-		default:
-			fmt.Fprintf(os.Stderr, "???: %s; %s", diag.Summary, diag.Detail)
-		}
-	}
+	renderer := &diagtext.Renderer{Width: 78}
+	renderer.Render(os.Stderr, diags)
 	if diags.HasErrors() {
 		provider.Close()
 		os.Exit(1)
@@ -1,11 +1,12 @@
 module github.com/apparentlymart/terraform-provider
 
-go 1.12
+go 1.13
 
 require (
 	github.com/apparentlymart/terraform-schema-go v0.0.0-20190818171348-d92f0176cd4b
-	github.com/golang/protobuf v1.3.2
-	github.com/zclconf/go-cty v1.1.0
+	github.com/golang/protobuf v1.3.4
+	github.com/hashicorp/hcl2 v0.0.0-20190809210004-72d32879a5c5
+	github.com/zclconf/go-cty v1.8.4
 	go.rpcplugin.org/rpcplugin v0.1.0
 	google.golang.org/grpc v1.23.0
 )
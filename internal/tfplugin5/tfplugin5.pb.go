@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -111,7 +112,45 @@ func (x Schema_NestedBlock_NestingMode) String() string {
 }
 
 func (Schema_NestedBlock_NestingMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{5, 2, 0}
+	return fileDescriptor_17ae6090ff270234, []int{6, 2, 0}
+}
+
+// Reason is the reason for deferring the change.
+type Deferred_Reason int32
+
+const (
+	// UNKNOWN is the default value, and should not be used.
+	Deferred_UNKNOWN Deferred_Reason = 0
+	// RESOURCE_CONFIG_UNKNOWN is used when the config is partially unknown and the real
+	// values need to be known before the change can be planned.
+	Deferred_RESOURCE_CONFIG_UNKNOWN Deferred_Reason = 1
+	// PROVIDER_CONFIG_UNKNOWN is used when parts of the provider configuration
+	// are unknown, e.g. the provider configuration is only known after the apply is done.
+	Deferred_PROVIDER_CONFIG_UNKNOWN Deferred_Reason = 2
+	// ABSENT_PREREQ is used when a hard dependency has not been satisfied.
+	Deferred_ABSENT_PREREQ Deferred_Reason = 3
+)
+
+var Deferred_Reason_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "RESOURCE_CONFIG_UNKNOWN",
+	2: "PROVIDER_CONFIG_UNKNOWN",
+	3: "ABSENT_PREREQ",
+}
+
+var Deferred_Reason_value = map[string]int32{
+	"UNKNOWN":                 0,
+	"RESOURCE_CONFIG_UNKNOWN": 1,
+	"PROVIDER_CONFIG_UNKNOWN": 2,
+	"ABSENT_PREREQ":           3,
+}
+
+func (x Deferred_Reason) String() string {
+	return proto.EnumName(Deferred_Reason_name, int32(x))
+}
+
+func (Deferred_Reason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{13, 0}
 }
 
 // DynamicValue is an opaque encoding of terraform data, with the field name
@@ -226,6 +265,79 @@ func (m *Diagnostic) GetAttribute() *AttributePath {
 	return nil
 }
 
+type FunctionError struct {
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// Types that are valid to be assigned to XFunctionArgument:
+	//	*FunctionError_FunctionArgument
+	XFunctionArgument    isFunctionError_XFunctionArgument `protobuf_oneof:"_function_argument"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *FunctionError) Reset()         { *m = FunctionError{} }
+func (m *FunctionError) String() string { return proto.CompactTextString(m) }
+func (*FunctionError) ProtoMessage()    {}
+func (*FunctionError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{2}
+}
+
+func (m *FunctionError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FunctionError.Unmarshal(m, b)
+}
+func (m *FunctionError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FunctionError.Marshal(b, m, deterministic)
+}
+func (m *FunctionError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionError.Merge(m, src)
+}
+func (m *FunctionError) XXX_Size() int {
+	return xxx_messageInfo_FunctionError.Size(m)
+}
+func (m *FunctionError) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FunctionError proto.InternalMessageInfo
+
+func (m *FunctionError) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+type isFunctionError_XFunctionArgument interface {
+	isFunctionError_XFunctionArgument()
+}
+
+type FunctionError_FunctionArgument struct {
+	FunctionArgument int64 `protobuf:"varint,2,opt,name=function_argument,json=functionArgument,proto3,oneof"`
+}
+
+func (*FunctionError_FunctionArgument) isFunctionError_XFunctionArgument() {}
+
+func (m *FunctionError) GetXFunctionArgument() isFunctionError_XFunctionArgument {
+	if m != nil {
+		return m.XFunctionArgument
+	}
+	return nil
+}
+
+func (m *FunctionError) GetFunctionArgument() int64 {
+	if x, ok := m.GetXFunctionArgument().(*FunctionError_FunctionArgument); ok {
+		return x.FunctionArgument
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*FunctionError) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*FunctionError_FunctionArgument)(nil),
+	}
+}
+
 type AttributePath struct {
 	Steps                []*AttributePath_Step `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -237,7 +349,7 @@ func (m *AttributePath) Reset()         { *m = AttributePath{} }
 func (m *AttributePath) String() string { return proto.CompactTextString(m) }
 func (*AttributePath) ProtoMessage()    {}
 func (*AttributePath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{2}
+	return fileDescriptor_17ae6090ff270234, []int{3}
 }
 
 func (m *AttributePath) XXX_Unmarshal(b []byte) error {
@@ -280,7 +392,7 @@ func (m *AttributePath_Step) Reset()         { *m = AttributePath_Step{} }
 func (m *AttributePath_Step) String() string { return proto.CompactTextString(m) }
 func (*AttributePath_Step) ProtoMessage()    {}
 func (*AttributePath_Step) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{2, 0}
+	return fileDescriptor_17ae6090ff270234, []int{3, 0}
 }
 
 func (m *AttributePath_Step) XXX_Unmarshal(b []byte) error {
@@ -370,7 +482,7 @@ func (m *Stop) Reset()         { *m = Stop{} }
 func (m *Stop) String() string { return proto.CompactTextString(m) }
 func (*Stop) ProtoMessage()    {}
 func (*Stop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{3}
+	return fileDescriptor_17ae6090ff270234, []int{4}
 }
 
 func (m *Stop) XXX_Unmarshal(b []byte) error {
@@ -401,7 +513,7 @@ func (m *Stop_Request) Reset()         { *m = Stop_Request{} }
 func (m *Stop_Request) String() string { return proto.CompactTextString(m) }
 func (*Stop_Request) ProtoMessage()    {}
 func (*Stop_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{3, 0}
+	return fileDescriptor_17ae6090ff270234, []int{4, 0}
 }
 
 func (m *Stop_Request) XXX_Unmarshal(b []byte) error {
@@ -433,7 +545,7 @@ func (m *Stop_Response) Reset()         { *m = Stop_Response{} }
 func (m *Stop_Response) String() string { return proto.CompactTextString(m) }
 func (*Stop_Response) ProtoMessage()    {}
 func (*Stop_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{3, 1}
+	return fileDescriptor_17ae6090ff270234, []int{4, 1}
 }
 
 func (m *Stop_Response) XXX_Unmarshal(b []byte) error {
@@ -476,7 +588,7 @@ func (m *RawState) Reset()         { *m = RawState{} }
 func (m *RawState) String() string { return proto.CompactTextString(m) }
 func (*RawState) ProtoMessage()    {}
 func (*RawState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{4}
+	return fileDescriptor_17ae6090ff270234, []int{5}
 }
 
 func (m *RawState) XXX_Unmarshal(b []byte) error {
@@ -528,7 +640,7 @@ func (m *Schema) Reset()         { *m = Schema{} }
 func (m *Schema) String() string { return proto.CompactTextString(m) }
 func (*Schema) ProtoMessage()    {}
 func (*Schema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{5}
+	return fileDescriptor_17ae6090ff270234, []int{6}
 }
 
 func (m *Schema) XXX_Unmarshal(b []byte) error {
@@ -579,7 +691,7 @@ func (m *Schema_Block) Reset()         { *m = Schema_Block{} }
 func (m *Schema_Block) String() string { return proto.CompactTextString(m) }
 func (*Schema_Block) ProtoMessage()    {}
 func (*Schema_Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{5, 0}
+	return fileDescriptor_17ae6090ff270234, []int{6, 0}
 }
 
 func (m *Schema_Block) XXX_Unmarshal(b []byte) error {
@@ -643,25 +755,30 @@ func (m *Schema_Block) GetDeprecated() bool {
 }
 
 type Schema_Attribute struct {
-	Name                 string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type                 []byte     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Description          string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Required             bool       `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
-	Optional             bool       `protobuf:"varint,5,opt,name=optional,proto3" json:"optional,omitempty"`
-	Computed             bool       `protobuf:"varint,6,opt,name=computed,proto3" json:"computed,omitempty"`
-	Sensitive            bool       `protobuf:"varint,7,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
-	DescriptionKind      StringKind `protobuf:"varint,8,opt,name=description_kind,json=descriptionKind,proto3,enum=tfplugin5.StringKind" json:"description_kind,omitempty"`
-	Deprecated           bool       `protobuf:"varint,9,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Name            string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type            []byte     `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description     string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Required        bool       `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Optional        bool       `protobuf:"varint,5,opt,name=optional,proto3" json:"optional,omitempty"`
+	Computed        bool       `protobuf:"varint,6,opt,name=computed,proto3" json:"computed,omitempty"`
+	Sensitive       bool       `protobuf:"varint,7,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	DescriptionKind StringKind `protobuf:"varint,8,opt,name=description_kind,json=descriptionKind,proto3,enum=tfplugin5.StringKind" json:"description_kind,omitempty"`
+	Deprecated      bool       `protobuf:"varint,9,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// write_only indicates that the attribute value will be provided via
+	// configuration and must be omitted from state. write_only must be
+	// combined with optional or required, and is only valid for managed
+	// resource schemas.
+	WriteOnly            bool     `protobuf:"varint,10,opt,name=write_only,json=writeOnly,proto3" json:"write_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Schema_Attribute) Reset()         { *m = Schema_Attribute{} }
 func (m *Schema_Attribute) String() string { return proto.CompactTextString(m) }
 func (*Schema_Attribute) ProtoMessage()    {}
 func (*Schema_Attribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{5, 1}
+	return fileDescriptor_17ae6090ff270234, []int{6, 1}
 }
 
 func (m *Schema_Attribute) XXX_Unmarshal(b []byte) error {
@@ -745,6 +862,13 @@ func (m *Schema_Attribute) GetDeprecated() bool {
 	return false
 }
 
+func (m *Schema_Attribute) GetWriteOnly() bool {
+	if m != nil {
+		return m.WriteOnly
+	}
+	return false
+}
+
 type Schema_NestedBlock struct {
 	TypeName             string                         `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	Block                *Schema_Block                  `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
@@ -760,7 +884,7 @@ func (m *Schema_NestedBlock) Reset()         { *m = Schema_NestedBlock{} }
 func (m *Schema_NestedBlock) String() string { return proto.CompactTextString(m) }
 func (*Schema_NestedBlock) ProtoMessage()    {}
 func (*Schema_NestedBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{5, 2}
+	return fileDescriptor_17ae6090ff270234, []int{6, 2}
 }
 
 func (m *Schema_NestedBlock) XXX_Unmarshal(b []byte) error {
@@ -816,433 +940,3638 @@ func (m *Schema_NestedBlock) GetMaxItems() int64 {
 	return 0
 }
 
-type GetProviderSchema struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetProviderSchema) Reset()         { *m = GetProviderSchema{} }
-func (m *GetProviderSchema) String() string { return proto.CompactTextString(m) }
-func (*GetProviderSchema) ProtoMessage()    {}
-func (*GetProviderSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{6}
+// ResourceIdentitySchema represents the structure and types of data used to identify
+// a managed resource type. Effectively, resource identity is a versioned object
+// that can be used to compare resources, whether already managed and/or being
+// discovered.
+type ResourceIdentitySchema struct {
+	// version is the identity version and separate from the Schema version.
+	// Any time the structure or format of identity_attributes changes, this version
+	// should be incremented. Versioning implicitly starts at 0 and by convention
+	// should be incremented by 1 each change.
+	//
+	// When comparing identity_attributes data, differing versions should always be treated
+	// as inequal.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// identity_attributes are the individual value definitions which define identity data
+	// for a managed resource type. This information is used to decode DynamicValue of
+	// identity data.
+	//
+	// These attributes are intended for permanent identity data and must be wholly
+	// representative of all data necessary to compare two managed resource instances
+	// with no other data. This generally should include account, endpoint, location,
+	// and automatically generated identifiers. For some resources, this may include
+	// configuration-based data, such as a required name which must be unique.
+	IdentityAttributes   []*ResourceIdentitySchema_IdentityAttribute `protobuf:"bytes,2,rep,name=identity_attributes,json=identityAttributes,proto3" json:"identity_attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *ResourceIdentitySchema) Reset()         { *m = ResourceIdentitySchema{} }
+func (m *ResourceIdentitySchema) String() string { return proto.CompactTextString(m) }
+func (*ResourceIdentitySchema) ProtoMessage()    {}
+func (*ResourceIdentitySchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{7}
 }
 
-func (m *GetProviderSchema) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProviderSchema.Unmarshal(m, b)
+func (m *ResourceIdentitySchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceIdentitySchema.Unmarshal(m, b)
 }
-func (m *GetProviderSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProviderSchema.Marshal(b, m, deterministic)
+func (m *ResourceIdentitySchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceIdentitySchema.Marshal(b, m, deterministic)
 }
-func (m *GetProviderSchema) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProviderSchema.Merge(m, src)
+func (m *ResourceIdentitySchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceIdentitySchema.Merge(m, src)
 }
-func (m *GetProviderSchema) XXX_Size() int {
-	return xxx_messageInfo_GetProviderSchema.Size(m)
+func (m *ResourceIdentitySchema) XXX_Size() int {
+	return xxx_messageInfo_ResourceIdentitySchema.Size(m)
 }
-func (m *GetProviderSchema) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProviderSchema.DiscardUnknown(m)
+func (m *ResourceIdentitySchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceIdentitySchema.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProviderSchema proto.InternalMessageInfo
+var xxx_messageInfo_ResourceIdentitySchema proto.InternalMessageInfo
 
-type GetProviderSchema_Request struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ResourceIdentitySchema) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *GetProviderSchema_Request) Reset()         { *m = GetProviderSchema_Request{} }
-func (m *GetProviderSchema_Request) String() string { return proto.CompactTextString(m) }
-func (*GetProviderSchema_Request) ProtoMessage()    {}
-func (*GetProviderSchema_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{6, 0}
+func (m *ResourceIdentitySchema) GetIdentityAttributes() []*ResourceIdentitySchema_IdentityAttribute {
+	if m != nil {
+		return m.IdentityAttributes
+	}
+	return nil
 }
 
-func (m *GetProviderSchema_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProviderSchema_Request.Unmarshal(m, b)
-}
-func (m *GetProviderSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProviderSchema_Request.Marshal(b, m, deterministic)
-}
-func (m *GetProviderSchema_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProviderSchema_Request.Merge(m, src)
-}
-func (m *GetProviderSchema_Request) XXX_Size() int {
-	return xxx_messageInfo_GetProviderSchema_Request.Size(m)
-}
-func (m *GetProviderSchema_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProviderSchema_Request.DiscardUnknown(m)
+// IdentityAttribute represents one value of data within resource identity.
+// These are always used in resource identity comparisons.
+type ResourceIdentitySchema_IdentityAttribute struct {
+	// name is the identity attribute name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the identity attribute type
+	Type []byte `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// required_for_import when enabled signifies that this attribute must be
+	// defined for ImportResourceState to complete successfully
+	RequiredForImport bool `protobuf:"varint,3,opt,name=required_for_import,json=requiredForImport,proto3" json:"required_for_import,omitempty"`
+	// optional_for_import when enabled signifies that this attribute is not
+	// required for ImportResourceState, because it can be supplied by the
+	// provider. It is still possible to supply this attribute during import.
+	OptionalForImport bool `protobuf:"varint,4,opt,name=optional_for_import,json=optionalForImport,proto3" json:"optional_for_import,omitempty"`
+	// description is a human-readable description of the attribute in Markdown
+	Description          string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var xxx_messageInfo_GetProviderSchema_Request proto.InternalMessageInfo
-
-type GetProviderSchema_Response struct {
-	Provider             *Schema            `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
-	ResourceSchemas      map[string]*Schema `protobuf:"bytes,2,rep,name=resource_schemas,json=resourceSchemas,proto3" json:"resource_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	DataSourceSchemas    map[string]*Schema `protobuf:"bytes,3,rep,name=data_source_schemas,json=dataSourceSchemas,proto3" json:"data_source_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Diagnostics          []*Diagnostic      `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	ProviderMeta         *Schema            `protobuf:"bytes,5,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+func (m *ResourceIdentitySchema_IdentityAttribute) Reset() {
+	*m = ResourceIdentitySchema_IdentityAttribute{}
 }
-
-func (m *GetProviderSchema_Response) Reset()         { *m = GetProviderSchema_Response{} }
-func (m *GetProviderSchema_Response) String() string { return proto.CompactTextString(m) }
-func (*GetProviderSchema_Response) ProtoMessage()    {}
-func (*GetProviderSchema_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{6, 1}
+func (m *ResourceIdentitySchema_IdentityAttribute) String() string { return proto.CompactTextString(m) }
+func (*ResourceIdentitySchema_IdentityAttribute) ProtoMessage()    {}
+func (*ResourceIdentitySchema_IdentityAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{7, 0}
 }
 
-func (m *GetProviderSchema_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProviderSchema_Response.Unmarshal(m, b)
+func (m *ResourceIdentitySchema_IdentityAttribute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceIdentitySchema_IdentityAttribute.Unmarshal(m, b)
 }
-func (m *GetProviderSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProviderSchema_Response.Marshal(b, m, deterministic)
+func (m *ResourceIdentitySchema_IdentityAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceIdentitySchema_IdentityAttribute.Marshal(b, m, deterministic)
 }
-func (m *GetProviderSchema_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProviderSchema_Response.Merge(m, src)
+func (m *ResourceIdentitySchema_IdentityAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceIdentitySchema_IdentityAttribute.Merge(m, src)
 }
-func (m *GetProviderSchema_Response) XXX_Size() int {
-	return xxx_messageInfo_GetProviderSchema_Response.Size(m)
+func (m *ResourceIdentitySchema_IdentityAttribute) XXX_Size() int {
+	return xxx_messageInfo_ResourceIdentitySchema_IdentityAttribute.Size(m)
 }
-func (m *GetProviderSchema_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProviderSchema_Response.DiscardUnknown(m)
+func (m *ResourceIdentitySchema_IdentityAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceIdentitySchema_IdentityAttribute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProviderSchema_Response proto.InternalMessageInfo
+var xxx_messageInfo_ResourceIdentitySchema_IdentityAttribute proto.InternalMessageInfo
 
-func (m *GetProviderSchema_Response) GetProvider() *Schema {
+func (m *ResourceIdentitySchema_IdentityAttribute) GetName() string {
 	if m != nil {
-		return m.Provider
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *GetProviderSchema_Response) GetResourceSchemas() map[string]*Schema {
+func (m *ResourceIdentitySchema_IdentityAttribute) GetType() []byte {
 	if m != nil {
-		return m.ResourceSchemas
+		return m.Type
 	}
 	return nil
 }
 
-func (m *GetProviderSchema_Response) GetDataSourceSchemas() map[string]*Schema {
+func (m *ResourceIdentitySchema_IdentityAttribute) GetRequiredForImport() bool {
 	if m != nil {
-		return m.DataSourceSchemas
+		return m.RequiredForImport
 	}
-	return nil
+	return false
 }
 
-func (m *GetProviderSchema_Response) GetDiagnostics() []*Diagnostic {
+func (m *ResourceIdentitySchema_IdentityAttribute) GetOptionalForImport() bool {
 	if m != nil {
-		return m.Diagnostics
+		return m.OptionalForImport
 	}
-	return nil
+	return false
 }
 
-func (m *GetProviderSchema_Response) GetProviderMeta() *Schema {
+func (m *ResourceIdentitySchema_IdentityAttribute) GetDescription() string {
 	if m != nil {
-		return m.ProviderMeta
+		return m.Description
 	}
-	return nil
-}
-
-type PrepareProviderConfig struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PrepareProviderConfig) Reset()         { *m = PrepareProviderConfig{} }
-func (m *PrepareProviderConfig) String() string { return proto.CompactTextString(m) }
-func (*PrepareProviderConfig) ProtoMessage()    {}
-func (*PrepareProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{7}
-}
-
-func (m *PrepareProviderConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrepareProviderConfig.Unmarshal(m, b)
-}
-func (m *PrepareProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrepareProviderConfig.Marshal(b, m, deterministic)
-}
-func (m *PrepareProviderConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepareProviderConfig.Merge(m, src)
-}
-func (m *PrepareProviderConfig) XXX_Size() int {
-	return xxx_messageInfo_PrepareProviderConfig.Size(m)
-}
-func (m *PrepareProviderConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepareProviderConfig.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_PrepareProviderConfig proto.InternalMessageInfo
-
-type PrepareProviderConfig_Request struct {
-	Config               *DynamicValue `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+// ResourceIdentityData is a separate message for better extensibility
+type ResourceIdentityData struct {
+	// identity_data is the resource identity data for the given definition. It should
+	// be decoded using the identity schema.
+	//
+	// This data is considered permanent for the identity version and suitable for
+	// longer-term storage.
+	IdentityData         *DynamicValue `protobuf:"bytes,1,opt,name=identity_data,json=identityData,proto3" json:"identity_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *PrepareProviderConfig_Request) Reset()         { *m = PrepareProviderConfig_Request{} }
-func (m *PrepareProviderConfig_Request) String() string { return proto.CompactTextString(m) }
-func (*PrepareProviderConfig_Request) ProtoMessage()    {}
-func (*PrepareProviderConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{7, 0}
+func (m *ResourceIdentityData) Reset()         { *m = ResourceIdentityData{} }
+func (m *ResourceIdentityData) String() string { return proto.CompactTextString(m) }
+func (*ResourceIdentityData) ProtoMessage()    {}
+func (*ResourceIdentityData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{8}
 }
 
-func (m *PrepareProviderConfig_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrepareProviderConfig_Request.Unmarshal(m, b)
+func (m *ResourceIdentityData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResourceIdentityData.Unmarshal(m, b)
 }
-func (m *PrepareProviderConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrepareProviderConfig_Request.Marshal(b, m, deterministic)
+func (m *ResourceIdentityData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResourceIdentityData.Marshal(b, m, deterministic)
 }
-func (m *PrepareProviderConfig_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepareProviderConfig_Request.Merge(m, src)
+func (m *ResourceIdentityData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceIdentityData.Merge(m, src)
 }
-func (m *PrepareProviderConfig_Request) XXX_Size() int {
-	return xxx_messageInfo_PrepareProviderConfig_Request.Size(m)
+func (m *ResourceIdentityData) XXX_Size() int {
+	return xxx_messageInfo_ResourceIdentityData.Size(m)
 }
-func (m *PrepareProviderConfig_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepareProviderConfig_Request.DiscardUnknown(m)
+func (m *ResourceIdentityData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceIdentityData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrepareProviderConfig_Request proto.InternalMessageInfo
+var xxx_messageInfo_ResourceIdentityData proto.InternalMessageInfo
 
-func (m *PrepareProviderConfig_Request) GetConfig() *DynamicValue {
+func (m *ResourceIdentityData) GetIdentityData() *DynamicValue {
 	if m != nil {
-		return m.Config
+		return m.IdentityData
 	}
 	return nil
 }
 
-type PrepareProviderConfig_Response struct {
-	PreparedConfig       *DynamicValue `protobuf:"bytes,1,opt,name=prepared_config,json=preparedConfig,proto3" json:"prepared_config,omitempty"`
-	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// ServerCapabilities allows providers to communicate extra information
+// regarding supported protocol features. This is used to indicate
+// availability of certain forward-compatible changes which may be optional
+// in a major protocol version, but cannot be tested for directly.
+type ServerCapabilities struct {
+	// The plan_destroy capability signals that a provider expects a call
+	// to PlanResourceChange when a resource is going to be destroyed.
+	PlanDestroy bool `protobuf:"varint,1,opt,name=plan_destroy,json=planDestroy,proto3" json:"plan_destroy,omitempty"`
+	// The get_provider_schema_optional capability indicates that this
+	// provider does not require calling GetProviderSchema to operate
+	// normally, and the caller can used a cached copy of the provider's
+	// schema.
+	GetProviderSchemaOptional bool `protobuf:"varint,2,opt,name=get_provider_schema_optional,json=getProviderSchemaOptional,proto3" json:"get_provider_schema_optional,omitempty"`
+	// The move_resource_state capability signals that a provider supports the
+	// MoveResourceState RPC.
+	MoveResourceState    bool     `protobuf:"varint,3,opt,name=move_resource_state,json=moveResourceState,proto3" json:"move_resource_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PrepareProviderConfig_Response) Reset()         { *m = PrepareProviderConfig_Response{} }
-func (m *PrepareProviderConfig_Response) String() string { return proto.CompactTextString(m) }
-func (*PrepareProviderConfig_Response) ProtoMessage()    {}
-func (*PrepareProviderConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{7, 1}
+func (m *ServerCapabilities) Reset()         { *m = ServerCapabilities{} }
+func (m *ServerCapabilities) String() string { return proto.CompactTextString(m) }
+func (*ServerCapabilities) ProtoMessage()    {}
+func (*ServerCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{9}
 }
 
-func (m *PrepareProviderConfig_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrepareProviderConfig_Response.Unmarshal(m, b)
+func (m *ServerCapabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerCapabilities.Unmarshal(m, b)
 }
-func (m *PrepareProviderConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrepareProviderConfig_Response.Marshal(b, m, deterministic)
+func (m *ServerCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerCapabilities.Marshal(b, m, deterministic)
 }
-func (m *PrepareProviderConfig_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepareProviderConfig_Response.Merge(m, src)
+func (m *ServerCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerCapabilities.Merge(m, src)
 }
-func (m *PrepareProviderConfig_Response) XXX_Size() int {
-	return xxx_messageInfo_PrepareProviderConfig_Response.Size(m)
+func (m *ServerCapabilities) XXX_Size() int {
+	return xxx_messageInfo_ServerCapabilities.Size(m)
 }
-func (m *PrepareProviderConfig_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepareProviderConfig_Response.DiscardUnknown(m)
+func (m *ServerCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerCapabilities.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrepareProviderConfig_Response proto.InternalMessageInfo
+var xxx_messageInfo_ServerCapabilities proto.InternalMessageInfo
 
-func (m *PrepareProviderConfig_Response) GetPreparedConfig() *DynamicValue {
+func (m *ServerCapabilities) GetPlanDestroy() bool {
 	if m != nil {
-		return m.PreparedConfig
+		return m.PlanDestroy
 	}
-	return nil
+	return false
 }
 
-func (m *PrepareProviderConfig_Response) GetDiagnostics() []*Diagnostic {
+func (m *ServerCapabilities) GetGetProviderSchemaOptional() bool {
+	if m != nil {
+		return m.GetProviderSchemaOptional
+	}
+	return false
+}
+
+func (m *ServerCapabilities) GetMoveResourceState() bool {
+	if m != nil {
+		return m.MoveResourceState
+	}
+	return false
+}
+
+// ClientCapabilities allows Terraform to publish information regarding
+// supported protocol features. This is used to indicate availability of
+// certain forward-compatible changes which may be optional in a major
+// protocol version, but cannot be tested for directly.
+type ClientCapabilities struct {
+	// The deferral_allowed capability signals that the client is able to
+	// handle deferred responses from the provider.
+	DeferralAllowed bool `protobuf:"varint,1,opt,name=deferral_allowed,json=deferralAllowed,proto3" json:"deferral_allowed,omitempty"`
+	// The write_only_attributes_allowed capability signals that the client
+	// is able to handle write_only attributes for managed resources.
+	WriteOnlyAttributesAllowed bool     `protobuf:"varint,2,opt,name=write_only_attributes_allowed,json=writeOnlyAttributesAllowed,proto3" json:"write_only_attributes_allowed,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *ClientCapabilities) Reset()         { *m = ClientCapabilities{} }
+func (m *ClientCapabilities) String() string { return proto.CompactTextString(m) }
+func (*ClientCapabilities) ProtoMessage()    {}
+func (*ClientCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{10}
+}
+
+func (m *ClientCapabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClientCapabilities.Unmarshal(m, b)
+}
+func (m *ClientCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClientCapabilities.Marshal(b, m, deterministic)
+}
+func (m *ClientCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientCapabilities.Merge(m, src)
+}
+func (m *ClientCapabilities) XXX_Size() int {
+	return xxx_messageInfo_ClientCapabilities.Size(m)
+}
+func (m *ClientCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientCapabilities proto.InternalMessageInfo
+
+func (m *ClientCapabilities) GetDeferralAllowed() bool {
+	if m != nil {
+		return m.DeferralAllowed
+	}
+	return false
+}
+
+func (m *ClientCapabilities) GetWriteOnlyAttributesAllowed() bool {
+	if m != nil {
+		return m.WriteOnlyAttributesAllowed
+	}
+	return false
+}
+
+type Function struct {
+	// parameters is the ordered list of positional function parameters.
+	Parameters []*Function_Parameter `protobuf:"bytes,1,rep,name=parameters,proto3" json:"parameters,omitempty"`
+	// variadic_parameter is an optional final parameter which accepts
+	// zero or more argument values, in which Terraform will send an
+	// ordered list of the parameter type.
+	VariadicParameter *Function_Parameter `protobuf:"bytes,2,opt,name=variadic_parameter,json=variadicParameter,proto3" json:"variadic_parameter,omitempty"`
+	// return is the function result.
+	Return *Function_Return `protobuf:"bytes,3,opt,name=return,proto3" json:"return,omitempty"`
+	// summary is the human-readable shortened documentation for the function.
+	Summary string `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	// description is human-readable documentation for the function.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// description_kind is the formatting of the description.
+	DescriptionKind StringKind `protobuf:"varint,6,opt,name=description_kind,json=descriptionKind,proto3,enum=tfplugin5.StringKind" json:"description_kind,omitempty"`
+	// deprecation_message is human-readable documentation if the
+	// function is deprecated.
+	DeprecationMessage   string   `protobuf:"bytes,7,opt,name=deprecation_message,json=deprecationMessage,proto3" json:"deprecation_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Function) Reset()         { *m = Function{} }
+func (m *Function) String() string { return proto.CompactTextString(m) }
+func (*Function) ProtoMessage()    {}
+func (*Function) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{11}
+}
+
+func (m *Function) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Function.Unmarshal(m, b)
+}
+func (m *Function) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Function.Marshal(b, m, deterministic)
+}
+func (m *Function) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Function.Merge(m, src)
+}
+func (m *Function) XXX_Size() int {
+	return xxx_messageInfo_Function.Size(m)
+}
+func (m *Function) XXX_DiscardUnknown() {
+	xxx_messageInfo_Function.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Function proto.InternalMessageInfo
+
+func (m *Function) GetParameters() []*Function_Parameter {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *Function) GetVariadicParameter() *Function_Parameter {
+	if m != nil {
+		return m.VariadicParameter
+	}
+	return nil
+}
+
+func (m *Function) GetReturn() *Function_Return {
+	if m != nil {
+		return m.Return
+	}
+	return nil
+}
+
+func (m *Function) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *Function) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Function) GetDescriptionKind() StringKind {
+	if m != nil {
+		return m.DescriptionKind
+	}
+	return StringKind_PLAIN
+}
+
+func (m *Function) GetDeprecationMessage() string {
+	if m != nil {
+		return m.DeprecationMessage
+	}
+	return ""
+}
+
+type Function_Parameter struct {
+	// name is the human-readable display name for the parameter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type is the type constraint for the parameter.
+	Type []byte `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// allow_null_value when enabled denotes that a null argument value can
+	// be passed to the provider. When disabled, Terraform returns an error
+	// if the argument value is null.
+	AllowNullValue bool `protobuf:"varint,3,opt,name=allow_null_value,json=allowNullValue,proto3" json:"allow_null_value,omitempty"`
+	// allow_unknown_values when enabled denotes that only wholly known
+	// argument values will be passed to the provider. When disabled,
+	// Terraform skips the function call entirely and assumes an unknown
+	// value result from the function.
+	AllowUnknownValues bool `protobuf:"varint,4,opt,name=allow_unknown_values,json=allowUnknownValues,proto3" json:"allow_unknown_values,omitempty"`
+	// description is human-readable documentation for the parameter.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// description_kind is the formatting of the description.
+	DescriptionKind      StringKind `protobuf:"varint,6,opt,name=description_kind,json=descriptionKind,proto3,enum=tfplugin5.StringKind" json:"description_kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Function_Parameter) Reset()         { *m = Function_Parameter{} }
+func (m *Function_Parameter) String() string { return proto.CompactTextString(m) }
+func (*Function_Parameter) ProtoMessage()    {}
+func (*Function_Parameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{11, 0}
+}
+
+func (m *Function_Parameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Function_Parameter.Unmarshal(m, b)
+}
+func (m *Function_Parameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Function_Parameter.Marshal(b, m, deterministic)
+}
+func (m *Function_Parameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Function_Parameter.Merge(m, src)
+}
+func (m *Function_Parameter) XXX_Size() int {
+	return xxx_messageInfo_Function_Parameter.Size(m)
+}
+func (m *Function_Parameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Function_Parameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Function_Parameter proto.InternalMessageInfo
+
+func (m *Function_Parameter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Function_Parameter) GetType() []byte {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *Function_Parameter) GetAllowNullValue() bool {
+	if m != nil {
+		return m.AllowNullValue
+	}
+	return false
+}
+
+func (m *Function_Parameter) GetAllowUnknownValues() bool {
+	if m != nil {
+		return m.AllowUnknownValues
+	}
+	return false
+}
+
+func (m *Function_Parameter) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Function_Parameter) GetDescriptionKind() StringKind {
+	if m != nil {
+		return m.DescriptionKind
+	}
+	return StringKind_PLAIN
+}
+
+type Function_Return struct {
+	// type is the type constraint for the function result.
+	Type                 []byte   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Function_Return) Reset()         { *m = Function_Return{} }
+func (m *Function_Return) String() string { return proto.CompactTextString(m) }
+func (*Function_Return) ProtoMessage()    {}
+func (*Function_Return) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{11, 1}
+}
+
+func (m *Function_Return) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Function_Return.Unmarshal(m, b)
+}
+func (m *Function_Return) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Function_Return.Marshal(b, m, deterministic)
+}
+func (m *Function_Return) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Function_Return.Merge(m, src)
+}
+func (m *Function_Return) XXX_Size() int {
+	return xxx_messageInfo_Function_Return.Size(m)
+}
+func (m *Function_Return) XXX_DiscardUnknown() {
+	xxx_messageInfo_Function_Return.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Function_Return proto.InternalMessageInfo
+
+func (m *Function_Return) GetType() []byte {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+type ActionSchema struct {
+	Schema               *Schema  `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionSchema) Reset()         { *m = ActionSchema{} }
+func (m *ActionSchema) String() string { return proto.CompactTextString(m) }
+func (*ActionSchema) ProtoMessage()    {}
+func (*ActionSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{12}
+}
+
+func (m *ActionSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionSchema.Unmarshal(m, b)
+}
+func (m *ActionSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionSchema.Marshal(b, m, deterministic)
+}
+func (m *ActionSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionSchema.Merge(m, src)
+}
+func (m *ActionSchema) XXX_Size() int {
+	return xxx_messageInfo_ActionSchema.Size(m)
+}
+func (m *ActionSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionSchema proto.InternalMessageInfo
+
+func (m *ActionSchema) GetSchema() *Schema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+// Deferred is a message that indicates that change is deferred for a reason.
+type Deferred struct {
+	// reason is the reason for deferring the change.
+	Reason               Deferred_Reason `protobuf:"varint,1,opt,name=reason,proto3,enum=tfplugin5.Deferred_Reason" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Deferred) Reset()         { *m = Deferred{} }
+func (m *Deferred) String() string { return proto.CompactTextString(m) }
+func (*Deferred) ProtoMessage()    {}
+func (*Deferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{13}
+}
+
+func (m *Deferred) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Deferred.Unmarshal(m, b)
+}
+func (m *Deferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Deferred.Marshal(b, m, deterministic)
+}
+func (m *Deferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deferred.Merge(m, src)
+}
+func (m *Deferred) XXX_Size() int {
+	return xxx_messageInfo_Deferred.Size(m)
+}
+func (m *Deferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deferred proto.InternalMessageInfo
+
+func (m *Deferred) GetReason() Deferred_Reason {
+	if m != nil {
+		return m.Reason
+	}
+	return Deferred_UNKNOWN
+}
+
+type GetMetadata struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata) Reset()         { *m = GetMetadata{} }
+func (m *GetMetadata) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata) ProtoMessage()    {}
+func (*GetMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14}
+}
+
+func (m *GetMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata.Unmarshal(m, b)
+}
+func (m *GetMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata.Merge(m, src)
+}
+func (m *GetMetadata) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata.Size(m)
+}
+func (m *GetMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata proto.InternalMessageInfo
+
+type GetMetadata_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata_Request) Reset()         { *m = GetMetadata_Request{} }
+func (m *GetMetadata_Request) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_Request) ProtoMessage()    {}
+func (*GetMetadata_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 0}
+}
+
+func (m *GetMetadata_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_Request.Unmarshal(m, b)
+}
+func (m *GetMetadata_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_Request.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_Request.Merge(m, src)
+}
+func (m *GetMetadata_Request) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_Request.Size(m)
+}
+func (m *GetMetadata_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_Request proto.InternalMessageInfo
+
+type GetMetadata_Response struct {
+	ServerCapabilities *ServerCapabilities               `protobuf:"bytes,1,opt,name=server_capabilities,json=serverCapabilities,proto3" json:"server_capabilities,omitempty"`
+	Diagnostics        []*Diagnostic                     `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	DataSources        []*GetMetadata_DataSourceMetadata `protobuf:"bytes,3,rep,name=data_sources,json=dataSources,proto3" json:"data_sources,omitempty"`
+	Resources          []*GetMetadata_ResourceMetadata   `protobuf:"bytes,4,rep,name=resources,proto3" json:"resources,omitempty"`
+	// functions returns metadata for any functions.
+	Functions            []*GetMetadata_FunctionMetadata          `protobuf:"bytes,5,rep,name=functions,proto3" json:"functions,omitempty"`
+	EphemeralResources   []*GetMetadata_EphemeralResourceMetadata `protobuf:"bytes,6,rep,name=ephemeral_resources,json=ephemeralResources,proto3" json:"ephemeral_resources,omitempty"`
+	ListResources        []*GetMetadata_ListResourceMetadata      `protobuf:"bytes,7,rep,name=list_resources,json=listResources,proto3" json:"list_resources,omitempty"`
+	Actions              []*GetMetadata_ActionMetadata            `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *GetMetadata_Response) Reset()         { *m = GetMetadata_Response{} }
+func (m *GetMetadata_Response) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_Response) ProtoMessage()    {}
+func (*GetMetadata_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 1}
+}
+
+func (m *GetMetadata_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_Response.Unmarshal(m, b)
+}
+func (m *GetMetadata_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_Response.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_Response.Merge(m, src)
+}
+func (m *GetMetadata_Response) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_Response.Size(m)
+}
+func (m *GetMetadata_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_Response proto.InternalMessageInfo
+
+func (m *GetMetadata_Response) GetServerCapabilities() *ServerCapabilities {
+	if m != nil {
+		return m.ServerCapabilities
+	}
+	return nil
+}
+
+func (m *GetMetadata_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *GetMetadata_Response) GetDataSources() []*GetMetadata_DataSourceMetadata {
+	if m != nil {
+		return m.DataSources
+	}
+	return nil
+}
+
+func (m *GetMetadata_Response) GetResources() []*GetMetadata_ResourceMetadata {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *GetMetadata_Response) GetFunctions() []*GetMetadata_FunctionMetadata {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *GetMetadata_Response) GetEphemeralResources() []*GetMetadata_EphemeralResourceMetadata {
+	if m != nil {
+		return m.EphemeralResources
+	}
+	return nil
+}
+
+func (m *GetMetadata_Response) GetListResources() []*GetMetadata_ListResourceMetadata {
+	if m != nil {
+		return m.ListResources
+	}
+	return nil
+}
+
+func (m *GetMetadata_Response) GetActions() []*GetMetadata_ActionMetadata {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type GetMetadata_FunctionMetadata struct {
+	// name is the function name.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata_FunctionMetadata) Reset()         { *m = GetMetadata_FunctionMetadata{} }
+func (m *GetMetadata_FunctionMetadata) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_FunctionMetadata) ProtoMessage()    {}
+func (*GetMetadata_FunctionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 2}
+}
+
+func (m *GetMetadata_FunctionMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_FunctionMetadata.Unmarshal(m, b)
+}
+func (m *GetMetadata_FunctionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_FunctionMetadata.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_FunctionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_FunctionMetadata.Merge(m, src)
+}
+func (m *GetMetadata_FunctionMetadata) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_FunctionMetadata.Size(m)
+}
+func (m *GetMetadata_FunctionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_FunctionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_FunctionMetadata proto.InternalMessageInfo
+
+func (m *GetMetadata_FunctionMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetMetadata_DataSourceMetadata struct {
+	TypeName             string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata_DataSourceMetadata) Reset()         { *m = GetMetadata_DataSourceMetadata{} }
+func (m *GetMetadata_DataSourceMetadata) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_DataSourceMetadata) ProtoMessage()    {}
+func (*GetMetadata_DataSourceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 3}
+}
+
+func (m *GetMetadata_DataSourceMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_DataSourceMetadata.Unmarshal(m, b)
+}
+func (m *GetMetadata_DataSourceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_DataSourceMetadata.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_DataSourceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_DataSourceMetadata.Merge(m, src)
+}
+func (m *GetMetadata_DataSourceMetadata) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_DataSourceMetadata.Size(m)
+}
+func (m *GetMetadata_DataSourceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_DataSourceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_DataSourceMetadata proto.InternalMessageInfo
+
+func (m *GetMetadata_DataSourceMetadata) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+type GetMetadata_ResourceMetadata struct {
+	TypeName             string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata_ResourceMetadata) Reset()         { *m = GetMetadata_ResourceMetadata{} }
+func (m *GetMetadata_ResourceMetadata) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_ResourceMetadata) ProtoMessage()    {}
+func (*GetMetadata_ResourceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 4}
+}
+
+func (m *GetMetadata_ResourceMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_ResourceMetadata.Unmarshal(m, b)
+}
+func (m *GetMetadata_ResourceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_ResourceMetadata.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_ResourceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_ResourceMetadata.Merge(m, src)
+}
+func (m *GetMetadata_ResourceMetadata) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_ResourceMetadata.Size(m)
+}
+func (m *GetMetadata_ResourceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_ResourceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_ResourceMetadata proto.InternalMessageInfo
+
+func (m *GetMetadata_ResourceMetadata) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+type GetMetadata_EphemeralResourceMetadata struct {
+	TypeName             string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata_EphemeralResourceMetadata) Reset()         { *m = GetMetadata_EphemeralResourceMetadata{} }
+func (m *GetMetadata_EphemeralResourceMetadata) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_EphemeralResourceMetadata) ProtoMessage()    {}
+func (*GetMetadata_EphemeralResourceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 5}
+}
+
+func (m *GetMetadata_EphemeralResourceMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_EphemeralResourceMetadata.Unmarshal(m, b)
+}
+func (m *GetMetadata_EphemeralResourceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_EphemeralResourceMetadata.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_EphemeralResourceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_EphemeralResourceMetadata.Merge(m, src)
+}
+func (m *GetMetadata_EphemeralResourceMetadata) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_EphemeralResourceMetadata.Size(m)
+}
+func (m *GetMetadata_EphemeralResourceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_EphemeralResourceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_EphemeralResourceMetadata proto.InternalMessageInfo
+
+func (m *GetMetadata_EphemeralResourceMetadata) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+type GetMetadata_ListResourceMetadata struct {
+	TypeName             string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata_ListResourceMetadata) Reset()         { *m = GetMetadata_ListResourceMetadata{} }
+func (m *GetMetadata_ListResourceMetadata) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_ListResourceMetadata) ProtoMessage()    {}
+func (*GetMetadata_ListResourceMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 6}
+}
+
+func (m *GetMetadata_ListResourceMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_ListResourceMetadata.Unmarshal(m, b)
+}
+func (m *GetMetadata_ListResourceMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_ListResourceMetadata.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_ListResourceMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_ListResourceMetadata.Merge(m, src)
+}
+func (m *GetMetadata_ListResourceMetadata) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_ListResourceMetadata.Size(m)
+}
+func (m *GetMetadata_ListResourceMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_ListResourceMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_ListResourceMetadata proto.InternalMessageInfo
+
+func (m *GetMetadata_ListResourceMetadata) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+type GetMetadata_ActionMetadata struct {
+	TypeName             string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMetadata_ActionMetadata) Reset()         { *m = GetMetadata_ActionMetadata{} }
+func (m *GetMetadata_ActionMetadata) String() string { return proto.CompactTextString(m) }
+func (*GetMetadata_ActionMetadata) ProtoMessage()    {}
+func (*GetMetadata_ActionMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{14, 7}
+}
+
+func (m *GetMetadata_ActionMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetadata_ActionMetadata.Unmarshal(m, b)
+}
+func (m *GetMetadata_ActionMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetadata_ActionMetadata.Marshal(b, m, deterministic)
+}
+func (m *GetMetadata_ActionMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetadata_ActionMetadata.Merge(m, src)
+}
+func (m *GetMetadata_ActionMetadata) XXX_Size() int {
+	return xxx_messageInfo_GetMetadata_ActionMetadata.Size(m)
+}
+func (m *GetMetadata_ActionMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetadata_ActionMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetadata_ActionMetadata proto.InternalMessageInfo
+
+func (m *GetMetadata_ActionMetadata) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+type GetProviderSchema struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProviderSchema) Reset()         { *m = GetProviderSchema{} }
+func (m *GetProviderSchema) String() string { return proto.CompactTextString(m) }
+func (*GetProviderSchema) ProtoMessage()    {}
+func (*GetProviderSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{15}
+}
+
+func (m *GetProviderSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProviderSchema.Unmarshal(m, b)
+}
+func (m *GetProviderSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProviderSchema.Marshal(b, m, deterministic)
+}
+func (m *GetProviderSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProviderSchema.Merge(m, src)
+}
+func (m *GetProviderSchema) XXX_Size() int {
+	return xxx_messageInfo_GetProviderSchema.Size(m)
+}
+func (m *GetProviderSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProviderSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProviderSchema proto.InternalMessageInfo
+
+type GetProviderSchema_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProviderSchema_Request) Reset()         { *m = GetProviderSchema_Request{} }
+func (m *GetProviderSchema_Request) String() string { return proto.CompactTextString(m) }
+func (*GetProviderSchema_Request) ProtoMessage()    {}
+func (*GetProviderSchema_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{15, 0}
+}
+
+func (m *GetProviderSchema_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProviderSchema_Request.Unmarshal(m, b)
+}
+func (m *GetProviderSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProviderSchema_Request.Marshal(b, m, deterministic)
+}
+func (m *GetProviderSchema_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProviderSchema_Request.Merge(m, src)
+}
+func (m *GetProviderSchema_Request) XXX_Size() int {
+	return xxx_messageInfo_GetProviderSchema_Request.Size(m)
+}
+func (m *GetProviderSchema_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProviderSchema_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProviderSchema_Request proto.InternalMessageInfo
+
+type GetProviderSchema_Response struct {
+	Provider           *Schema             `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ResourceSchemas    map[string]*Schema  `protobuf:"bytes,2,rep,name=resource_schemas,json=resourceSchemas,proto3" json:"resource_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DataSourceSchemas  map[string]*Schema  `protobuf:"bytes,3,rep,name=data_source_schemas,json=dataSourceSchemas,proto3" json:"data_source_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Diagnostics        []*Diagnostic       `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	ProviderMeta       *Schema             `protobuf:"bytes,5,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	ServerCapabilities *ServerCapabilities `protobuf:"bytes,6,opt,name=server_capabilities,json=serverCapabilities,proto3" json:"server_capabilities,omitempty"`
+	// functions is a mapping of function names to definitions.
+	Functions                map[string]*Function     `protobuf:"bytes,7,rep,name=functions,proto3" json:"functions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	EphemeralResourceSchemas map[string]*Schema       `protobuf:"bytes,8,rep,name=ephemeral_resource_schemas,json=ephemeralResourceSchemas,proto3" json:"ephemeral_resource_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ListResourceSchemas      map[string]*Schema       `protobuf:"bytes,9,rep,name=list_resource_schemas,json=listResourceSchemas,proto3" json:"list_resource_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ActionSchemas            map[string]*ActionSchema `protobuf:"bytes,11,rep,name=action_schemas,json=actionSchemas,proto3" json:"action_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral     struct{}                 `json:"-"`
+	XXX_unrecognized         []byte                   `json:"-"`
+	XXX_sizecache            int32                    `json:"-"`
+}
+
+func (m *GetProviderSchema_Response) Reset()         { *m = GetProviderSchema_Response{} }
+func (m *GetProviderSchema_Response) String() string { return proto.CompactTextString(m) }
+func (*GetProviderSchema_Response) ProtoMessage()    {}
+func (*GetProviderSchema_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{15, 1}
+}
+
+func (m *GetProviderSchema_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProviderSchema_Response.Unmarshal(m, b)
+}
+func (m *GetProviderSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProviderSchema_Response.Marshal(b, m, deterministic)
+}
+func (m *GetProviderSchema_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProviderSchema_Response.Merge(m, src)
+}
+func (m *GetProviderSchema_Response) XXX_Size() int {
+	return xxx_messageInfo_GetProviderSchema_Response.Size(m)
+}
+func (m *GetProviderSchema_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProviderSchema_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProviderSchema_Response proto.InternalMessageInfo
+
+func (m *GetProviderSchema_Response) GetProvider() *Schema {
+	if m != nil {
+		return m.Provider
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetResourceSchemas() map[string]*Schema {
+	if m != nil {
+		return m.ResourceSchemas
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetDataSourceSchemas() map[string]*Schema {
+	if m != nil {
+		return m.DataSourceSchemas
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetProviderMeta() *Schema {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetServerCapabilities() *ServerCapabilities {
+	if m != nil {
+		return m.ServerCapabilities
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetFunctions() map[string]*Function {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetEphemeralResourceSchemas() map[string]*Schema {
+	if m != nil {
+		return m.EphemeralResourceSchemas
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetListResourceSchemas() map[string]*Schema {
+	if m != nil {
+		return m.ListResourceSchemas
+	}
+	return nil
+}
+
+func (m *GetProviderSchema_Response) GetActionSchemas() map[string]*ActionSchema {
+	if m != nil {
+		return m.ActionSchemas
+	}
+	return nil
+}
+
+type PrepareProviderConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrepareProviderConfig) Reset()         { *m = PrepareProviderConfig{} }
+func (m *PrepareProviderConfig) String() string { return proto.CompactTextString(m) }
+func (*PrepareProviderConfig) ProtoMessage()    {}
+func (*PrepareProviderConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{16}
+}
+
+func (m *PrepareProviderConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrepareProviderConfig.Unmarshal(m, b)
+}
+func (m *PrepareProviderConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrepareProviderConfig.Marshal(b, m, deterministic)
+}
+func (m *PrepareProviderConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareProviderConfig.Merge(m, src)
+}
+func (m *PrepareProviderConfig) XXX_Size() int {
+	return xxx_messageInfo_PrepareProviderConfig.Size(m)
+}
+func (m *PrepareProviderConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareProviderConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepareProviderConfig proto.InternalMessageInfo
+
+type PrepareProviderConfig_Request struct {
+	Config               *DynamicValue `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PrepareProviderConfig_Request) Reset()         { *m = PrepareProviderConfig_Request{} }
+func (m *PrepareProviderConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*PrepareProviderConfig_Request) ProtoMessage()    {}
+func (*PrepareProviderConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{16, 0}
+}
+
+func (m *PrepareProviderConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrepareProviderConfig_Request.Unmarshal(m, b)
+}
+func (m *PrepareProviderConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrepareProviderConfig_Request.Marshal(b, m, deterministic)
+}
+func (m *PrepareProviderConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareProviderConfig_Request.Merge(m, src)
+}
+func (m *PrepareProviderConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_PrepareProviderConfig_Request.Size(m)
+}
+func (m *PrepareProviderConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareProviderConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepareProviderConfig_Request proto.InternalMessageInfo
+
+func (m *PrepareProviderConfig_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type PrepareProviderConfig_Response struct {
+	PreparedConfig       *DynamicValue `protobuf:"bytes,1,opt,name=prepared_config,json=preparedConfig,proto3" json:"prepared_config,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PrepareProviderConfig_Response) Reset()         { *m = PrepareProviderConfig_Response{} }
+func (m *PrepareProviderConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*PrepareProviderConfig_Response) ProtoMessage()    {}
+func (*PrepareProviderConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{16, 1}
+}
+
+func (m *PrepareProviderConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrepareProviderConfig_Response.Unmarshal(m, b)
+}
+func (m *PrepareProviderConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrepareProviderConfig_Response.Marshal(b, m, deterministic)
+}
+func (m *PrepareProviderConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareProviderConfig_Response.Merge(m, src)
+}
+func (m *PrepareProviderConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_PrepareProviderConfig_Response.Size(m)
+}
+func (m *PrepareProviderConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareProviderConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepareProviderConfig_Response proto.InternalMessageInfo
+
+func (m *PrepareProviderConfig_Response) GetPreparedConfig() *DynamicValue {
+	if m != nil {
+		return m.PreparedConfig
+	}
+	return nil
+}
+
+func (m *PrepareProviderConfig_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type UpgradeResourceState struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpgradeResourceState) Reset()         { *m = UpgradeResourceState{} }
+func (m *UpgradeResourceState) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceState) ProtoMessage()    {}
+func (*UpgradeResourceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{17}
+}
+
+func (m *UpgradeResourceState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceState.Unmarshal(m, b)
+}
+func (m *UpgradeResourceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceState.Marshal(b, m, deterministic)
+}
+func (m *UpgradeResourceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceState.Merge(m, src)
+}
+func (m *UpgradeResourceState) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceState.Size(m)
+}
+func (m *UpgradeResourceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeResourceState proto.InternalMessageInfo
+
+// Request is the message that is sent to the provider during the
+// UpgradeResourceState RPC.
+//
+// This message intentionally does not include configuration data as any
+// configuration-based or configuration-conditional changes should occur
+// during the PlanResourceChange RPC. Additionally, the configuration is
+// not guaranteed to exist (in the case of resource destruction), be wholly
+// known, nor match the given prior state, which could lead to unexpected
+// provider behaviors for practitioners.
+type UpgradeResourceState_Request struct {
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// version is the schema_version number recorded in the state file
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// raw_state is the raw states as stored for the resource.  Core does
+	// not have access to the schema of prior_version, so it's the
+	// provider's responsibility to interpret this value using the
+	// appropriate older schema. The raw_state will be the json encoded
+	// state, or a legacy flat-mapped format.
+	RawState             *RawState `protobuf:"bytes,3,opt,name=raw_state,json=rawState,proto3" json:"raw_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UpgradeResourceState_Request) Reset()         { *m = UpgradeResourceState_Request{} }
+func (m *UpgradeResourceState_Request) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceState_Request) ProtoMessage()    {}
+func (*UpgradeResourceState_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{17, 0}
+}
+
+func (m *UpgradeResourceState_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceState_Request.Unmarshal(m, b)
+}
+func (m *UpgradeResourceState_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceState_Request.Marshal(b, m, deterministic)
+}
+func (m *UpgradeResourceState_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceState_Request.Merge(m, src)
+}
+func (m *UpgradeResourceState_Request) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceState_Request.Size(m)
+}
+func (m *UpgradeResourceState_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceState_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeResourceState_Request proto.InternalMessageInfo
+
+func (m *UpgradeResourceState_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *UpgradeResourceState_Request) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpgradeResourceState_Request) GetRawState() *RawState {
+	if m != nil {
+		return m.RawState
+	}
+	return nil
+}
+
+type UpgradeResourceState_Response struct {
+	// new_state is a msgpack-encoded data structure that, when interpreted with
+	// the _current_ schema for this resource type, is functionally equivalent to
+	// that which was given in prior_state_raw.
+	UpgradedState *DynamicValue `protobuf:"bytes,1,opt,name=upgraded_state,json=upgradedState,proto3" json:"upgraded_state,omitempty"`
+	// diagnostics describes any errors encountered during migration that could not
+	// be safely resolved, and warnings about any possibly-risky assumptions made
+	// in the upgrade process.
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpgradeResourceState_Response) Reset()         { *m = UpgradeResourceState_Response{} }
+func (m *UpgradeResourceState_Response) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceState_Response) ProtoMessage()    {}
+func (*UpgradeResourceState_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{17, 1}
+}
+
+func (m *UpgradeResourceState_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceState_Response.Unmarshal(m, b)
+}
+func (m *UpgradeResourceState_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceState_Response.Marshal(b, m, deterministic)
+}
+func (m *UpgradeResourceState_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceState_Response.Merge(m, src)
+}
+func (m *UpgradeResourceState_Response) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceState_Response.Size(m)
+}
+func (m *UpgradeResourceState_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceState_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeResourceState_Response proto.InternalMessageInfo
+
+func (m *UpgradeResourceState_Response) GetUpgradedState() *DynamicValue {
+	if m != nil {
+		return m.UpgradedState
+	}
+	return nil
+}
+
+func (m *UpgradeResourceState_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ValidateResourceTypeConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateResourceTypeConfig) Reset()         { *m = ValidateResourceTypeConfig{} }
+func (m *ValidateResourceTypeConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateResourceTypeConfig) ProtoMessage()    {}
+func (*ValidateResourceTypeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{18}
+}
+
+func (m *ValidateResourceTypeConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateResourceTypeConfig.Unmarshal(m, b)
+}
+func (m *ValidateResourceTypeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateResourceTypeConfig.Marshal(b, m, deterministic)
+}
+func (m *ValidateResourceTypeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResourceTypeConfig.Merge(m, src)
+}
+func (m *ValidateResourceTypeConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateResourceTypeConfig.Size(m)
+}
+func (m *ValidateResourceTypeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResourceTypeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResourceTypeConfig proto.InternalMessageInfo
+
+type ValidateResourceTypeConfig_Request struct {
+	TypeName             string              `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config               *DynamicValue       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ClientCapabilities   *ClientCapabilities `protobuf:"bytes,3,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ValidateResourceTypeConfig_Request) Reset()         { *m = ValidateResourceTypeConfig_Request{} }
+func (m *ValidateResourceTypeConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateResourceTypeConfig_Request) ProtoMessage()    {}
+func (*ValidateResourceTypeConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{18, 0}
+}
+
+func (m *ValidateResourceTypeConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateResourceTypeConfig_Request.Unmarshal(m, b)
+}
+func (m *ValidateResourceTypeConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateResourceTypeConfig_Request.Marshal(b, m, deterministic)
+}
+func (m *ValidateResourceTypeConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResourceTypeConfig_Request.Merge(m, src)
+}
+func (m *ValidateResourceTypeConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateResourceTypeConfig_Request.Size(m)
+}
+func (m *ValidateResourceTypeConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResourceTypeConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResourceTypeConfig_Request proto.InternalMessageInfo
+
+func (m *ValidateResourceTypeConfig_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ValidateResourceTypeConfig_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ValidateResourceTypeConfig_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
+}
+
+type ValidateResourceTypeConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateResourceTypeConfig_Response) Reset()         { *m = ValidateResourceTypeConfig_Response{} }
+func (m *ValidateResourceTypeConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateResourceTypeConfig_Response) ProtoMessage()    {}
+func (*ValidateResourceTypeConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{18, 1}
+}
+
+func (m *ValidateResourceTypeConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateResourceTypeConfig_Response.Unmarshal(m, b)
+}
+func (m *ValidateResourceTypeConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateResourceTypeConfig_Response.Marshal(b, m, deterministic)
+}
+func (m *ValidateResourceTypeConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateResourceTypeConfig_Response.Merge(m, src)
+}
+func (m *ValidateResourceTypeConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateResourceTypeConfig_Response.Size(m)
+}
+func (m *ValidateResourceTypeConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateResourceTypeConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateResourceTypeConfig_Response proto.InternalMessageInfo
+
+func (m *ValidateResourceTypeConfig_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ValidateDataSourceConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateDataSourceConfig) Reset()         { *m = ValidateDataSourceConfig{} }
+func (m *ValidateDataSourceConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateDataSourceConfig) ProtoMessage()    {}
+func (*ValidateDataSourceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{19}
+}
+
+func (m *ValidateDataSourceConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateDataSourceConfig.Unmarshal(m, b)
+}
+func (m *ValidateDataSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateDataSourceConfig.Marshal(b, m, deterministic)
+}
+func (m *ValidateDataSourceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDataSourceConfig.Merge(m, src)
+}
+func (m *ValidateDataSourceConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateDataSourceConfig.Size(m)
+}
+func (m *ValidateDataSourceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDataSourceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDataSourceConfig proto.InternalMessageInfo
+
+type ValidateDataSourceConfig_Request struct {
+	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateDataSourceConfig_Request) Reset()         { *m = ValidateDataSourceConfig_Request{} }
+func (m *ValidateDataSourceConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateDataSourceConfig_Request) ProtoMessage()    {}
+func (*ValidateDataSourceConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{19, 0}
+}
+
+func (m *ValidateDataSourceConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateDataSourceConfig_Request.Unmarshal(m, b)
+}
+func (m *ValidateDataSourceConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateDataSourceConfig_Request.Marshal(b, m, deterministic)
+}
+func (m *ValidateDataSourceConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDataSourceConfig_Request.Merge(m, src)
+}
+func (m *ValidateDataSourceConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateDataSourceConfig_Request.Size(m)
+}
+func (m *ValidateDataSourceConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDataSourceConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDataSourceConfig_Request proto.InternalMessageInfo
+
+func (m *ValidateDataSourceConfig_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ValidateDataSourceConfig_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type ValidateDataSourceConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateDataSourceConfig_Response) Reset()         { *m = ValidateDataSourceConfig_Response{} }
+func (m *ValidateDataSourceConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateDataSourceConfig_Response) ProtoMessage()    {}
+func (*ValidateDataSourceConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{19, 1}
+}
+
+func (m *ValidateDataSourceConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateDataSourceConfig_Response.Unmarshal(m, b)
+}
+func (m *ValidateDataSourceConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateDataSourceConfig_Response.Marshal(b, m, deterministic)
+}
+func (m *ValidateDataSourceConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDataSourceConfig_Response.Merge(m, src)
+}
+func (m *ValidateDataSourceConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateDataSourceConfig_Response.Size(m)
+}
+func (m *ValidateDataSourceConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDataSourceConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDataSourceConfig_Response proto.InternalMessageInfo
+
+func (m *ValidateDataSourceConfig_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type Configure struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Configure) Reset()         { *m = Configure{} }
+func (m *Configure) String() string { return proto.CompactTextString(m) }
+func (*Configure) ProtoMessage()    {}
+func (*Configure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{20}
+}
+
+func (m *Configure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configure.Unmarshal(m, b)
+}
+func (m *Configure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configure.Marshal(b, m, deterministic)
+}
+func (m *Configure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configure.Merge(m, src)
+}
+func (m *Configure) XXX_Size() int {
+	return xxx_messageInfo_Configure.Size(m)
+}
+func (m *Configure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configure proto.InternalMessageInfo
+
+type Configure_Request struct {
+	TerraformVersion     string              `protobuf:"bytes,1,opt,name=terraform_version,json=terraformVersion,proto3" json:"terraform_version,omitempty"`
+	Config               *DynamicValue       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ClientCapabilities   *ClientCapabilities `protobuf:"bytes,3,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Configure_Request) Reset()         { *m = Configure_Request{} }
+func (m *Configure_Request) String() string { return proto.CompactTextString(m) }
+func (*Configure_Request) ProtoMessage()    {}
+func (*Configure_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{20, 0}
+}
+
+func (m *Configure_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configure_Request.Unmarshal(m, b)
+}
+func (m *Configure_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configure_Request.Marshal(b, m, deterministic)
+}
+func (m *Configure_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configure_Request.Merge(m, src)
+}
+func (m *Configure_Request) XXX_Size() int {
+	return xxx_messageInfo_Configure_Request.Size(m)
+}
+func (m *Configure_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configure_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configure_Request proto.InternalMessageInfo
+
+func (m *Configure_Request) GetTerraformVersion() string {
+	if m != nil {
+		return m.TerraformVersion
+	}
+	return ""
+}
+
+func (m *Configure_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *Configure_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
+}
+
+type Configure_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Configure_Response) Reset()         { *m = Configure_Response{} }
+func (m *Configure_Response) String() string { return proto.CompactTextString(m) }
+func (*Configure_Response) ProtoMessage()    {}
+func (*Configure_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{20, 1}
+}
+
+func (m *Configure_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Configure_Response.Unmarshal(m, b)
+}
+func (m *Configure_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Configure_Response.Marshal(b, m, deterministic)
+}
+func (m *Configure_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configure_Response.Merge(m, src)
+}
+func (m *Configure_Response) XXX_Size() int {
+	return xxx_messageInfo_Configure_Response.Size(m)
+}
+func (m *Configure_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configure_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configure_Response proto.InternalMessageInfo
+
+func (m *Configure_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ReadResource struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadResource) Reset()         { *m = ReadResource{} }
+func (m *ReadResource) String() string { return proto.CompactTextString(m) }
+func (*ReadResource) ProtoMessage()    {}
+func (*ReadResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{21}
+}
+
+func (m *ReadResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadResource.Unmarshal(m, b)
+}
+func (m *ReadResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadResource.Marshal(b, m, deterministic)
+}
+func (m *ReadResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadResource.Merge(m, src)
+}
+func (m *ReadResource) XXX_Size() int {
+	return xxx_messageInfo_ReadResource.Size(m)
+}
+func (m *ReadResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadResource proto.InternalMessageInfo
+
+// Request is the message that is sent to the provider during the
+// ReadResource RPC.
+//
+// This message intentionally does not include configuration data as any
+// configuration-based or configuration-conditional changes should occur
+// during the PlanResourceChange RPC. Additionally, the configuration is
+// not guaranteed to be wholly known nor match the given prior state, which
+// could lead to unexpected provider behaviors for practitioners.
+type ReadResource_Request struct {
+	TypeName             string                `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	CurrentState         *DynamicValue         `protobuf:"bytes,2,opt,name=current_state,json=currentState,proto3" json:"current_state,omitempty"`
+	Private              []byte                `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
+	ProviderMeta         *DynamicValue         `protobuf:"bytes,4,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	ClientCapabilities   *ClientCapabilities   `protobuf:"bytes,5,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	CurrentIdentity      *ResourceIdentityData `protobuf:"bytes,6,opt,name=current_identity,json=currentIdentity,proto3" json:"current_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReadResource_Request) Reset()         { *m = ReadResource_Request{} }
+func (m *ReadResource_Request) String() string { return proto.CompactTextString(m) }
+func (*ReadResource_Request) ProtoMessage()    {}
+func (*ReadResource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{21, 0}
+}
+
+func (m *ReadResource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadResource_Request.Unmarshal(m, b)
+}
+func (m *ReadResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadResource_Request.Marshal(b, m, deterministic)
+}
+func (m *ReadResource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadResource_Request.Merge(m, src)
+}
+func (m *ReadResource_Request) XXX_Size() int {
+	return xxx_messageInfo_ReadResource_Request.Size(m)
+}
+func (m *ReadResource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadResource_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadResource_Request proto.InternalMessageInfo
+
+func (m *ReadResource_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ReadResource_Request) GetCurrentState() *DynamicValue {
+	if m != nil {
+		return m.CurrentState
+	}
+	return nil
+}
+
+func (m *ReadResource_Request) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+func (m *ReadResource_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+func (m *ReadResource_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
+}
+
+func (m *ReadResource_Request) GetCurrentIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.CurrentIdentity
+	}
+	return nil
+}
+
+type ReadResource_Response struct {
+	NewState    *DynamicValue `protobuf:"bytes,1,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Private     []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
+	// deferred is set if the provider is deferring the change. If set the caller
+	// needs to handle the deferral.
+	Deferred             *Deferred             `protobuf:"bytes,4,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	NewIdentity          *ResourceIdentityData `protobuf:"bytes,5,opt,name=new_identity,json=newIdentity,proto3" json:"new_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReadResource_Response) Reset()         { *m = ReadResource_Response{} }
+func (m *ReadResource_Response) String() string { return proto.CompactTextString(m) }
+func (*ReadResource_Response) ProtoMessage()    {}
+func (*ReadResource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{21, 1}
+}
+
+func (m *ReadResource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadResource_Response.Unmarshal(m, b)
+}
+func (m *ReadResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadResource_Response.Marshal(b, m, deterministic)
+}
+func (m *ReadResource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadResource_Response.Merge(m, src)
+}
+func (m *ReadResource_Response) XXX_Size() int {
+	return xxx_messageInfo_ReadResource_Response.Size(m)
+}
+func (m *ReadResource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadResource_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadResource_Response proto.InternalMessageInfo
+
+func (m *ReadResource_Response) GetNewState() *DynamicValue {
+	if m != nil {
+		return m.NewState
+	}
+	return nil
+}
+
+func (m *ReadResource_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *ReadResource_Response) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+func (m *ReadResource_Response) GetDeferred() *Deferred {
+	if m != nil {
+		return m.Deferred
+	}
+	return nil
+}
+
+func (m *ReadResource_Response) GetNewIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.NewIdentity
+	}
+	return nil
+}
+
+type PlanResourceChange struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanResourceChange) Reset()         { *m = PlanResourceChange{} }
+func (m *PlanResourceChange) String() string { return proto.CompactTextString(m) }
+func (*PlanResourceChange) ProtoMessage()    {}
+func (*PlanResourceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{22}
+}
+
+func (m *PlanResourceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanResourceChange.Unmarshal(m, b)
+}
+func (m *PlanResourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanResourceChange.Marshal(b, m, deterministic)
+}
+func (m *PlanResourceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResourceChange.Merge(m, src)
+}
+func (m *PlanResourceChange) XXX_Size() int {
+	return xxx_messageInfo_PlanResourceChange.Size(m)
+}
+func (m *PlanResourceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResourceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanResourceChange proto.InternalMessageInfo
+
+type PlanResourceChange_Request struct {
+	TypeName             string                `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	PriorState           *DynamicValue         `protobuf:"bytes,2,opt,name=prior_state,json=priorState,proto3" json:"prior_state,omitempty"`
+	ProposedNewState     *DynamicValue         `protobuf:"bytes,3,opt,name=proposed_new_state,json=proposedNewState,proto3" json:"proposed_new_state,omitempty"`
+	Config               *DynamicValue         `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	PriorPrivate         []byte                `protobuf:"bytes,5,opt,name=prior_private,json=priorPrivate,proto3" json:"prior_private,omitempty"`
+	ProviderMeta         *DynamicValue         `protobuf:"bytes,6,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	ClientCapabilities   *ClientCapabilities   `protobuf:"bytes,7,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	PriorIdentity        *ResourceIdentityData `protobuf:"bytes,8,opt,name=prior_identity,json=priorIdentity,proto3" json:"prior_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PlanResourceChange_Request) Reset()         { *m = PlanResourceChange_Request{} }
+func (m *PlanResourceChange_Request) String() string { return proto.CompactTextString(m) }
+func (*PlanResourceChange_Request) ProtoMessage()    {}
+func (*PlanResourceChange_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{22, 0}
+}
+
+func (m *PlanResourceChange_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanResourceChange_Request.Unmarshal(m, b)
+}
+func (m *PlanResourceChange_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanResourceChange_Request.Marshal(b, m, deterministic)
+}
+func (m *PlanResourceChange_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResourceChange_Request.Merge(m, src)
+}
+func (m *PlanResourceChange_Request) XXX_Size() int {
+	return xxx_messageInfo_PlanResourceChange_Request.Size(m)
+}
+func (m *PlanResourceChange_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResourceChange_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanResourceChange_Request proto.InternalMessageInfo
+
+func (m *PlanResourceChange_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *PlanResourceChange_Request) GetPriorState() *DynamicValue {
+	if m != nil {
+		return m.PriorState
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetProposedNewState() *DynamicValue {
+	if m != nil {
+		return m.ProposedNewState
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetPriorPrivate() []byte {
+	if m != nil {
+		return m.PriorPrivate
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Request) GetPriorIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.PriorIdentity
+	}
+	return nil
+}
+
+type PlanResourceChange_Response struct {
+	PlannedState    *DynamicValue    `protobuf:"bytes,1,opt,name=planned_state,json=plannedState,proto3" json:"planned_state,omitempty"`
+	RequiresReplace []*AttributePath `protobuf:"bytes,2,rep,name=requires_replace,json=requiresReplace,proto3" json:"requires_replace,omitempty"`
+	PlannedPrivate  []byte           `protobuf:"bytes,3,opt,name=planned_private,json=plannedPrivate,proto3" json:"planned_private,omitempty"`
+	Diagnostics     []*Diagnostic    `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// This may be set only by the helper/schema "SDK" in the main Terraform
+	// repository, to request that Terraform Core >=0.12 permit additional
+	// inconsistencies that can result from the legacy SDK type system
+	// and its imprecise mapping to the >=0.12 type system.
+	// The change in behavior implied by this flag makes sense only for the
+	// specific details of the legacy SDK type system, and are not a general
+	// mechanism to avoid proper type handling in providers.
+	//
+	//     ====              DO NOT USE THIS              ====
+	//     ==== THIS MUST BE LEFT UNSET IN ALL OTHER SDKS ====
+	//     ====              DO NOT USE THIS              ====
+	LegacyTypeSystem bool `protobuf:"varint,5,opt,name=legacy_type_system,json=legacyTypeSystem,proto3" json:"legacy_type_system,omitempty"`
+	// deferred is set if the provider is deferring the change. If set the caller
+	// needs to handle the deferral.
+	Deferred             *Deferred             `protobuf:"bytes,6,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	PlannedIdentity      *ResourceIdentityData `protobuf:"bytes,7,opt,name=planned_identity,json=plannedIdentity,proto3" json:"planned_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PlanResourceChange_Response) Reset()         { *m = PlanResourceChange_Response{} }
+func (m *PlanResourceChange_Response) String() string { return proto.CompactTextString(m) }
+func (*PlanResourceChange_Response) ProtoMessage()    {}
+func (*PlanResourceChange_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{22, 1}
+}
+
+func (m *PlanResourceChange_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanResourceChange_Response.Unmarshal(m, b)
+}
+func (m *PlanResourceChange_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanResourceChange_Response.Marshal(b, m, deterministic)
+}
+func (m *PlanResourceChange_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResourceChange_Response.Merge(m, src)
+}
+func (m *PlanResourceChange_Response) XXX_Size() int {
+	return xxx_messageInfo_PlanResourceChange_Response.Size(m)
+}
+func (m *PlanResourceChange_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResourceChange_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanResourceChange_Response proto.InternalMessageInfo
+
+func (m *PlanResourceChange_Response) GetPlannedState() *DynamicValue {
+	if m != nil {
+		return m.PlannedState
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetRequiresReplace() []*AttributePath {
+	if m != nil {
+		return m.RequiresReplace
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetPlannedPrivate() []byte {
+	if m != nil {
+		return m.PlannedPrivate
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetLegacyTypeSystem() bool {
+	if m != nil {
+		return m.LegacyTypeSystem
+	}
+	return false
+}
+
+func (m *PlanResourceChange_Response) GetDeferred() *Deferred {
+	if m != nil {
+		return m.Deferred
+	}
+	return nil
+}
+
+func (m *PlanResourceChange_Response) GetPlannedIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.PlannedIdentity
+	}
+	return nil
+}
+
+type ApplyResourceChange struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyResourceChange) Reset()         { *m = ApplyResourceChange{} }
+func (m *ApplyResourceChange) String() string { return proto.CompactTextString(m) }
+func (*ApplyResourceChange) ProtoMessage()    {}
+func (*ApplyResourceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{23}
+}
+
+func (m *ApplyResourceChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyResourceChange.Unmarshal(m, b)
+}
+func (m *ApplyResourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyResourceChange.Marshal(b, m, deterministic)
+}
+func (m *ApplyResourceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyResourceChange.Merge(m, src)
+}
+func (m *ApplyResourceChange) XXX_Size() int {
+	return xxx_messageInfo_ApplyResourceChange.Size(m)
+}
+func (m *ApplyResourceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyResourceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyResourceChange proto.InternalMessageInfo
+
+type ApplyResourceChange_Request struct {
+	TypeName             string                `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	PriorState           *DynamicValue         `protobuf:"bytes,2,opt,name=prior_state,json=priorState,proto3" json:"prior_state,omitempty"`
+	PlannedState         *DynamicValue         `protobuf:"bytes,3,opt,name=planned_state,json=plannedState,proto3" json:"planned_state,omitempty"`
+	Config               *DynamicValue         `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	PlannedPrivate       []byte                `protobuf:"bytes,5,opt,name=planned_private,json=plannedPrivate,proto3" json:"planned_private,omitempty"`
+	ProviderMeta         *DynamicValue         `protobuf:"bytes,6,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	PlannedIdentity      *ResourceIdentityData `protobuf:"bytes,7,opt,name=planned_identity,json=plannedIdentity,proto3" json:"planned_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ApplyResourceChange_Request) Reset()         { *m = ApplyResourceChange_Request{} }
+func (m *ApplyResourceChange_Request) String() string { return proto.CompactTextString(m) }
+func (*ApplyResourceChange_Request) ProtoMessage()    {}
+func (*ApplyResourceChange_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{23, 0}
+}
+
+func (m *ApplyResourceChange_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyResourceChange_Request.Unmarshal(m, b)
+}
+func (m *ApplyResourceChange_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyResourceChange_Request.Marshal(b, m, deterministic)
+}
+func (m *ApplyResourceChange_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyResourceChange_Request.Merge(m, src)
+}
+func (m *ApplyResourceChange_Request) XXX_Size() int {
+	return xxx_messageInfo_ApplyResourceChange_Request.Size(m)
+}
+func (m *ApplyResourceChange_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyResourceChange_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyResourceChange_Request proto.InternalMessageInfo
+
+func (m *ApplyResourceChange_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ApplyResourceChange_Request) GetPriorState() *DynamicValue {
+	if m != nil {
+		return m.PriorState
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetPlannedState() *DynamicValue {
+	if m != nil {
+		return m.PlannedState
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetPlannedPrivate() []byte {
+	if m != nil {
+		return m.PlannedPrivate
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Request) GetPlannedIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.PlannedIdentity
+	}
+	return nil
+}
+
+type ApplyResourceChange_Response struct {
+	NewState    *DynamicValue `protobuf:"bytes,1,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
+	Private     []byte        `protobuf:"bytes,2,opt,name=private,proto3" json:"private,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// This may be set only by the helper/schema "SDK" in the main Terraform
+	// repository, to request that Terraform Core >=0.12 permit additional
+	// inconsistencies that can result from the legacy SDK type system
+	// and its imprecise mapping to the >=0.12 type system.
+	// The change in behavior implied by this flag makes sense only for the
+	// specific details of the legacy SDK type system, and are not a general
+	// mechanism to avoid proper type handling in providers.
+	//
+	//     ====              DO NOT USE THIS              ====
+	//     ==== THIS MUST BE LEFT UNSET IN ALL OTHER SDKS ====
+	//     ====              DO NOT USE THIS              ====
+	LegacyTypeSystem     bool                  `protobuf:"varint,4,opt,name=legacy_type_system,json=legacyTypeSystem,proto3" json:"legacy_type_system,omitempty"`
+	NewIdentity          *ResourceIdentityData `protobuf:"bytes,5,opt,name=new_identity,json=newIdentity,proto3" json:"new_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ApplyResourceChange_Response) Reset()         { *m = ApplyResourceChange_Response{} }
+func (m *ApplyResourceChange_Response) String() string { return proto.CompactTextString(m) }
+func (*ApplyResourceChange_Response) ProtoMessage()    {}
+func (*ApplyResourceChange_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{23, 1}
+}
+
+func (m *ApplyResourceChange_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplyResourceChange_Response.Unmarshal(m, b)
+}
+func (m *ApplyResourceChange_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplyResourceChange_Response.Marshal(b, m, deterministic)
+}
+func (m *ApplyResourceChange_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyResourceChange_Response.Merge(m, src)
+}
+func (m *ApplyResourceChange_Response) XXX_Size() int {
+	return xxx_messageInfo_ApplyResourceChange_Response.Size(m)
+}
+func (m *ApplyResourceChange_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyResourceChange_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyResourceChange_Response proto.InternalMessageInfo
+
+func (m *ApplyResourceChange_Response) GetNewState() *DynamicValue {
+	if m != nil {
+		return m.NewState
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Response) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *ApplyResourceChange_Response) GetLegacyTypeSystem() bool {
+	if m != nil {
+		return m.LegacyTypeSystem
+	}
+	return false
+}
+
+func (m *ApplyResourceChange_Response) GetNewIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.NewIdentity
+	}
+	return nil
+}
+
+type ImportResourceState struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportResourceState) Reset()         { *m = ImportResourceState{} }
+func (m *ImportResourceState) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState) ProtoMessage()    {}
+func (*ImportResourceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{24}
+}
+
+func (m *ImportResourceState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState.Unmarshal(m, b)
+}
+func (m *ImportResourceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState.Marshal(b, m, deterministic)
+}
+func (m *ImportResourceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState.Merge(m, src)
+}
+func (m *ImportResourceState) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState.Size(m)
+}
+func (m *ImportResourceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState proto.InternalMessageInfo
+
+type ImportResourceState_Request struct {
+	TypeName             string                `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Id                   string                `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ClientCapabilities   *ClientCapabilities   `protobuf:"bytes,3,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	Identity             *ResourceIdentityData `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ImportResourceState_Request) Reset()         { *m = ImportResourceState_Request{} }
+func (m *ImportResourceState_Request) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState_Request) ProtoMessage()    {}
+func (*ImportResourceState_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{24, 0}
+}
+
+func (m *ImportResourceState_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState_Request.Unmarshal(m, b)
+}
+func (m *ImportResourceState_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState_Request.Marshal(b, m, deterministic)
+}
+func (m *ImportResourceState_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState_Request.Merge(m, src)
+}
+func (m *ImportResourceState_Request) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState_Request.Size(m)
+}
+func (m *ImportResourceState_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState_Request proto.InternalMessageInfo
+
+func (m *ImportResourceState_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ImportResourceState_Request) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ImportResourceState_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
+}
+
+func (m *ImportResourceState_Request) GetIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.Identity
+	}
+	return nil
+}
+
+type ImportResourceState_ImportedResource struct {
+	TypeName             string                `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	State                *DynamicValue         `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Private              []byte                `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
+	Identity             *ResourceIdentityData `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ImportResourceState_ImportedResource) Reset()         { *m = ImportResourceState_ImportedResource{} }
+func (m *ImportResourceState_ImportedResource) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState_ImportedResource) ProtoMessage()    {}
+func (*ImportResourceState_ImportedResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{24, 1}
+}
+
+func (m *ImportResourceState_ImportedResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState_ImportedResource.Unmarshal(m, b)
+}
+func (m *ImportResourceState_ImportedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState_ImportedResource.Marshal(b, m, deterministic)
+}
+func (m *ImportResourceState_ImportedResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState_ImportedResource.Merge(m, src)
+}
+func (m *ImportResourceState_ImportedResource) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState_ImportedResource.Size(m)
+}
+func (m *ImportResourceState_ImportedResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState_ImportedResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState_ImportedResource proto.InternalMessageInfo
+
+func (m *ImportResourceState_ImportedResource) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ImportResourceState_ImportedResource) GetState() *DynamicValue {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ImportResourceState_ImportedResource) GetPrivate() []byte {
+	if m != nil {
+		return m.Private
+	}
+	return nil
+}
+
+func (m *ImportResourceState_ImportedResource) GetIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.Identity
+	}
+	return nil
+}
+
+type ImportResourceState_Response struct {
+	ImportedResources []*ImportResourceState_ImportedResource `protobuf:"bytes,1,rep,name=imported_resources,json=importedResources,proto3" json:"imported_resources,omitempty"`
+	Diagnostics       []*Diagnostic                           `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// deferred is set if the provider is deferring the change. If set the caller
+	// needs to handle the deferral.
+	Deferred             *Deferred `protobuf:"bytes,3,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ImportResourceState_Response) Reset()         { *m = ImportResourceState_Response{} }
+func (m *ImportResourceState_Response) String() string { return proto.CompactTextString(m) }
+func (*ImportResourceState_Response) ProtoMessage()    {}
+func (*ImportResourceState_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{24, 2}
+}
+
+func (m *ImportResourceState_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResourceState_Response.Unmarshal(m, b)
+}
+func (m *ImportResourceState_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResourceState_Response.Marshal(b, m, deterministic)
+}
+func (m *ImportResourceState_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResourceState_Response.Merge(m, src)
+}
+func (m *ImportResourceState_Response) XXX_Size() int {
+	return xxx_messageInfo_ImportResourceState_Response.Size(m)
+}
+func (m *ImportResourceState_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResourceState_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResourceState_Response proto.InternalMessageInfo
+
+func (m *ImportResourceState_Response) GetImportedResources() []*ImportResourceState_ImportedResource {
+	if m != nil {
+		return m.ImportedResources
+	}
+	return nil
+}
+
+func (m *ImportResourceState_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *ImportResourceState_Response) GetDeferred() *Deferred {
+	if m != nil {
+		return m.Deferred
+	}
+	return nil
+}
+
+type MoveResourceState struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveResourceState) Reset()         { *m = MoveResourceState{} }
+func (m *MoveResourceState) String() string { return proto.CompactTextString(m) }
+func (*MoveResourceState) ProtoMessage()    {}
+func (*MoveResourceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{25}
+}
+
+func (m *MoveResourceState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveResourceState.Unmarshal(m, b)
+}
+func (m *MoveResourceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveResourceState.Marshal(b, m, deterministic)
+}
+func (m *MoveResourceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveResourceState.Merge(m, src)
+}
+func (m *MoveResourceState) XXX_Size() int {
+	return xxx_messageInfo_MoveResourceState.Size(m)
+}
+func (m *MoveResourceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveResourceState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveResourceState proto.InternalMessageInfo
+
+type MoveResourceState_Request struct {
+	// The address of the provider the resource is being moved from.
+	SourceProviderAddress string `protobuf:"bytes,1,opt,name=source_provider_address,json=sourceProviderAddress,proto3" json:"source_provider_address,omitempty"`
+	// The resource type that the resource is being moved from.
+	SourceTypeName string `protobuf:"bytes,2,opt,name=source_type_name,json=sourceTypeName,proto3" json:"source_type_name,omitempty"`
+	// The schema version of the resource type that the resource is being
+	// moved from.
+	SourceSchemaVersion int64 `protobuf:"varint,3,opt,name=source_schema_version,json=sourceSchemaVersion,proto3" json:"source_schema_version,omitempty"`
+	// The raw state of the resource being moved. Only the json field is
+	// populated, as there should be no legacy providers using the flatmap
+	// format that support newly introduced RPCs.
+	SourceState *RawState `protobuf:"bytes,4,opt,name=source_state,json=sourceState,proto3" json:"source_state,omitempty"`
+	// The resource type that the resource is being moved to.
+	TargetTypeName string `protobuf:"bytes,5,opt,name=target_type_name,json=targetTypeName,proto3" json:"target_type_name,omitempty"`
+	// The private state of the resource being moved.
+	SourcePrivate []byte `protobuf:"bytes,6,opt,name=source_private,json=sourcePrivate,proto3" json:"source_private,omitempty"`
+	// The raw identity of the resource being moved. Only the json field is
+	// populated, as there should be no legacy providers using the flatmap
+	// format that support newly introduced RPCs.
+	SourceIdentity *RawState `protobuf:"bytes,7,opt,name=source_identity,json=sourceIdentity,proto3" json:"source_identity,omitempty"`
+	// The identity schema version of the resource type that the resource
+	// is being moved from.
+	SourceIdentitySchemaVersion int64    `protobuf:"varint,8,opt,name=source_identity_schema_version,json=sourceIdentitySchemaVersion,proto3" json:"source_identity_schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{} `json:"-"`
+	XXX_unrecognized            []byte   `json:"-"`
+	XXX_sizecache               int32    `json:"-"`
+}
+
+func (m *MoveResourceState_Request) Reset()         { *m = MoveResourceState_Request{} }
+func (m *MoveResourceState_Request) String() string { return proto.CompactTextString(m) }
+func (*MoveResourceState_Request) ProtoMessage()    {}
+func (*MoveResourceState_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{25, 0}
+}
+
+func (m *MoveResourceState_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveResourceState_Request.Unmarshal(m, b)
+}
+func (m *MoveResourceState_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveResourceState_Request.Marshal(b, m, deterministic)
+}
+func (m *MoveResourceState_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveResourceState_Request.Merge(m, src)
+}
+func (m *MoveResourceState_Request) XXX_Size() int {
+	return xxx_messageInfo_MoveResourceState_Request.Size(m)
+}
+func (m *MoveResourceState_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveResourceState_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveResourceState_Request proto.InternalMessageInfo
+
+func (m *MoveResourceState_Request) GetSourceProviderAddress() string {
+	if m != nil {
+		return m.SourceProviderAddress
+	}
+	return ""
+}
+
+func (m *MoveResourceState_Request) GetSourceTypeName() string {
+	if m != nil {
+		return m.SourceTypeName
+	}
+	return ""
+}
+
+func (m *MoveResourceState_Request) GetSourceSchemaVersion() int64 {
+	if m != nil {
+		return m.SourceSchemaVersion
+	}
+	return 0
+}
+
+func (m *MoveResourceState_Request) GetSourceState() *RawState {
+	if m != nil {
+		return m.SourceState
+	}
+	return nil
+}
+
+func (m *MoveResourceState_Request) GetTargetTypeName() string {
+	if m != nil {
+		return m.TargetTypeName
+	}
+	return ""
+}
+
+func (m *MoveResourceState_Request) GetSourcePrivate() []byte {
+	if m != nil {
+		return m.SourcePrivate
+	}
+	return nil
+}
+
+func (m *MoveResourceState_Request) GetSourceIdentity() *RawState {
+	if m != nil {
+		return m.SourceIdentity
+	}
+	return nil
+}
+
+func (m *MoveResourceState_Request) GetSourceIdentitySchemaVersion() int64 {
+	if m != nil {
+		return m.SourceIdentitySchemaVersion
+	}
+	return 0
+}
+
+type MoveResourceState_Response struct {
+	// The state of the resource after it has been moved.
+	TargetState *DynamicValue `protobuf:"bytes,1,opt,name=target_state,json=targetState,proto3" json:"target_state,omitempty"`
+	// Any diagnostics that occurred during the move.
+	Diagnostics []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// The private state of the resource after it has been moved.
+	TargetPrivate        []byte                `protobuf:"bytes,3,opt,name=target_private,json=targetPrivate,proto3" json:"target_private,omitempty"`
+	TargetIdentity       *ResourceIdentityData `protobuf:"bytes,4,opt,name=target_identity,json=targetIdentity,proto3" json:"target_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MoveResourceState_Response) Reset()         { *m = MoveResourceState_Response{} }
+func (m *MoveResourceState_Response) String() string { return proto.CompactTextString(m) }
+func (*MoveResourceState_Response) ProtoMessage()    {}
+func (*MoveResourceState_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{25, 1}
+}
+
+func (m *MoveResourceState_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveResourceState_Response.Unmarshal(m, b)
+}
+func (m *MoveResourceState_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveResourceState_Response.Marshal(b, m, deterministic)
+}
+func (m *MoveResourceState_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveResourceState_Response.Merge(m, src)
+}
+func (m *MoveResourceState_Response) XXX_Size() int {
+	return xxx_messageInfo_MoveResourceState_Response.Size(m)
+}
+func (m *MoveResourceState_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveResourceState_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveResourceState_Response proto.InternalMessageInfo
+
+func (m *MoveResourceState_Response) GetTargetState() *DynamicValue {
+	if m != nil {
+		return m.TargetState
+	}
+	return nil
+}
+
+func (m *MoveResourceState_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *MoveResourceState_Response) GetTargetPrivate() []byte {
+	if m != nil {
+		return m.TargetPrivate
+	}
+	return nil
+}
+
+func (m *MoveResourceState_Response) GetTargetIdentity() *ResourceIdentityData {
+	if m != nil {
+		return m.TargetIdentity
+	}
+	return nil
+}
+
+type ReadDataSource struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadDataSource) Reset()         { *m = ReadDataSource{} }
+func (m *ReadDataSource) String() string { return proto.CompactTextString(m) }
+func (*ReadDataSource) ProtoMessage()    {}
+func (*ReadDataSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{26}
+}
+
+func (m *ReadDataSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadDataSource.Unmarshal(m, b)
+}
+func (m *ReadDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadDataSource.Marshal(b, m, deterministic)
+}
+func (m *ReadDataSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDataSource.Merge(m, src)
+}
+func (m *ReadDataSource) XXX_Size() int {
+	return xxx_messageInfo_ReadDataSource.Size(m)
+}
+func (m *ReadDataSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDataSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadDataSource proto.InternalMessageInfo
+
+type ReadDataSource_Request struct {
+	TypeName             string              `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config               *DynamicValue       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ProviderMeta         *DynamicValue       `protobuf:"bytes,3,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+	ClientCapabilities   *ClientCapabilities `protobuf:"bytes,4,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReadDataSource_Request) Reset()         { *m = ReadDataSource_Request{} }
+func (m *ReadDataSource_Request) String() string { return proto.CompactTextString(m) }
+func (*ReadDataSource_Request) ProtoMessage()    {}
+func (*ReadDataSource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{26, 0}
+}
+
+func (m *ReadDataSource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadDataSource_Request.Unmarshal(m, b)
+}
+func (m *ReadDataSource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadDataSource_Request.Marshal(b, m, deterministic)
+}
+func (m *ReadDataSource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDataSource_Request.Merge(m, src)
+}
+func (m *ReadDataSource_Request) XXX_Size() int {
+	return xxx_messageInfo_ReadDataSource_Request.Size(m)
+}
+func (m *ReadDataSource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDataSource_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadDataSource_Request proto.InternalMessageInfo
+
+func (m *ReadDataSource_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *ReadDataSource_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *ReadDataSource_Request) GetProviderMeta() *DynamicValue {
+	if m != nil {
+		return m.ProviderMeta
+	}
+	return nil
+}
+
+func (m *ReadDataSource_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
+}
+
+type ReadDataSource_Response struct {
+	State       *DynamicValue `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// deferred is set if the provider is deferring the change. If set the caller
+	// needs to handle the deferral.
+	Deferred             *Deferred `protobuf:"bytes,3,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReadDataSource_Response) Reset()         { *m = ReadDataSource_Response{} }
+func (m *ReadDataSource_Response) String() string { return proto.CompactTextString(m) }
+func (*ReadDataSource_Response) ProtoMessage()    {}
+func (*ReadDataSource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{26, 1}
+}
+
+func (m *ReadDataSource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadDataSource_Response.Unmarshal(m, b)
+}
+func (m *ReadDataSource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadDataSource_Response.Marshal(b, m, deterministic)
+}
+func (m *ReadDataSource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadDataSource_Response.Merge(m, src)
+}
+func (m *ReadDataSource_Response) XXX_Size() int {
+	return xxx_messageInfo_ReadDataSource_Response.Size(m)
+}
+func (m *ReadDataSource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadDataSource_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadDataSource_Response proto.InternalMessageInfo
+
+func (m *ReadDataSource_Response) GetState() *DynamicValue {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ReadDataSource_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+func (m *ReadDataSource_Response) GetDeferred() *Deferred {
+	if m != nil {
+		return m.Deferred
+	}
+	return nil
+}
+
+type GetProvisionerSchema struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProvisionerSchema) Reset()         { *m = GetProvisionerSchema{} }
+func (m *GetProvisionerSchema) String() string { return proto.CompactTextString(m) }
+func (*GetProvisionerSchema) ProtoMessage()    {}
+func (*GetProvisionerSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{27}
+}
+
+func (m *GetProvisionerSchema) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProvisionerSchema.Unmarshal(m, b)
+}
+func (m *GetProvisionerSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProvisionerSchema.Marshal(b, m, deterministic)
+}
+func (m *GetProvisionerSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProvisionerSchema.Merge(m, src)
+}
+func (m *GetProvisionerSchema) XXX_Size() int {
+	return xxx_messageInfo_GetProvisionerSchema.Size(m)
+}
+func (m *GetProvisionerSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProvisionerSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProvisionerSchema proto.InternalMessageInfo
+
+type GetProvisionerSchema_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProvisionerSchema_Request) Reset()         { *m = GetProvisionerSchema_Request{} }
+func (m *GetProvisionerSchema_Request) String() string { return proto.CompactTextString(m) }
+func (*GetProvisionerSchema_Request) ProtoMessage()    {}
+func (*GetProvisionerSchema_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{27, 0}
+}
+
+func (m *GetProvisionerSchema_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProvisionerSchema_Request.Unmarshal(m, b)
+}
+func (m *GetProvisionerSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProvisionerSchema_Request.Marshal(b, m, deterministic)
+}
+func (m *GetProvisionerSchema_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProvisionerSchema_Request.Merge(m, src)
+}
+func (m *GetProvisionerSchema_Request) XXX_Size() int {
+	return xxx_messageInfo_GetProvisionerSchema_Request.Size(m)
+}
+func (m *GetProvisionerSchema_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProvisionerSchema_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProvisionerSchema_Request proto.InternalMessageInfo
+
+type GetProvisionerSchema_Response struct {
+	Provisioner          *Schema       `protobuf:"bytes,1,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetProvisionerSchema_Response) Reset()         { *m = GetProvisionerSchema_Response{} }
+func (m *GetProvisionerSchema_Response) String() string { return proto.CompactTextString(m) }
+func (*GetProvisionerSchema_Response) ProtoMessage()    {}
+func (*GetProvisionerSchema_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{27, 1}
+}
+
+func (m *GetProvisionerSchema_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProvisionerSchema_Response.Unmarshal(m, b)
+}
+func (m *GetProvisionerSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProvisionerSchema_Response.Marshal(b, m, deterministic)
+}
+func (m *GetProvisionerSchema_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProvisionerSchema_Response.Merge(m, src)
+}
+func (m *GetProvisionerSchema_Response) XXX_Size() int {
+	return xxx_messageInfo_GetProvisionerSchema_Response.Size(m)
+}
+func (m *GetProvisionerSchema_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProvisionerSchema_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProvisionerSchema_Response proto.InternalMessageInfo
+
+func (m *GetProvisionerSchema_Response) GetProvisioner() *Schema {
+	if m != nil {
+		return m.Provisioner
+	}
+	return nil
+}
+
+func (m *GetProvisionerSchema_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type ValidateProvisionerConfig struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateProvisionerConfig) Reset()         { *m = ValidateProvisionerConfig{} }
+func (m *ValidateProvisionerConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateProvisionerConfig) ProtoMessage()    {}
+func (*ValidateProvisionerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{28}
+}
+
+func (m *ValidateProvisionerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateProvisionerConfig.Unmarshal(m, b)
+}
+func (m *ValidateProvisionerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateProvisionerConfig.Marshal(b, m, deterministic)
+}
+func (m *ValidateProvisionerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateProvisionerConfig.Merge(m, src)
+}
+func (m *ValidateProvisionerConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateProvisionerConfig.Size(m)
+}
+func (m *ValidateProvisionerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateProvisionerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateProvisionerConfig proto.InternalMessageInfo
+
+type ValidateProvisionerConfig_Request struct {
+	Config               *DynamicValue `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateProvisionerConfig_Request) Reset()         { *m = ValidateProvisionerConfig_Request{} }
+func (m *ValidateProvisionerConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateProvisionerConfig_Request) ProtoMessage()    {}
+func (*ValidateProvisionerConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{28, 0}
+}
+
+func (m *ValidateProvisionerConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateProvisionerConfig_Request.Unmarshal(m, b)
+}
+func (m *ValidateProvisionerConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateProvisionerConfig_Request.Marshal(b, m, deterministic)
+}
+func (m *ValidateProvisionerConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateProvisionerConfig_Request.Merge(m, src)
+}
+func (m *ValidateProvisionerConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateProvisionerConfig_Request.Size(m)
+}
+func (m *ValidateProvisionerConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateProvisionerConfig_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateProvisionerConfig_Request proto.InternalMessageInfo
+
+func (m *ValidateProvisionerConfig_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type ValidateProvisionerConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ValidateProvisionerConfig_Response) Reset()         { *m = ValidateProvisionerConfig_Response{} }
+func (m *ValidateProvisionerConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateProvisionerConfig_Response) ProtoMessage()    {}
+func (*ValidateProvisionerConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{28, 1}
+}
+
+func (m *ValidateProvisionerConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateProvisionerConfig_Response.Unmarshal(m, b)
+}
+func (m *ValidateProvisionerConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateProvisionerConfig_Response.Marshal(b, m, deterministic)
+}
+func (m *ValidateProvisionerConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateProvisionerConfig_Response.Merge(m, src)
+}
+func (m *ValidateProvisionerConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateProvisionerConfig_Response.Size(m)
+}
+func (m *ValidateProvisionerConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateProvisionerConfig_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateProvisionerConfig_Response proto.InternalMessageInfo
+
+func (m *ValidateProvisionerConfig_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
 		return m.Diagnostics
 	}
 	return nil
 }
 
-type UpgradeResourceState struct {
+type ProvisionResource struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpgradeResourceState) Reset()         { *m = UpgradeResourceState{} }
-func (m *UpgradeResourceState) String() string { return proto.CompactTextString(m) }
-func (*UpgradeResourceState) ProtoMessage()    {}
-func (*UpgradeResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{8}
+func (m *ProvisionResource) Reset()         { *m = ProvisionResource{} }
+func (m *ProvisionResource) String() string { return proto.CompactTextString(m) }
+func (*ProvisionResource) ProtoMessage()    {}
+func (*ProvisionResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{29}
 }
 
-func (m *UpgradeResourceState) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpgradeResourceState.Unmarshal(m, b)
+func (m *ProvisionResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProvisionResource.Unmarshal(m, b)
 }
-func (m *UpgradeResourceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpgradeResourceState.Marshal(b, m, deterministic)
+func (m *ProvisionResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProvisionResource.Marshal(b, m, deterministic)
 }
-func (m *UpgradeResourceState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeResourceState.Merge(m, src)
+func (m *ProvisionResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionResource.Merge(m, src)
 }
-func (m *UpgradeResourceState) XXX_Size() int {
-	return xxx_messageInfo_UpgradeResourceState.Size(m)
+func (m *ProvisionResource) XXX_Size() int {
+	return xxx_messageInfo_ProvisionResource.Size(m)
 }
-func (m *UpgradeResourceState) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeResourceState.DiscardUnknown(m)
+func (m *ProvisionResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeResourceState proto.InternalMessageInfo
+var xxx_messageInfo_ProvisionResource proto.InternalMessageInfo
 
-type UpgradeResourceState_Request struct {
-	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	// version is the schema_version number recorded in the state file
-	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	// raw_state is the raw states as stored for the resource.  Core does
-	// not have access to the schema of prior_version, so it's the
-	// provider's responsibility to interpret this value using the
-	// appropriate older schema. The raw_state will be the json encoded
-	// state, or a legacy flat-mapped format.
-	RawState             *RawState `protobuf:"bytes,3,opt,name=raw_state,json=rawState,proto3" json:"raw_state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type ProvisionResource_Request struct {
+	Config               *DynamicValue `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Connection           *DynamicValue `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *UpgradeResourceState_Request) Reset()         { *m = UpgradeResourceState_Request{} }
-func (m *UpgradeResourceState_Request) String() string { return proto.CompactTextString(m) }
-func (*UpgradeResourceState_Request) ProtoMessage()    {}
-func (*UpgradeResourceState_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{8, 0}
+func (m *ProvisionResource_Request) Reset()         { *m = ProvisionResource_Request{} }
+func (m *ProvisionResource_Request) String() string { return proto.CompactTextString(m) }
+func (*ProvisionResource_Request) ProtoMessage()    {}
+func (*ProvisionResource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{29, 0}
 }
 
-func (m *UpgradeResourceState_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpgradeResourceState_Request.Unmarshal(m, b)
+func (m *ProvisionResource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProvisionResource_Request.Unmarshal(m, b)
 }
-func (m *UpgradeResourceState_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpgradeResourceState_Request.Marshal(b, m, deterministic)
+func (m *ProvisionResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProvisionResource_Request.Marshal(b, m, deterministic)
 }
-func (m *UpgradeResourceState_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeResourceState_Request.Merge(m, src)
+func (m *ProvisionResource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionResource_Request.Merge(m, src)
 }
-func (m *UpgradeResourceState_Request) XXX_Size() int {
-	return xxx_messageInfo_UpgradeResourceState_Request.Size(m)
+func (m *ProvisionResource_Request) XXX_Size() int {
+	return xxx_messageInfo_ProvisionResource_Request.Size(m)
 }
-func (m *UpgradeResourceState_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeResourceState_Request.DiscardUnknown(m)
+func (m *ProvisionResource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionResource_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeResourceState_Request proto.InternalMessageInfo
+var xxx_messageInfo_ProvisionResource_Request proto.InternalMessageInfo
 
-func (m *UpgradeResourceState_Request) GetTypeName() string {
+func (m *ProvisionResource_Request) GetConfig() *DynamicValue {
 	if m != nil {
-		return m.TypeName
+		return m.Config
 	}
-	return ""
+	return nil
 }
 
-func (m *UpgradeResourceState_Request) GetVersion() int64 {
+func (m *ProvisionResource_Request) GetConnection() *DynamicValue {
 	if m != nil {
-		return m.Version
+		return m.Connection
 	}
-	return 0
+	return nil
 }
 
-func (m *UpgradeResourceState_Request) GetRawState() *RawState {
+type ProvisionResource_Response struct {
+	Output               string        `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ProvisionResource_Response) Reset()         { *m = ProvisionResource_Response{} }
+func (m *ProvisionResource_Response) String() string { return proto.CompactTextString(m) }
+func (*ProvisionResource_Response) ProtoMessage()    {}
+func (*ProvisionResource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{29, 1}
+}
+
+func (m *ProvisionResource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProvisionResource_Response.Unmarshal(m, b)
+}
+func (m *ProvisionResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProvisionResource_Response.Marshal(b, m, deterministic)
+}
+func (m *ProvisionResource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvisionResource_Response.Merge(m, src)
+}
+func (m *ProvisionResource_Response) XXX_Size() int {
+	return xxx_messageInfo_ProvisionResource_Response.Size(m)
+}
+func (m *ProvisionResource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvisionResource_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProvisionResource_Response proto.InternalMessageInfo
+
+func (m *ProvisionResource_Response) GetOutput() string {
 	if m != nil {
-		return m.RawState
+		return m.Output
+	}
+	return ""
+}
+
+func (m *ProvisionResource_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
 	}
 	return nil
 }
 
-type UpgradeResourceState_Response struct {
-	// new_state is a msgpack-encoded data structure that, when interpreted with
-	// the _current_ schema for this resource type, is functionally equivalent to
-	// that which was given in prior_state_raw.
-	UpgradedState *DynamicValue `protobuf:"bytes,1,opt,name=upgraded_state,json=upgradedState,proto3" json:"upgraded_state,omitempty"`
-	// diagnostics describes any errors encountered during migration that could not
-	// be safely resolved, and warnings about any possibly-risky assumptions made
-	// in the upgrade process.
+type GetFunctions struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFunctions) Reset()         { *m = GetFunctions{} }
+func (m *GetFunctions) String() string { return proto.CompactTextString(m) }
+func (*GetFunctions) ProtoMessage()    {}
+func (*GetFunctions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{30}
+}
+
+func (m *GetFunctions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFunctions.Unmarshal(m, b)
+}
+func (m *GetFunctions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFunctions.Marshal(b, m, deterministic)
+}
+func (m *GetFunctions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFunctions.Merge(m, src)
+}
+func (m *GetFunctions) XXX_Size() int {
+	return xxx_messageInfo_GetFunctions.Size(m)
+}
+func (m *GetFunctions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFunctions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFunctions proto.InternalMessageInfo
+
+type GetFunctions_Request struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFunctions_Request) Reset()         { *m = GetFunctions_Request{} }
+func (m *GetFunctions_Request) String() string { return proto.CompactTextString(m) }
+func (*GetFunctions_Request) ProtoMessage()    {}
+func (*GetFunctions_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{30, 0}
+}
+
+func (m *GetFunctions_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFunctions_Request.Unmarshal(m, b)
+}
+func (m *GetFunctions_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFunctions_Request.Marshal(b, m, deterministic)
+}
+func (m *GetFunctions_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFunctions_Request.Merge(m, src)
+}
+func (m *GetFunctions_Request) XXX_Size() int {
+	return xxx_messageInfo_GetFunctions_Request.Size(m)
+}
+func (m *GetFunctions_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFunctions_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFunctions_Request proto.InternalMessageInfo
+
+type GetFunctions_Response struct {
+	// functions is a mapping of function names to definitions.
+	Functions map[string]*Function `protobuf:"bytes,1,rep,name=functions,proto3" json:"functions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// diagnostics is any warnings or errors.
 	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *UpgradeResourceState_Response) Reset()         { *m = UpgradeResourceState_Response{} }
-func (m *UpgradeResourceState_Response) String() string { return proto.CompactTextString(m) }
-func (*UpgradeResourceState_Response) ProtoMessage()    {}
-func (*UpgradeResourceState_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{8, 1}
+func (m *GetFunctions_Response) Reset()         { *m = GetFunctions_Response{} }
+func (m *GetFunctions_Response) String() string { return proto.CompactTextString(m) }
+func (*GetFunctions_Response) ProtoMessage()    {}
+func (*GetFunctions_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{30, 1}
+}
+
+func (m *GetFunctions_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFunctions_Response.Unmarshal(m, b)
+}
+func (m *GetFunctions_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFunctions_Response.Marshal(b, m, deterministic)
+}
+func (m *GetFunctions_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFunctions_Response.Merge(m, src)
+}
+func (m *GetFunctions_Response) XXX_Size() int {
+	return xxx_messageInfo_GetFunctions_Response.Size(m)
+}
+func (m *GetFunctions_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFunctions_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFunctions_Response proto.InternalMessageInfo
+
+func (m *GetFunctions_Response) GetFunctions() map[string]*Function {
+	if m != nil {
+		return m.Functions
+	}
+	return nil
+}
+
+func (m *GetFunctions_Response) GetDiagnostics() []*Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+type CallFunction struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallFunction) Reset()         { *m = CallFunction{} }
+func (m *CallFunction) String() string { return proto.CompactTextString(m) }
+func (*CallFunction) ProtoMessage()    {}
+func (*CallFunction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{31}
+}
+
+func (m *CallFunction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallFunction.Unmarshal(m, b)
+}
+func (m *CallFunction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallFunction.Marshal(b, m, deterministic)
+}
+func (m *CallFunction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallFunction.Merge(m, src)
+}
+func (m *CallFunction) XXX_Size() int {
+	return xxx_messageInfo_CallFunction.Size(m)
+}
+func (m *CallFunction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallFunction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallFunction proto.InternalMessageInfo
+
+type CallFunction_Request struct {
+	// name is the name of the function being called.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// arguments is the data of each function argument value.
+	Arguments            []*DynamicValue `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CallFunction_Request) Reset()         { *m = CallFunction_Request{} }
+func (m *CallFunction_Request) String() string { return proto.CompactTextString(m) }
+func (*CallFunction_Request) ProtoMessage()    {}
+func (*CallFunction_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{31, 0}
+}
+
+func (m *CallFunction_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallFunction_Request.Unmarshal(m, b)
+}
+func (m *CallFunction_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallFunction_Request.Marshal(b, m, deterministic)
+}
+func (m *CallFunction_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallFunction_Request.Merge(m, src)
+}
+func (m *CallFunction_Request) XXX_Size() int {
+	return xxx_messageInfo_CallFunction_Request.Size(m)
+}
+func (m *CallFunction_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallFunction_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallFunction_Request proto.InternalMessageInfo
+
+func (m *CallFunction_Request) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CallFunction_Request) GetArguments() []*DynamicValue {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+type CallFunction_Response struct {
+	// result is result value after running the function logic.
+	Result *DynamicValue `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// error is any error from the function logic.
+	Error                *FunctionError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CallFunction_Response) Reset()         { *m = CallFunction_Response{} }
+func (m *CallFunction_Response) String() string { return proto.CompactTextString(m) }
+func (*CallFunction_Response) ProtoMessage()    {}
+func (*CallFunction_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{31, 1}
 }
 
-func (m *UpgradeResourceState_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpgradeResourceState_Response.Unmarshal(m, b)
+func (m *CallFunction_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallFunction_Response.Unmarshal(m, b)
 }
-func (m *UpgradeResourceState_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpgradeResourceState_Response.Marshal(b, m, deterministic)
+func (m *CallFunction_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallFunction_Response.Marshal(b, m, deterministic)
 }
-func (m *UpgradeResourceState_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpgradeResourceState_Response.Merge(m, src)
+func (m *CallFunction_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallFunction_Response.Merge(m, src)
 }
-func (m *UpgradeResourceState_Response) XXX_Size() int {
-	return xxx_messageInfo_UpgradeResourceState_Response.Size(m)
+func (m *CallFunction_Response) XXX_Size() int {
+	return xxx_messageInfo_CallFunction_Response.Size(m)
 }
-func (m *UpgradeResourceState_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpgradeResourceState_Response.DiscardUnknown(m)
+func (m *CallFunction_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallFunction_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpgradeResourceState_Response proto.InternalMessageInfo
+var xxx_messageInfo_CallFunction_Response proto.InternalMessageInfo
 
-func (m *UpgradeResourceState_Response) GetUpgradedState() *DynamicValue {
+func (m *CallFunction_Response) GetResult() *DynamicValue {
 	if m != nil {
-		return m.UpgradedState
+		return m.Result
 	}
 	return nil
 }
 
-func (m *UpgradeResourceState_Response) GetDiagnostics() []*Diagnostic {
+func (m *CallFunction_Response) GetError() *FunctionError {
 	if m != nil {
-		return m.Diagnostics
+		return m.Error
 	}
 	return nil
 }
 
-type ValidateResourceTypeConfig struct {
+type ValidateEphemeralResourceConfig struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateResourceTypeConfig) Reset()         { *m = ValidateResourceTypeConfig{} }
-func (m *ValidateResourceTypeConfig) String() string { return proto.CompactTextString(m) }
-func (*ValidateResourceTypeConfig) ProtoMessage()    {}
-func (*ValidateResourceTypeConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{9}
+func (m *ValidateEphemeralResourceConfig) Reset()         { *m = ValidateEphemeralResourceConfig{} }
+func (m *ValidateEphemeralResourceConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateEphemeralResourceConfig) ProtoMessage()    {}
+func (*ValidateEphemeralResourceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{32}
 }
 
-func (m *ValidateResourceTypeConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateResourceTypeConfig.Unmarshal(m, b)
+func (m *ValidateEphemeralResourceConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig.Unmarshal(m, b)
 }
-func (m *ValidateResourceTypeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateResourceTypeConfig.Marshal(b, m, deterministic)
+func (m *ValidateEphemeralResourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig.Marshal(b, m, deterministic)
 }
-func (m *ValidateResourceTypeConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateResourceTypeConfig.Merge(m, src)
+func (m *ValidateEphemeralResourceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateEphemeralResourceConfig.Merge(m, src)
 }
-func (m *ValidateResourceTypeConfig) XXX_Size() int {
-	return xxx_messageInfo_ValidateResourceTypeConfig.Size(m)
+func (m *ValidateEphemeralResourceConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig.Size(m)
 }
-func (m *ValidateResourceTypeConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateResourceTypeConfig.DiscardUnknown(m)
+func (m *ValidateEphemeralResourceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateEphemeralResourceConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateResourceTypeConfig proto.InternalMessageInfo
+var xxx_messageInfo_ValidateEphemeralResourceConfig proto.InternalMessageInfo
 
-type ValidateResourceTypeConfig_Request struct {
+type ValidateEphemeralResourceConfig_Request struct {
 	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
 	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -1250,1493 +4579,1709 @@ type ValidateResourceTypeConfig_Request struct {
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ValidateResourceTypeConfig_Request) Reset()         { *m = ValidateResourceTypeConfig_Request{} }
-func (m *ValidateResourceTypeConfig_Request) String() string { return proto.CompactTextString(m) }
-func (*ValidateResourceTypeConfig_Request) ProtoMessage()    {}
-func (*ValidateResourceTypeConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{9, 0}
+func (m *ValidateEphemeralResourceConfig_Request) Reset() {
+	*m = ValidateEphemeralResourceConfig_Request{}
+}
+func (m *ValidateEphemeralResourceConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateEphemeralResourceConfig_Request) ProtoMessage()    {}
+func (*ValidateEphemeralResourceConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{32, 0}
 }
 
-func (m *ValidateResourceTypeConfig_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateResourceTypeConfig_Request.Unmarshal(m, b)
+func (m *ValidateEphemeralResourceConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig_Request.Unmarshal(m, b)
 }
-func (m *ValidateResourceTypeConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateResourceTypeConfig_Request.Marshal(b, m, deterministic)
+func (m *ValidateEphemeralResourceConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig_Request.Marshal(b, m, deterministic)
 }
-func (m *ValidateResourceTypeConfig_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateResourceTypeConfig_Request.Merge(m, src)
+func (m *ValidateEphemeralResourceConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateEphemeralResourceConfig_Request.Merge(m, src)
 }
-func (m *ValidateResourceTypeConfig_Request) XXX_Size() int {
-	return xxx_messageInfo_ValidateResourceTypeConfig_Request.Size(m)
+func (m *ValidateEphemeralResourceConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig_Request.Size(m)
 }
-func (m *ValidateResourceTypeConfig_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateResourceTypeConfig_Request.DiscardUnknown(m)
+func (m *ValidateEphemeralResourceConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateEphemeralResourceConfig_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateResourceTypeConfig_Request proto.InternalMessageInfo
+var xxx_messageInfo_ValidateEphemeralResourceConfig_Request proto.InternalMessageInfo
 
-func (m *ValidateResourceTypeConfig_Request) GetTypeName() string {
+func (m *ValidateEphemeralResourceConfig_Request) GetTypeName() string {
 	if m != nil {
 		return m.TypeName
 	}
 	return ""
 }
 
-func (m *ValidateResourceTypeConfig_Request) GetConfig() *DynamicValue {
+func (m *ValidateEphemeralResourceConfig_Request) GetConfig() *DynamicValue {
 	if m != nil {
 		return m.Config
 	}
 	return nil
 }
 
-type ValidateResourceTypeConfig_Response struct {
+type ValidateEphemeralResourceConfig_Response struct {
 	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ValidateResourceTypeConfig_Response) Reset()         { *m = ValidateResourceTypeConfig_Response{} }
-func (m *ValidateResourceTypeConfig_Response) String() string { return proto.CompactTextString(m) }
-func (*ValidateResourceTypeConfig_Response) ProtoMessage()    {}
-func (*ValidateResourceTypeConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{9, 1}
+func (m *ValidateEphemeralResourceConfig_Response) Reset() {
+	*m = ValidateEphemeralResourceConfig_Response{}
+}
+func (m *ValidateEphemeralResourceConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateEphemeralResourceConfig_Response) ProtoMessage()    {}
+func (*ValidateEphemeralResourceConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{32, 1}
 }
 
-func (m *ValidateResourceTypeConfig_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateResourceTypeConfig_Response.Unmarshal(m, b)
+func (m *ValidateEphemeralResourceConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig_Response.Unmarshal(m, b)
 }
-func (m *ValidateResourceTypeConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateResourceTypeConfig_Response.Marshal(b, m, deterministic)
+func (m *ValidateEphemeralResourceConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig_Response.Marshal(b, m, deterministic)
 }
-func (m *ValidateResourceTypeConfig_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateResourceTypeConfig_Response.Merge(m, src)
+func (m *ValidateEphemeralResourceConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateEphemeralResourceConfig_Response.Merge(m, src)
 }
-func (m *ValidateResourceTypeConfig_Response) XXX_Size() int {
-	return xxx_messageInfo_ValidateResourceTypeConfig_Response.Size(m)
+func (m *ValidateEphemeralResourceConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateEphemeralResourceConfig_Response.Size(m)
 }
-func (m *ValidateResourceTypeConfig_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateResourceTypeConfig_Response.DiscardUnknown(m)
+func (m *ValidateEphemeralResourceConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateEphemeralResourceConfig_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateResourceTypeConfig_Response proto.InternalMessageInfo
+var xxx_messageInfo_ValidateEphemeralResourceConfig_Response proto.InternalMessageInfo
 
-func (m *ValidateResourceTypeConfig_Response) GetDiagnostics() []*Diagnostic {
+func (m *ValidateEphemeralResourceConfig_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
 		return m.Diagnostics
 	}
 	return nil
 }
 
-type ValidateDataSourceConfig struct {
+type OpenEphemeralResource struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateDataSourceConfig) Reset()         { *m = ValidateDataSourceConfig{} }
-func (m *ValidateDataSourceConfig) String() string { return proto.CompactTextString(m) }
-func (*ValidateDataSourceConfig) ProtoMessage()    {}
-func (*ValidateDataSourceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{10}
+func (m *OpenEphemeralResource) Reset()         { *m = OpenEphemeralResource{} }
+func (m *OpenEphemeralResource) String() string { return proto.CompactTextString(m) }
+func (*OpenEphemeralResource) ProtoMessage()    {}
+func (*OpenEphemeralResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{33}
 }
 
-func (m *ValidateDataSourceConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateDataSourceConfig.Unmarshal(m, b)
+func (m *OpenEphemeralResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenEphemeralResource.Unmarshal(m, b)
 }
-func (m *ValidateDataSourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateDataSourceConfig.Marshal(b, m, deterministic)
+func (m *OpenEphemeralResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenEphemeralResource.Marshal(b, m, deterministic)
 }
-func (m *ValidateDataSourceConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateDataSourceConfig.Merge(m, src)
+func (m *OpenEphemeralResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenEphemeralResource.Merge(m, src)
 }
-func (m *ValidateDataSourceConfig) XXX_Size() int {
-	return xxx_messageInfo_ValidateDataSourceConfig.Size(m)
+func (m *OpenEphemeralResource) XXX_Size() int {
+	return xxx_messageInfo_OpenEphemeralResource.Size(m)
 }
-func (m *ValidateDataSourceConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateDataSourceConfig.DiscardUnknown(m)
+func (m *OpenEphemeralResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenEphemeralResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateDataSourceConfig proto.InternalMessageInfo
+var xxx_messageInfo_OpenEphemeralResource proto.InternalMessageInfo
 
-type ValidateDataSourceConfig_Request struct {
-	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type OpenEphemeralResource_Request struct {
+	TypeName             string              `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config               *DynamicValue       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ClientCapabilities   *ClientCapabilities `protobuf:"bytes,3,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *ValidateDataSourceConfig_Request) Reset()         { *m = ValidateDataSourceConfig_Request{} }
-func (m *ValidateDataSourceConfig_Request) String() string { return proto.CompactTextString(m) }
-func (*ValidateDataSourceConfig_Request) ProtoMessage()    {}
-func (*ValidateDataSourceConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{10, 0}
+func (m *OpenEphemeralResource_Request) Reset()         { *m = OpenEphemeralResource_Request{} }
+func (m *OpenEphemeralResource_Request) String() string { return proto.CompactTextString(m) }
+func (*OpenEphemeralResource_Request) ProtoMessage()    {}
+func (*OpenEphemeralResource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{33, 0}
 }
 
-func (m *ValidateDataSourceConfig_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateDataSourceConfig_Request.Unmarshal(m, b)
+func (m *OpenEphemeralResource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenEphemeralResource_Request.Unmarshal(m, b)
 }
-func (m *ValidateDataSourceConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateDataSourceConfig_Request.Marshal(b, m, deterministic)
+func (m *OpenEphemeralResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenEphemeralResource_Request.Marshal(b, m, deterministic)
 }
-func (m *ValidateDataSourceConfig_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateDataSourceConfig_Request.Merge(m, src)
+func (m *OpenEphemeralResource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenEphemeralResource_Request.Merge(m, src)
 }
-func (m *ValidateDataSourceConfig_Request) XXX_Size() int {
-	return xxx_messageInfo_ValidateDataSourceConfig_Request.Size(m)
+func (m *OpenEphemeralResource_Request) XXX_Size() int {
+	return xxx_messageInfo_OpenEphemeralResource_Request.Size(m)
 }
-func (m *ValidateDataSourceConfig_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateDataSourceConfig_Request.DiscardUnknown(m)
+func (m *OpenEphemeralResource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenEphemeralResource_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateDataSourceConfig_Request proto.InternalMessageInfo
+var xxx_messageInfo_OpenEphemeralResource_Request proto.InternalMessageInfo
 
-func (m *ValidateDataSourceConfig_Request) GetTypeName() string {
+func (m *OpenEphemeralResource_Request) GetTypeName() string {
 	if m != nil {
 		return m.TypeName
 	}
 	return ""
 }
 
-func (m *ValidateDataSourceConfig_Request) GetConfig() *DynamicValue {
+func (m *OpenEphemeralResource_Request) GetConfig() *DynamicValue {
 	if m != nil {
 		return m.Config
 	}
 	return nil
 }
 
-type ValidateDataSourceConfig_Response struct {
-	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *OpenEphemeralResource_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
 }
 
-func (m *ValidateDataSourceConfig_Response) Reset()         { *m = ValidateDataSourceConfig_Response{} }
-func (m *ValidateDataSourceConfig_Response) String() string { return proto.CompactTextString(m) }
-func (*ValidateDataSourceConfig_Response) ProtoMessage()    {}
-func (*ValidateDataSourceConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{10, 1}
+type OpenEphemeralResource_Response struct {
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// Types that are valid to be assigned to XRenewAt:
+	//	*OpenEphemeralResource_Response_RenewAt
+	XRenewAt isOpenEphemeralResource_Response_XRenewAt `protobuf_oneof:"_renew_at"`
+	Result   *DynamicValue                             `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// Types that are valid to be assigned to XPrivate:
+	//	*OpenEphemeralResource_Response_Private
+	XPrivate isOpenEphemeralResource_Response_XPrivate `protobuf_oneof:"_private"`
+	// deferred is set if the provider is deferring the change. If set the caller
+	// needs to handle the deferral.
+	Deferred             *Deferred `protobuf:"bytes,5,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *ValidateDataSourceConfig_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateDataSourceConfig_Response.Unmarshal(m, b)
+func (m *OpenEphemeralResource_Response) Reset()         { *m = OpenEphemeralResource_Response{} }
+func (m *OpenEphemeralResource_Response) String() string { return proto.CompactTextString(m) }
+func (*OpenEphemeralResource_Response) ProtoMessage()    {}
+func (*OpenEphemeralResource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{33, 1}
 }
-func (m *ValidateDataSourceConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateDataSourceConfig_Response.Marshal(b, m, deterministic)
+
+func (m *OpenEphemeralResource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenEphemeralResource_Response.Unmarshal(m, b)
 }
-func (m *ValidateDataSourceConfig_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateDataSourceConfig_Response.Merge(m, src)
+func (m *OpenEphemeralResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenEphemeralResource_Response.Marshal(b, m, deterministic)
 }
-func (m *ValidateDataSourceConfig_Response) XXX_Size() int {
-	return xxx_messageInfo_ValidateDataSourceConfig_Response.Size(m)
+func (m *OpenEphemeralResource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenEphemeralResource_Response.Merge(m, src)
 }
-func (m *ValidateDataSourceConfig_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateDataSourceConfig_Response.DiscardUnknown(m)
+func (m *OpenEphemeralResource_Response) XXX_Size() int {
+	return xxx_messageInfo_OpenEphemeralResource_Response.Size(m)
+}
+func (m *OpenEphemeralResource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenEphemeralResource_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateDataSourceConfig_Response proto.InternalMessageInfo
+var xxx_messageInfo_OpenEphemeralResource_Response proto.InternalMessageInfo
 
-func (m *ValidateDataSourceConfig_Response) GetDiagnostics() []*Diagnostic {
+func (m *OpenEphemeralResource_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
 		return m.Diagnostics
 	}
 	return nil
 }
 
-type Configure struct {
+type isOpenEphemeralResource_Response_XRenewAt interface {
+	isOpenEphemeralResource_Response_XRenewAt()
+}
+
+type OpenEphemeralResource_Response_RenewAt struct {
+	RenewAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=renew_at,json=renewAt,proto3,oneof"`
+}
+
+func (*OpenEphemeralResource_Response_RenewAt) isOpenEphemeralResource_Response_XRenewAt() {}
+
+func (m *OpenEphemeralResource_Response) GetXRenewAt() isOpenEphemeralResource_Response_XRenewAt {
+	if m != nil {
+		return m.XRenewAt
+	}
+	return nil
+}
+
+func (m *OpenEphemeralResource_Response) GetRenewAt() *timestamp.Timestamp {
+	if x, ok := m.GetXRenewAt().(*OpenEphemeralResource_Response_RenewAt); ok {
+		return x.RenewAt
+	}
+	return nil
+}
+
+func (m *OpenEphemeralResource_Response) GetResult() *DynamicValue {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type isOpenEphemeralResource_Response_XPrivate interface {
+	isOpenEphemeralResource_Response_XPrivate()
+}
+
+type OpenEphemeralResource_Response_Private struct {
+	Private []byte `protobuf:"bytes,4,opt,name=private,proto3,oneof"`
+}
+
+func (*OpenEphemeralResource_Response_Private) isOpenEphemeralResource_Response_XPrivate() {}
+
+func (m *OpenEphemeralResource_Response) GetXPrivate() isOpenEphemeralResource_Response_XPrivate {
+	if m != nil {
+		return m.XPrivate
+	}
+	return nil
+}
+
+func (m *OpenEphemeralResource_Response) GetPrivate() []byte {
+	if x, ok := m.GetXPrivate().(*OpenEphemeralResource_Response_Private); ok {
+		return x.Private
+	}
+	return nil
+}
+
+func (m *OpenEphemeralResource_Response) GetDeferred() *Deferred {
+	if m != nil {
+		return m.Deferred
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*OpenEphemeralResource_Response) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*OpenEphemeralResource_Response_RenewAt)(nil),
+		(*OpenEphemeralResource_Response_Private)(nil),
+	}
+}
+
+type RenewEphemeralResource struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Configure) Reset()         { *m = Configure{} }
-func (m *Configure) String() string { return proto.CompactTextString(m) }
-func (*Configure) ProtoMessage()    {}
-func (*Configure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{11}
+func (m *RenewEphemeralResource) Reset()         { *m = RenewEphemeralResource{} }
+func (m *RenewEphemeralResource) String() string { return proto.CompactTextString(m) }
+func (*RenewEphemeralResource) ProtoMessage()    {}
+func (*RenewEphemeralResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{34}
 }
 
-func (m *Configure) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Configure.Unmarshal(m, b)
+func (m *RenewEphemeralResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewEphemeralResource.Unmarshal(m, b)
 }
-func (m *Configure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Configure.Marshal(b, m, deterministic)
+func (m *RenewEphemeralResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewEphemeralResource.Marshal(b, m, deterministic)
 }
-func (m *Configure) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Configure.Merge(m, src)
+func (m *RenewEphemeralResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewEphemeralResource.Merge(m, src)
 }
-func (m *Configure) XXX_Size() int {
-	return xxx_messageInfo_Configure.Size(m)
+func (m *RenewEphemeralResource) XXX_Size() int {
+	return xxx_messageInfo_RenewEphemeralResource.Size(m)
 }
-func (m *Configure) XXX_DiscardUnknown() {
-	xxx_messageInfo_Configure.DiscardUnknown(m)
+func (m *RenewEphemeralResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewEphemeralResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Configure proto.InternalMessageInfo
+var xxx_messageInfo_RenewEphemeralResource proto.InternalMessageInfo
 
-type Configure_Request struct {
-	TerraformVersion     string        `protobuf:"bytes,1,opt,name=terraform_version,json=terraformVersion,proto3" json:"terraform_version,omitempty"`
-	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type RenewEphemeralResource_Request struct {
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// Types that are valid to be assigned to XPrivate:
+	//	*RenewEphemeralResource_Request_Private
+	XPrivate             isRenewEphemeralResource_Request_XPrivate `protobuf_oneof:"_private"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func (m *Configure_Request) Reset()         { *m = Configure_Request{} }
-func (m *Configure_Request) String() string { return proto.CompactTextString(m) }
-func (*Configure_Request) ProtoMessage()    {}
-func (*Configure_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{11, 0}
+func (m *RenewEphemeralResource_Request) Reset()         { *m = RenewEphemeralResource_Request{} }
+func (m *RenewEphemeralResource_Request) String() string { return proto.CompactTextString(m) }
+func (*RenewEphemeralResource_Request) ProtoMessage()    {}
+func (*RenewEphemeralResource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{34, 0}
 }
 
-func (m *Configure_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Configure_Request.Unmarshal(m, b)
+func (m *RenewEphemeralResource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewEphemeralResource_Request.Unmarshal(m, b)
 }
-func (m *Configure_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Configure_Request.Marshal(b, m, deterministic)
+func (m *RenewEphemeralResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewEphemeralResource_Request.Marshal(b, m, deterministic)
 }
-func (m *Configure_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Configure_Request.Merge(m, src)
+func (m *RenewEphemeralResource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewEphemeralResource_Request.Merge(m, src)
+}
+func (m *RenewEphemeralResource_Request) XXX_Size() int {
+	return xxx_messageInfo_RenewEphemeralResource_Request.Size(m)
+}
+func (m *RenewEphemeralResource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewEphemeralResource_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenewEphemeralResource_Request proto.InternalMessageInfo
+
+func (m *RenewEphemeralResource_Request) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+type isRenewEphemeralResource_Request_XPrivate interface {
+	isRenewEphemeralResource_Request_XPrivate()
+}
+
+type RenewEphemeralResource_Request_Private struct {
+	Private []byte `protobuf:"bytes,2,opt,name=private,proto3,oneof"`
+}
+
+func (*RenewEphemeralResource_Request_Private) isRenewEphemeralResource_Request_XPrivate() {}
+
+func (m *RenewEphemeralResource_Request) GetXPrivate() isRenewEphemeralResource_Request_XPrivate {
+	if m != nil {
+		return m.XPrivate
+	}
+	return nil
+}
+
+func (m *RenewEphemeralResource_Request) GetPrivate() []byte {
+	if x, ok := m.GetXPrivate().(*RenewEphemeralResource_Request_Private); ok {
+		return x.Private
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RenewEphemeralResource_Request) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RenewEphemeralResource_Request_Private)(nil),
+	}
+}
+
+type RenewEphemeralResource_Response struct {
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// Types that are valid to be assigned to XRenewAt:
+	//	*RenewEphemeralResource_Response_RenewAt
+	XRenewAt isRenewEphemeralResource_Response_XRenewAt `protobuf_oneof:"_renew_at"`
+	// Types that are valid to be assigned to XPrivate:
+	//	*RenewEphemeralResource_Response_Private
+	XPrivate             isRenewEphemeralResource_Response_XPrivate `protobuf_oneof:"_private"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *RenewEphemeralResource_Response) Reset()         { *m = RenewEphemeralResource_Response{} }
+func (m *RenewEphemeralResource_Response) String() string { return proto.CompactTextString(m) }
+func (*RenewEphemeralResource_Response) ProtoMessage()    {}
+func (*RenewEphemeralResource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{34, 1}
+}
+
+func (m *RenewEphemeralResource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewEphemeralResource_Response.Unmarshal(m, b)
+}
+func (m *RenewEphemeralResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewEphemeralResource_Response.Marshal(b, m, deterministic)
+}
+func (m *RenewEphemeralResource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewEphemeralResource_Response.Merge(m, src)
 }
-func (m *Configure_Request) XXX_Size() int {
-	return xxx_messageInfo_Configure_Request.Size(m)
+func (m *RenewEphemeralResource_Response) XXX_Size() int {
+	return xxx_messageInfo_RenewEphemeralResource_Response.Size(m)
 }
-func (m *Configure_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_Configure_Request.DiscardUnknown(m)
+func (m *RenewEphemeralResource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewEphemeralResource_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Configure_Request proto.InternalMessageInfo
+var xxx_messageInfo_RenewEphemeralResource_Response proto.InternalMessageInfo
 
-func (m *Configure_Request) GetTerraformVersion() string {
+func (m *RenewEphemeralResource_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
-		return m.TerraformVersion
+		return m.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-func (m *Configure_Request) GetConfig() *DynamicValue {
+type isRenewEphemeralResource_Response_XRenewAt interface {
+	isRenewEphemeralResource_Response_XRenewAt()
+}
+
+type RenewEphemeralResource_Response_RenewAt struct {
+	RenewAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=renew_at,json=renewAt,proto3,oneof"`
+}
+
+func (*RenewEphemeralResource_Response_RenewAt) isRenewEphemeralResource_Response_XRenewAt() {}
+
+func (m *RenewEphemeralResource_Response) GetXRenewAt() isRenewEphemeralResource_Response_XRenewAt {
 	if m != nil {
-		return m.Config
+		return m.XRenewAt
 	}
 	return nil
 }
 
-type Configure_Response struct {
-	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *RenewEphemeralResource_Response) GetRenewAt() *timestamp.Timestamp {
+	if x, ok := m.GetXRenewAt().(*RenewEphemeralResource_Response_RenewAt); ok {
+		return x.RenewAt
+	}
+	return nil
 }
 
-func (m *Configure_Response) Reset()         { *m = Configure_Response{} }
-func (m *Configure_Response) String() string { return proto.CompactTextString(m) }
-func (*Configure_Response) ProtoMessage()    {}
-func (*Configure_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{11, 1}
+type isRenewEphemeralResource_Response_XPrivate interface {
+	isRenewEphemeralResource_Response_XPrivate()
 }
 
-func (m *Configure_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Configure_Response.Unmarshal(m, b)
-}
-func (m *Configure_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Configure_Response.Marshal(b, m, deterministic)
-}
-func (m *Configure_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Configure_Response.Merge(m, src)
-}
-func (m *Configure_Response) XXX_Size() int {
-	return xxx_messageInfo_Configure_Response.Size(m)
-}
-func (m *Configure_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_Configure_Response.DiscardUnknown(m)
+type RenewEphemeralResource_Response_Private struct {
+	Private []byte `protobuf:"bytes,3,opt,name=private,proto3,oneof"`
 }
 
-var xxx_messageInfo_Configure_Response proto.InternalMessageInfo
+func (*RenewEphemeralResource_Response_Private) isRenewEphemeralResource_Response_XPrivate() {}
 
-func (m *Configure_Response) GetDiagnostics() []*Diagnostic {
+func (m *RenewEphemeralResource_Response) GetXPrivate() isRenewEphemeralResource_Response_XPrivate {
 	if m != nil {
-		return m.Diagnostics
+		return m.XPrivate
 	}
 	return nil
 }
 
-type ReadResource struct {
+func (m *RenewEphemeralResource_Response) GetPrivate() []byte {
+	if x, ok := m.GetXPrivate().(*RenewEphemeralResource_Response_Private); ok {
+		return x.Private
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RenewEphemeralResource_Response) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RenewEphemeralResource_Response_RenewAt)(nil),
+		(*RenewEphemeralResource_Response_Private)(nil),
+	}
+}
+
+type CloseEphemeralResource struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadResource) Reset()         { *m = ReadResource{} }
-func (m *ReadResource) String() string { return proto.CompactTextString(m) }
-func (*ReadResource) ProtoMessage()    {}
-func (*ReadResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{12}
+func (m *CloseEphemeralResource) Reset()         { *m = CloseEphemeralResource{} }
+func (m *CloseEphemeralResource) String() string { return proto.CompactTextString(m) }
+func (*CloseEphemeralResource) ProtoMessage()    {}
+func (*CloseEphemeralResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{35}
 }
 
-func (m *ReadResource) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadResource.Unmarshal(m, b)
+func (m *CloseEphemeralResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseEphemeralResource.Unmarshal(m, b)
 }
-func (m *ReadResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadResource.Marshal(b, m, deterministic)
+func (m *CloseEphemeralResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseEphemeralResource.Marshal(b, m, deterministic)
 }
-func (m *ReadResource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadResource.Merge(m, src)
+func (m *CloseEphemeralResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseEphemeralResource.Merge(m, src)
 }
-func (m *ReadResource) XXX_Size() int {
-	return xxx_messageInfo_ReadResource.Size(m)
+func (m *CloseEphemeralResource) XXX_Size() int {
+	return xxx_messageInfo_CloseEphemeralResource.Size(m)
 }
-func (m *ReadResource) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadResource.DiscardUnknown(m)
+func (m *CloseEphemeralResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseEphemeralResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadResource proto.InternalMessageInfo
+var xxx_messageInfo_CloseEphemeralResource proto.InternalMessageInfo
 
-type ReadResource_Request struct {
-	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	CurrentState         *DynamicValue `protobuf:"bytes,2,opt,name=current_state,json=currentState,proto3" json:"current_state,omitempty"`
-	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
-	ProviderMeta         *DynamicValue `protobuf:"bytes,4,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type CloseEphemeralResource_Request struct {
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// Types that are valid to be assigned to XPrivate:
+	//	*CloseEphemeralResource_Request_Private
+	XPrivate             isCloseEphemeralResource_Request_XPrivate `protobuf_oneof:"_private"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func (m *ReadResource_Request) Reset()         { *m = ReadResource_Request{} }
-func (m *ReadResource_Request) String() string { return proto.CompactTextString(m) }
-func (*ReadResource_Request) ProtoMessage()    {}
-func (*ReadResource_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{12, 0}
+func (m *CloseEphemeralResource_Request) Reset()         { *m = CloseEphemeralResource_Request{} }
+func (m *CloseEphemeralResource_Request) String() string { return proto.CompactTextString(m) }
+func (*CloseEphemeralResource_Request) ProtoMessage()    {}
+func (*CloseEphemeralResource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{35, 0}
 }
 
-func (m *ReadResource_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadResource_Request.Unmarshal(m, b)
+func (m *CloseEphemeralResource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseEphemeralResource_Request.Unmarshal(m, b)
 }
-func (m *ReadResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadResource_Request.Marshal(b, m, deterministic)
+func (m *CloseEphemeralResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseEphemeralResource_Request.Marshal(b, m, deterministic)
 }
-func (m *ReadResource_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadResource_Request.Merge(m, src)
+func (m *CloseEphemeralResource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseEphemeralResource_Request.Merge(m, src)
 }
-func (m *ReadResource_Request) XXX_Size() int {
-	return xxx_messageInfo_ReadResource_Request.Size(m)
+func (m *CloseEphemeralResource_Request) XXX_Size() int {
+	return xxx_messageInfo_CloseEphemeralResource_Request.Size(m)
 }
-func (m *ReadResource_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadResource_Request.DiscardUnknown(m)
+func (m *CloseEphemeralResource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseEphemeralResource_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadResource_Request proto.InternalMessageInfo
+var xxx_messageInfo_CloseEphemeralResource_Request proto.InternalMessageInfo
 
-func (m *ReadResource_Request) GetTypeName() string {
+func (m *CloseEphemeralResource_Request) GetTypeName() string {
 	if m != nil {
 		return m.TypeName
 	}
 	return ""
 }
 
-func (m *ReadResource_Request) GetCurrentState() *DynamicValue {
+type isCloseEphemeralResource_Request_XPrivate interface {
+	isCloseEphemeralResource_Request_XPrivate()
+}
+
+type CloseEphemeralResource_Request_Private struct {
+	Private []byte `protobuf:"bytes,2,opt,name=private,proto3,oneof"`
+}
+
+func (*CloseEphemeralResource_Request_Private) isCloseEphemeralResource_Request_XPrivate() {}
+
+func (m *CloseEphemeralResource_Request) GetXPrivate() isCloseEphemeralResource_Request_XPrivate {
 	if m != nil {
-		return m.CurrentState
+		return m.XPrivate
 	}
 	return nil
 }
 
-func (m *ReadResource_Request) GetPrivate() []byte {
-	if m != nil {
-		return m.Private
+func (m *CloseEphemeralResource_Request) GetPrivate() []byte {
+	if x, ok := m.GetXPrivate().(*CloseEphemeralResource_Request_Private); ok {
+		return x.Private
 	}
 	return nil
 }
 
-func (m *ReadResource_Request) GetProviderMeta() *DynamicValue {
-	if m != nil {
-		return m.ProviderMeta
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CloseEphemeralResource_Request) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CloseEphemeralResource_Request_Private)(nil),
 	}
-	return nil
 }
 
-type ReadResource_Response struct {
-	NewState             *DynamicValue `protobuf:"bytes,1,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
-	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
+type CloseEphemeralResource_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ReadResource_Response) Reset()         { *m = ReadResource_Response{} }
-func (m *ReadResource_Response) String() string { return proto.CompactTextString(m) }
-func (*ReadResource_Response) ProtoMessage()    {}
-func (*ReadResource_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{12, 1}
+func (m *CloseEphemeralResource_Response) Reset()         { *m = CloseEphemeralResource_Response{} }
+func (m *CloseEphemeralResource_Response) String() string { return proto.CompactTextString(m) }
+func (*CloseEphemeralResource_Response) ProtoMessage()    {}
+func (*CloseEphemeralResource_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{35, 1}
 }
 
-func (m *ReadResource_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadResource_Response.Unmarshal(m, b)
+func (m *CloseEphemeralResource_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseEphemeralResource_Response.Unmarshal(m, b)
 }
-func (m *ReadResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadResource_Response.Marshal(b, m, deterministic)
+func (m *CloseEphemeralResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseEphemeralResource_Response.Marshal(b, m, deterministic)
 }
-func (m *ReadResource_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadResource_Response.Merge(m, src)
+func (m *CloseEphemeralResource_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseEphemeralResource_Response.Merge(m, src)
 }
-func (m *ReadResource_Response) XXX_Size() int {
-	return xxx_messageInfo_ReadResource_Response.Size(m)
+func (m *CloseEphemeralResource_Response) XXX_Size() int {
+	return xxx_messageInfo_CloseEphemeralResource_Response.Size(m)
 }
-func (m *ReadResource_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadResource_Response.DiscardUnknown(m)
+func (m *CloseEphemeralResource_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseEphemeralResource_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadResource_Response proto.InternalMessageInfo
+var xxx_messageInfo_CloseEphemeralResource_Response proto.InternalMessageInfo
 
-func (m *ReadResource_Response) GetNewState() *DynamicValue {
+func (m *CloseEphemeralResource_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
-		return m.NewState
+		return m.Diagnostics
 	}
 	return nil
 }
 
-func (m *ReadResource_Response) GetDiagnostics() []*Diagnostic {
-	if m != nil {
-		return m.Diagnostics
-	}
-	return nil
+// Returns resource identity schemas for all resources
+type GetResourceIdentitySchemas struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadResource_Response) GetPrivate() []byte {
-	if m != nil {
-		return m.Private
-	}
-	return nil
+func (m *GetResourceIdentitySchemas) Reset()         { *m = GetResourceIdentitySchemas{} }
+func (m *GetResourceIdentitySchemas) String() string { return proto.CompactTextString(m) }
+func (*GetResourceIdentitySchemas) ProtoMessage()    {}
+func (*GetResourceIdentitySchemas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{36}
 }
 
-type PlanResourceChange struct {
+func (m *GetResourceIdentitySchemas) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceIdentitySchemas.Unmarshal(m, b)
+}
+func (m *GetResourceIdentitySchemas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceIdentitySchemas.Marshal(b, m, deterministic)
+}
+func (m *GetResourceIdentitySchemas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceIdentitySchemas.Merge(m, src)
+}
+func (m *GetResourceIdentitySchemas) XXX_Size() int {
+	return xxx_messageInfo_GetResourceIdentitySchemas.Size(m)
+}
+func (m *GetResourceIdentitySchemas) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceIdentitySchemas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetResourceIdentitySchemas proto.InternalMessageInfo
+
+type GetResourceIdentitySchemas_Request struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlanResourceChange) Reset()         { *m = PlanResourceChange{} }
-func (m *PlanResourceChange) String() string { return proto.CompactTextString(m) }
-func (*PlanResourceChange) ProtoMessage()    {}
-func (*PlanResourceChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{13}
+func (m *GetResourceIdentitySchemas_Request) Reset()         { *m = GetResourceIdentitySchemas_Request{} }
+func (m *GetResourceIdentitySchemas_Request) String() string { return proto.CompactTextString(m) }
+func (*GetResourceIdentitySchemas_Request) ProtoMessage()    {}
+func (*GetResourceIdentitySchemas_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{36, 0}
 }
 
-func (m *PlanResourceChange) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlanResourceChange.Unmarshal(m, b)
+func (m *GetResourceIdentitySchemas_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceIdentitySchemas_Request.Unmarshal(m, b)
 }
-func (m *PlanResourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlanResourceChange.Marshal(b, m, deterministic)
+func (m *GetResourceIdentitySchemas_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceIdentitySchemas_Request.Marshal(b, m, deterministic)
 }
-func (m *PlanResourceChange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlanResourceChange.Merge(m, src)
+func (m *GetResourceIdentitySchemas_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceIdentitySchemas_Request.Merge(m, src)
 }
-func (m *PlanResourceChange) XXX_Size() int {
-	return xxx_messageInfo_PlanResourceChange.Size(m)
+func (m *GetResourceIdentitySchemas_Request) XXX_Size() int {
+	return xxx_messageInfo_GetResourceIdentitySchemas_Request.Size(m)
 }
-func (m *PlanResourceChange) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlanResourceChange.DiscardUnknown(m)
+func (m *GetResourceIdentitySchemas_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceIdentitySchemas_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlanResourceChange proto.InternalMessageInfo
+var xxx_messageInfo_GetResourceIdentitySchemas_Request proto.InternalMessageInfo
 
-type PlanResourceChange_Request struct {
-	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	PriorState           *DynamicValue `protobuf:"bytes,2,opt,name=prior_state,json=priorState,proto3" json:"prior_state,omitempty"`
-	ProposedNewState     *DynamicValue `protobuf:"bytes,3,opt,name=proposed_new_state,json=proposedNewState,proto3" json:"proposed_new_state,omitempty"`
-	Config               *DynamicValue `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	PriorPrivate         []byte        `protobuf:"bytes,5,opt,name=prior_private,json=priorPrivate,proto3" json:"prior_private,omitempty"`
-	ProviderMeta         *DynamicValue `protobuf:"bytes,6,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
+type GetResourceIdentitySchemas_Response struct {
+	// identity_schemas is a mapping of resource type names to their identity schemas.
+	IdentitySchemas map[string]*ResourceIdentitySchema `protobuf:"bytes,1,rep,name=identity_schemas,json=identitySchemas,proto3" json:"identity_schemas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// diagnostics is the collection of warning and error diagnostics for this request.
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *PlanResourceChange_Request) Reset()         { *m = PlanResourceChange_Request{} }
-func (m *PlanResourceChange_Request) String() string { return proto.CompactTextString(m) }
-func (*PlanResourceChange_Request) ProtoMessage()    {}
-func (*PlanResourceChange_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{13, 0}
+func (m *GetResourceIdentitySchemas_Response) Reset()         { *m = GetResourceIdentitySchemas_Response{} }
+func (m *GetResourceIdentitySchemas_Response) String() string { return proto.CompactTextString(m) }
+func (*GetResourceIdentitySchemas_Response) ProtoMessage()    {}
+func (*GetResourceIdentitySchemas_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{36, 1}
 }
 
-func (m *PlanResourceChange_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlanResourceChange_Request.Unmarshal(m, b)
+func (m *GetResourceIdentitySchemas_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetResourceIdentitySchemas_Response.Unmarshal(m, b)
 }
-func (m *PlanResourceChange_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlanResourceChange_Request.Marshal(b, m, deterministic)
+func (m *GetResourceIdentitySchemas_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetResourceIdentitySchemas_Response.Marshal(b, m, deterministic)
 }
-func (m *PlanResourceChange_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlanResourceChange_Request.Merge(m, src)
+func (m *GetResourceIdentitySchemas_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetResourceIdentitySchemas_Response.Merge(m, src)
 }
-func (m *PlanResourceChange_Request) XXX_Size() int {
-	return xxx_messageInfo_PlanResourceChange_Request.Size(m)
+func (m *GetResourceIdentitySchemas_Response) XXX_Size() int {
+	return xxx_messageInfo_GetResourceIdentitySchemas_Response.Size(m)
 }
-func (m *PlanResourceChange_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlanResourceChange_Request.DiscardUnknown(m)
+func (m *GetResourceIdentitySchemas_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetResourceIdentitySchemas_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlanResourceChange_Request proto.InternalMessageInfo
-
-func (m *PlanResourceChange_Request) GetTypeName() string {
-	if m != nil {
-		return m.TypeName
-	}
-	return ""
-}
+var xxx_messageInfo_GetResourceIdentitySchemas_Response proto.InternalMessageInfo
 
-func (m *PlanResourceChange_Request) GetPriorState() *DynamicValue {
+func (m *GetResourceIdentitySchemas_Response) GetIdentitySchemas() map[string]*ResourceIdentitySchema {
 	if m != nil {
-		return m.PriorState
+		return m.IdentitySchemas
 	}
 	return nil
 }
 
-func (m *PlanResourceChange_Request) GetProposedNewState() *DynamicValue {
+func (m *GetResourceIdentitySchemas_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
-		return m.ProposedNewState
+		return m.Diagnostics
 	}
 	return nil
 }
 
-func (m *PlanResourceChange_Request) GetConfig() *DynamicValue {
-	if m != nil {
-		return m.Config
-	}
-	return nil
+type UpgradeResourceIdentity struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PlanResourceChange_Request) GetPriorPrivate() []byte {
-	if m != nil {
-		return m.PriorPrivate
-	}
-	return nil
+func (m *UpgradeResourceIdentity) Reset()         { *m = UpgradeResourceIdentity{} }
+func (m *UpgradeResourceIdentity) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceIdentity) ProtoMessage()    {}
+func (*UpgradeResourceIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{37}
 }
 
-func (m *PlanResourceChange_Request) GetProviderMeta() *DynamicValue {
-	if m != nil {
-		return m.ProviderMeta
-	}
-	return nil
+func (m *UpgradeResourceIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceIdentity.Unmarshal(m, b)
 }
-
-type PlanResourceChange_Response struct {
-	PlannedState    *DynamicValue    `protobuf:"bytes,1,opt,name=planned_state,json=plannedState,proto3" json:"planned_state,omitempty"`
-	RequiresReplace []*AttributePath `protobuf:"bytes,2,rep,name=requires_replace,json=requiresReplace,proto3" json:"requires_replace,omitempty"`
-	PlannedPrivate  []byte           `protobuf:"bytes,3,opt,name=planned_private,json=plannedPrivate,proto3" json:"planned_private,omitempty"`
-	Diagnostics     []*Diagnostic    `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	// This may be set only by the helper/schema "SDK" in the main Terraform
-	// repository, to request that Terraform Core >=0.12 permit additional
-	// inconsistencies that can result from the legacy SDK type system
-	// and its imprecise mapping to the >=0.12 type system.
-	// The change in behavior implied by this flag makes sense only for the
-	// specific details of the legacy SDK type system, and are not a general
-	// mechanism to avoid proper type handling in providers.
-	//
-	//     ====              DO NOT USE THIS              ====
-	//     ==== THIS MUST BE LEFT UNSET IN ALL OTHER SDKS ====
-	//     ====              DO NOT USE THIS              ====
-	LegacyTypeSystem     bool     `protobuf:"varint,5,opt,name=legacy_type_system,json=legacyTypeSystem,proto3" json:"legacy_type_system,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UpgradeResourceIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceIdentity.Marshal(b, m, deterministic)
+}
+func (m *UpgradeResourceIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceIdentity.Merge(m, src)
+}
+func (m *UpgradeResourceIdentity) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceIdentity.Size(m)
+}
+func (m *UpgradeResourceIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceIdentity.DiscardUnknown(m)
 }
 
-func (m *PlanResourceChange_Response) Reset()         { *m = PlanResourceChange_Response{} }
-func (m *PlanResourceChange_Response) String() string { return proto.CompactTextString(m) }
-func (*PlanResourceChange_Response) ProtoMessage()    {}
-func (*PlanResourceChange_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{13, 1}
+var xxx_messageInfo_UpgradeResourceIdentity proto.InternalMessageInfo
+
+type UpgradeResourceIdentity_Request struct {
+	// type_name is the managed resource type name
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// version is the version of the resource identity data to upgrade
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// raw_identity is the raw identity as stored for the resource. Core does
+	// not have access to the identity schema of prior_version, so it's the
+	// provider's responsibility to interpret this value using the
+	// appropriate older schema. The raw_identity will be json encoded.
+	RawIdentity          *RawState `protobuf:"bytes,3,opt,name=raw_identity,json=rawIdentity,proto3" json:"raw_identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *PlanResourceChange_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlanResourceChange_Response.Unmarshal(m, b)
+func (m *UpgradeResourceIdentity_Request) Reset()         { *m = UpgradeResourceIdentity_Request{} }
+func (m *UpgradeResourceIdentity_Request) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceIdentity_Request) ProtoMessage()    {}
+func (*UpgradeResourceIdentity_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{37, 0}
 }
-func (m *PlanResourceChange_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlanResourceChange_Response.Marshal(b, m, deterministic)
+
+func (m *UpgradeResourceIdentity_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceIdentity_Request.Unmarshal(m, b)
 }
-func (m *PlanResourceChange_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlanResourceChange_Response.Merge(m, src)
+func (m *UpgradeResourceIdentity_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceIdentity_Request.Marshal(b, m, deterministic)
 }
-func (m *PlanResourceChange_Response) XXX_Size() int {
-	return xxx_messageInfo_PlanResourceChange_Response.Size(m)
+func (m *UpgradeResourceIdentity_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceIdentity_Request.Merge(m, src)
 }
-func (m *PlanResourceChange_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlanResourceChange_Response.DiscardUnknown(m)
+func (m *UpgradeResourceIdentity_Request) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceIdentity_Request.Size(m)
+}
+func (m *UpgradeResourceIdentity_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceIdentity_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlanResourceChange_Response proto.InternalMessageInfo
+var xxx_messageInfo_UpgradeResourceIdentity_Request proto.InternalMessageInfo
 
-func (m *PlanResourceChange_Response) GetPlannedState() *DynamicValue {
+func (m *UpgradeResourceIdentity_Request) GetTypeName() string {
 	if m != nil {
-		return m.PlannedState
+		return m.TypeName
 	}
-	return nil
+	return ""
 }
 
-func (m *PlanResourceChange_Response) GetRequiresReplace() []*AttributePath {
+func (m *UpgradeResourceIdentity_Request) GetVersion() int64 {
 	if m != nil {
-		return m.RequiresReplace
+		return m.Version
 	}
-	return nil
+	return 0
 }
 
-func (m *PlanResourceChange_Response) GetPlannedPrivate() []byte {
+func (m *UpgradeResourceIdentity_Request) GetRawIdentity() *RawState {
 	if m != nil {
-		return m.PlannedPrivate
+		return m.RawIdentity
 	}
 	return nil
 }
 
-func (m *PlanResourceChange_Response) GetDiagnostics() []*Diagnostic {
+type UpgradeResourceIdentity_Response struct {
+	// upgraded_identity returns the upgraded resource identity data
+	UpgradedIdentity *ResourceIdentityData `protobuf:"bytes,1,opt,name=upgraded_identity,json=upgradedIdentity,proto3" json:"upgraded_identity,omitempty"`
+	// diagnostics is the collection of warning and error diagnostics for this request
+	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *UpgradeResourceIdentity_Response) Reset()         { *m = UpgradeResourceIdentity_Response{} }
+func (m *UpgradeResourceIdentity_Response) String() string { return proto.CompactTextString(m) }
+func (*UpgradeResourceIdentity_Response) ProtoMessage()    {}
+func (*UpgradeResourceIdentity_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{37, 1}
+}
+
+func (m *UpgradeResourceIdentity_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpgradeResourceIdentity_Response.Unmarshal(m, b)
+}
+func (m *UpgradeResourceIdentity_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpgradeResourceIdentity_Response.Marshal(b, m, deterministic)
+}
+func (m *UpgradeResourceIdentity_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeResourceIdentity_Response.Merge(m, src)
+}
+func (m *UpgradeResourceIdentity_Response) XXX_Size() int {
+	return xxx_messageInfo_UpgradeResourceIdentity_Response.Size(m)
+}
+func (m *UpgradeResourceIdentity_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeResourceIdentity_Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeResourceIdentity_Response proto.InternalMessageInfo
+
+func (m *UpgradeResourceIdentity_Response) GetUpgradedIdentity() *ResourceIdentityData {
 	if m != nil {
-		return m.Diagnostics
+		return m.UpgradedIdentity
 	}
 	return nil
 }
 
-func (m *PlanResourceChange_Response) GetLegacyTypeSystem() bool {
+func (m *UpgradeResourceIdentity_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
-		return m.LegacyTypeSystem
+		return m.Diagnostics
 	}
-	return false
+	return nil
 }
 
-type ApplyResourceChange struct {
+type ListResource struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplyResourceChange) Reset()         { *m = ApplyResourceChange{} }
-func (m *ApplyResourceChange) String() string { return proto.CompactTextString(m) }
-func (*ApplyResourceChange) ProtoMessage()    {}
-func (*ApplyResourceChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{14}
+func (m *ListResource) Reset()         { *m = ListResource{} }
+func (m *ListResource) String() string { return proto.CompactTextString(m) }
+func (*ListResource) ProtoMessage()    {}
+func (*ListResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{38}
 }
 
-func (m *ApplyResourceChange) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ApplyResourceChange.Unmarshal(m, b)
+func (m *ListResource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResource.Unmarshal(m, b)
 }
-func (m *ApplyResourceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ApplyResourceChange.Marshal(b, m, deterministic)
+func (m *ListResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResource.Marshal(b, m, deterministic)
 }
-func (m *ApplyResourceChange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplyResourceChange.Merge(m, src)
+func (m *ListResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResource.Merge(m, src)
 }
-func (m *ApplyResourceChange) XXX_Size() int {
-	return xxx_messageInfo_ApplyResourceChange.Size(m)
+func (m *ListResource) XXX_Size() int {
+	return xxx_messageInfo_ListResource.Size(m)
 }
-func (m *ApplyResourceChange) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplyResourceChange.DiscardUnknown(m)
+func (m *ListResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplyResourceChange proto.InternalMessageInfo
+var xxx_messageInfo_ListResource proto.InternalMessageInfo
 
-type ApplyResourceChange_Request struct {
-	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	PriorState           *DynamicValue `protobuf:"bytes,2,opt,name=prior_state,json=priorState,proto3" json:"prior_state,omitempty"`
-	PlannedState         *DynamicValue `protobuf:"bytes,3,opt,name=planned_state,json=plannedState,proto3" json:"planned_state,omitempty"`
-	Config               *DynamicValue `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
-	PlannedPrivate       []byte        `protobuf:"bytes,5,opt,name=planned_private,json=plannedPrivate,proto3" json:"planned_private,omitempty"`
-	ProviderMeta         *DynamicValue `protobuf:"bytes,6,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type ListResource_Request struct {
+	// type_name is the list resource type name.
+	TypeName string `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// configuration is the list ConfigSchema-based configuration data.
+	Config *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// when include_resource_object is set to true, the provider should
+	// include the full resource object for each result
+	IncludeResourceObject bool `protobuf:"varint,3,opt,name=include_resource_object,json=includeResourceObject,proto3" json:"include_resource_object,omitempty"`
+	// The maximum number of results that Terraform is expecting.
+	// The stream will stop, once this limit is reached.
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApplyResourceChange_Request) Reset()         { *m = ApplyResourceChange_Request{} }
-func (m *ApplyResourceChange_Request) String() string { return proto.CompactTextString(m) }
-func (*ApplyResourceChange_Request) ProtoMessage()    {}
-func (*ApplyResourceChange_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{14, 0}
+func (m *ListResource_Request) Reset()         { *m = ListResource_Request{} }
+func (m *ListResource_Request) String() string { return proto.CompactTextString(m) }
+func (*ListResource_Request) ProtoMessage()    {}
+func (*ListResource_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{38, 0}
 }
 
-func (m *ApplyResourceChange_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ApplyResourceChange_Request.Unmarshal(m, b)
+func (m *ListResource_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResource_Request.Unmarshal(m, b)
 }
-func (m *ApplyResourceChange_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ApplyResourceChange_Request.Marshal(b, m, deterministic)
+func (m *ListResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResource_Request.Marshal(b, m, deterministic)
 }
-func (m *ApplyResourceChange_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplyResourceChange_Request.Merge(m, src)
+func (m *ListResource_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResource_Request.Merge(m, src)
 }
-func (m *ApplyResourceChange_Request) XXX_Size() int {
-	return xxx_messageInfo_ApplyResourceChange_Request.Size(m)
+func (m *ListResource_Request) XXX_Size() int {
+	return xxx_messageInfo_ListResource_Request.Size(m)
 }
-func (m *ApplyResourceChange_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplyResourceChange_Request.DiscardUnknown(m)
+func (m *ListResource_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResource_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplyResourceChange_Request proto.InternalMessageInfo
+var xxx_messageInfo_ListResource_Request proto.InternalMessageInfo
 
-func (m *ApplyResourceChange_Request) GetTypeName() string {
+func (m *ListResource_Request) GetTypeName() string {
 	if m != nil {
 		return m.TypeName
 	}
 	return ""
 }
 
-func (m *ApplyResourceChange_Request) GetPriorState() *DynamicValue {
+func (m *ListResource_Request) GetConfig() *DynamicValue {
 	if m != nil {
-		return m.PriorState
+		return m.Config
 	}
 	return nil
 }
 
-func (m *ApplyResourceChange_Request) GetPlannedState() *DynamicValue {
+func (m *ListResource_Request) GetIncludeResourceObject() bool {
 	if m != nil {
-		return m.PlannedState
+		return m.IncludeResourceObject
 	}
-	return nil
+	return false
 }
 
-func (m *ApplyResourceChange_Request) GetConfig() *DynamicValue {
+func (m *ListResource_Request) GetLimit() int64 {
 	if m != nil {
-		return m.Config
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *ApplyResourceChange_Request) GetPlannedPrivate() []byte {
-	if m != nil {
-		return m.PlannedPrivate
-	}
-	return nil
+type ListResource_Event struct {
+	// identity is the resource identity data of the resource instance.
+	Identity *ResourceIdentityData `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	// display_name can be displayed in a UI to make it easier for humans to identify a resource
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Types that are valid to be assigned to XResourceObject:
+	//	*ListResource_Event_ResourceObject
+	XResourceObject isListResource_Event_XResourceObject `protobuf_oneof:"_resource_object"`
+	// A warning or error diagnostics for this event
+	Diagnostic           []*Diagnostic `protobuf:"bytes,4,rep,name=diagnostic,proto3" json:"diagnostic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ApplyResourceChange_Request) GetProviderMeta() *DynamicValue {
+func (m *ListResource_Event) Reset()         { *m = ListResource_Event{} }
+func (m *ListResource_Event) String() string { return proto.CompactTextString(m) }
+func (*ListResource_Event) ProtoMessage()    {}
+func (*ListResource_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{38, 1}
+}
+
+func (m *ListResource_Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResource_Event.Unmarshal(m, b)
+}
+func (m *ListResource_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResource_Event.Marshal(b, m, deterministic)
+}
+func (m *ListResource_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResource_Event.Merge(m, src)
+}
+func (m *ListResource_Event) XXX_Size() int {
+	return xxx_messageInfo_ListResource_Event.Size(m)
+}
+func (m *ListResource_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResource_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResource_Event proto.InternalMessageInfo
+
+func (m *ListResource_Event) GetIdentity() *ResourceIdentityData {
 	if m != nil {
-		return m.ProviderMeta
+		return m.Identity
 	}
 	return nil
 }
 
-type ApplyResourceChange_Response struct {
-	NewState    *DynamicValue `protobuf:"bytes,1,opt,name=new_state,json=newState,proto3" json:"new_state,omitempty"`
-	Private     []byte        `protobuf:"bytes,2,opt,name=private,proto3" json:"private,omitempty"`
-	Diagnostics []*Diagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	// This may be set only by the helper/schema "SDK" in the main Terraform
-	// repository, to request that Terraform Core >=0.12 permit additional
-	// inconsistencies that can result from the legacy SDK type system
-	// and its imprecise mapping to the >=0.12 type system.
-	// The change in behavior implied by this flag makes sense only for the
-	// specific details of the legacy SDK type system, and are not a general
-	// mechanism to avoid proper type handling in providers.
-	//
-	//     ====              DO NOT USE THIS              ====
-	//     ==== THIS MUST BE LEFT UNSET IN ALL OTHER SDKS ====
-	//     ====              DO NOT USE THIS              ====
-	LegacyTypeSystem     bool     `protobuf:"varint,4,opt,name=legacy_type_system,json=legacyTypeSystem,proto3" json:"legacy_type_system,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListResource_Event) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
 }
 
-func (m *ApplyResourceChange_Response) Reset()         { *m = ApplyResourceChange_Response{} }
-func (m *ApplyResourceChange_Response) String() string { return proto.CompactTextString(m) }
-func (*ApplyResourceChange_Response) ProtoMessage()    {}
-func (*ApplyResourceChange_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{14, 1}
+type isListResource_Event_XResourceObject interface {
+	isListResource_Event_XResourceObject()
 }
 
-func (m *ApplyResourceChange_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ApplyResourceChange_Response.Unmarshal(m, b)
-}
-func (m *ApplyResourceChange_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ApplyResourceChange_Response.Marshal(b, m, deterministic)
-}
-func (m *ApplyResourceChange_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplyResourceChange_Response.Merge(m, src)
-}
-func (m *ApplyResourceChange_Response) XXX_Size() int {
-	return xxx_messageInfo_ApplyResourceChange_Response.Size(m)
-}
-func (m *ApplyResourceChange_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplyResourceChange_Response.DiscardUnknown(m)
+type ListResource_Event_ResourceObject struct {
+	ResourceObject *DynamicValue `protobuf:"bytes,3,opt,name=resource_object,json=resourceObject,proto3,oneof"`
 }
 
-var xxx_messageInfo_ApplyResourceChange_Response proto.InternalMessageInfo
+func (*ListResource_Event_ResourceObject) isListResource_Event_XResourceObject() {}
 
-func (m *ApplyResourceChange_Response) GetNewState() *DynamicValue {
+func (m *ListResource_Event) GetXResourceObject() isListResource_Event_XResourceObject {
 	if m != nil {
-		return m.NewState
+		return m.XResourceObject
 	}
 	return nil
 }
 
-func (m *ApplyResourceChange_Response) GetPrivate() []byte {
-	if m != nil {
-		return m.Private
+func (m *ListResource_Event) GetResourceObject() *DynamicValue {
+	if x, ok := m.GetXResourceObject().(*ListResource_Event_ResourceObject); ok {
+		return x.ResourceObject
 	}
 	return nil
 }
 
-func (m *ApplyResourceChange_Response) GetDiagnostics() []*Diagnostic {
+func (m *ListResource_Event) GetDiagnostic() []*Diagnostic {
 	if m != nil {
-		return m.Diagnostics
+		return m.Diagnostic
 	}
 	return nil
 }
 
-func (m *ApplyResourceChange_Response) GetLegacyTypeSystem() bool {
-	if m != nil {
-		return m.LegacyTypeSystem
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ListResource_Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ListResource_Event_ResourceObject)(nil),
 	}
-	return false
 }
 
-type ImportResourceState struct {
+type ValidateListResourceConfig struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ImportResourceState) Reset()         { *m = ImportResourceState{} }
-func (m *ImportResourceState) String() string { return proto.CompactTextString(m) }
-func (*ImportResourceState) ProtoMessage()    {}
-func (*ImportResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{15}
+func (m *ValidateListResourceConfig) Reset()         { *m = ValidateListResourceConfig{} }
+func (m *ValidateListResourceConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateListResourceConfig) ProtoMessage()    {}
+func (*ValidateListResourceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{39}
 }
 
-func (m *ImportResourceState) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportResourceState.Unmarshal(m, b)
+func (m *ValidateListResourceConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateListResourceConfig.Unmarshal(m, b)
 }
-func (m *ImportResourceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportResourceState.Marshal(b, m, deterministic)
+func (m *ValidateListResourceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateListResourceConfig.Marshal(b, m, deterministic)
 }
-func (m *ImportResourceState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportResourceState.Merge(m, src)
+func (m *ValidateListResourceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateListResourceConfig.Merge(m, src)
 }
-func (m *ImportResourceState) XXX_Size() int {
-	return xxx_messageInfo_ImportResourceState.Size(m)
+func (m *ValidateListResourceConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateListResourceConfig.Size(m)
 }
-func (m *ImportResourceState) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportResourceState.DiscardUnknown(m)
+func (m *ValidateListResourceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateListResourceConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportResourceState proto.InternalMessageInfo
+var xxx_messageInfo_ValidateListResourceConfig proto.InternalMessageInfo
 
-type ImportResourceState_Request struct {
-	TypeName             string   `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ValidateListResourceConfig_Request struct {
+	TypeName              string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	Config                *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	IncludeResourceObject *DynamicValue `protobuf:"bytes,3,opt,name=include_resource_object,json=includeResourceObject,proto3" json:"include_resource_object,omitempty"`
+	Limit                 *DynamicValue `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}      `json:"-"`
+	XXX_unrecognized      []byte        `json:"-"`
+	XXX_sizecache         int32         `json:"-"`
 }
 
-func (m *ImportResourceState_Request) Reset()         { *m = ImportResourceState_Request{} }
-func (m *ImportResourceState_Request) String() string { return proto.CompactTextString(m) }
-func (*ImportResourceState_Request) ProtoMessage()    {}
-func (*ImportResourceState_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{15, 0}
+func (m *ValidateListResourceConfig_Request) Reset()         { *m = ValidateListResourceConfig_Request{} }
+func (m *ValidateListResourceConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateListResourceConfig_Request) ProtoMessage()    {}
+func (*ValidateListResourceConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{39, 0}
 }
 
-func (m *ImportResourceState_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportResourceState_Request.Unmarshal(m, b)
+func (m *ValidateListResourceConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateListResourceConfig_Request.Unmarshal(m, b)
 }
-func (m *ImportResourceState_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportResourceState_Request.Marshal(b, m, deterministic)
+func (m *ValidateListResourceConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateListResourceConfig_Request.Marshal(b, m, deterministic)
 }
-func (m *ImportResourceState_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportResourceState_Request.Merge(m, src)
+func (m *ValidateListResourceConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateListResourceConfig_Request.Merge(m, src)
 }
-func (m *ImportResourceState_Request) XXX_Size() int {
-	return xxx_messageInfo_ImportResourceState_Request.Size(m)
+func (m *ValidateListResourceConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateListResourceConfig_Request.Size(m)
 }
-func (m *ImportResourceState_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportResourceState_Request.DiscardUnknown(m)
+func (m *ValidateListResourceConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateListResourceConfig_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportResourceState_Request proto.InternalMessageInfo
+var xxx_messageInfo_ValidateListResourceConfig_Request proto.InternalMessageInfo
 
-func (m *ImportResourceState_Request) GetTypeName() string {
+func (m *ValidateListResourceConfig_Request) GetTypeName() string {
 	if m != nil {
 		return m.TypeName
 	}
 	return ""
 }
 
-func (m *ImportResourceState_Request) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
-}
-
-type ImportResourceState_ImportedResource struct {
-	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
-	State                *DynamicValue `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	Private              []byte        `protobuf:"bytes,3,opt,name=private,proto3" json:"private,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *ImportResourceState_ImportedResource) Reset()         { *m = ImportResourceState_ImportedResource{} }
-func (m *ImportResourceState_ImportedResource) String() string { return proto.CompactTextString(m) }
-func (*ImportResourceState_ImportedResource) ProtoMessage()    {}
-func (*ImportResourceState_ImportedResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{15, 1}
-}
-
-func (m *ImportResourceState_ImportedResource) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportResourceState_ImportedResource.Unmarshal(m, b)
-}
-func (m *ImportResourceState_ImportedResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportResourceState_ImportedResource.Marshal(b, m, deterministic)
-}
-func (m *ImportResourceState_ImportedResource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportResourceState_ImportedResource.Merge(m, src)
-}
-func (m *ImportResourceState_ImportedResource) XXX_Size() int {
-	return xxx_messageInfo_ImportResourceState_ImportedResource.Size(m)
-}
-func (m *ImportResourceState_ImportedResource) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportResourceState_ImportedResource.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ImportResourceState_ImportedResource proto.InternalMessageInfo
-
-func (m *ImportResourceState_ImportedResource) GetTypeName() string {
+func (m *ValidateListResourceConfig_Request) GetConfig() *DynamicValue {
 	if m != nil {
-		return m.TypeName
+		return m.Config
 	}
-	return ""
+	return nil
 }
 
-func (m *ImportResourceState_ImportedResource) GetState() *DynamicValue {
+func (m *ValidateListResourceConfig_Request) GetIncludeResourceObject() *DynamicValue {
 	if m != nil {
-		return m.State
+		return m.IncludeResourceObject
 	}
 	return nil
 }
 
-func (m *ImportResourceState_ImportedResource) GetPrivate() []byte {
+func (m *ValidateListResourceConfig_Request) GetLimit() *DynamicValue {
 	if m != nil {
-		return m.Private
+		return m.Limit
 	}
 	return nil
 }
 
-type ImportResourceState_Response struct {
-	ImportedResources    []*ImportResourceState_ImportedResource `protobuf:"bytes,1,rep,name=imported_resources,json=importedResources,proto3" json:"imported_resources,omitempty"`
-	Diagnostics          []*Diagnostic                           `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+type ValidateListResourceConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ImportResourceState_Response) Reset()         { *m = ImportResourceState_Response{} }
-func (m *ImportResourceState_Response) String() string { return proto.CompactTextString(m) }
-func (*ImportResourceState_Response) ProtoMessage()    {}
-func (*ImportResourceState_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{15, 2}
+func (m *ValidateListResourceConfig_Response) Reset()         { *m = ValidateListResourceConfig_Response{} }
+func (m *ValidateListResourceConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateListResourceConfig_Response) ProtoMessage()    {}
+func (*ValidateListResourceConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{39, 1}
 }
 
-func (m *ImportResourceState_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ImportResourceState_Response.Unmarshal(m, b)
+func (m *ValidateListResourceConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateListResourceConfig_Response.Unmarshal(m, b)
 }
-func (m *ImportResourceState_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ImportResourceState_Response.Marshal(b, m, deterministic)
+func (m *ValidateListResourceConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateListResourceConfig_Response.Marshal(b, m, deterministic)
 }
-func (m *ImportResourceState_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ImportResourceState_Response.Merge(m, src)
+func (m *ValidateListResourceConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateListResourceConfig_Response.Merge(m, src)
 }
-func (m *ImportResourceState_Response) XXX_Size() int {
-	return xxx_messageInfo_ImportResourceState_Response.Size(m)
+func (m *ValidateListResourceConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateListResourceConfig_Response.Size(m)
 }
-func (m *ImportResourceState_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ImportResourceState_Response.DiscardUnknown(m)
+func (m *ValidateListResourceConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateListResourceConfig_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ImportResourceState_Response proto.InternalMessageInfo
-
-func (m *ImportResourceState_Response) GetImportedResources() []*ImportResourceState_ImportedResource {
-	if m != nil {
-		return m.ImportedResources
-	}
-	return nil
-}
+var xxx_messageInfo_ValidateListResourceConfig_Response proto.InternalMessageInfo
 
-func (m *ImportResourceState_Response) GetDiagnostics() []*Diagnostic {
+func (m *ValidateListResourceConfig_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
 		return m.Diagnostics
 	}
 	return nil
 }
 
-type ReadDataSource struct {
+type ValidateActionConfig struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReadDataSource) Reset()         { *m = ReadDataSource{} }
-func (m *ReadDataSource) String() string { return proto.CompactTextString(m) }
-func (*ReadDataSource) ProtoMessage()    {}
-func (*ReadDataSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{16}
+func (m *ValidateActionConfig) Reset()         { *m = ValidateActionConfig{} }
+func (m *ValidateActionConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidateActionConfig) ProtoMessage()    {}
+func (*ValidateActionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{40}
 }
 
-func (m *ReadDataSource) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadDataSource.Unmarshal(m, b)
+func (m *ValidateActionConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateActionConfig.Unmarshal(m, b)
 }
-func (m *ReadDataSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadDataSource.Marshal(b, m, deterministic)
+func (m *ValidateActionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateActionConfig.Marshal(b, m, deterministic)
 }
-func (m *ReadDataSource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadDataSource.Merge(m, src)
+func (m *ValidateActionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateActionConfig.Merge(m, src)
 }
-func (m *ReadDataSource) XXX_Size() int {
-	return xxx_messageInfo_ReadDataSource.Size(m)
+func (m *ValidateActionConfig) XXX_Size() int {
+	return xxx_messageInfo_ValidateActionConfig.Size(m)
 }
-func (m *ReadDataSource) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadDataSource.DiscardUnknown(m)
+func (m *ValidateActionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateActionConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadDataSource proto.InternalMessageInfo
+var xxx_messageInfo_ValidateActionConfig proto.InternalMessageInfo
 
-type ReadDataSource_Request struct {
-	TypeName             string        `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+type ValidateActionConfig_Request struct {
+	ActionType           string        `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
 	Config               *DynamicValue `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	ProviderMeta         *DynamicValue `protobuf:"bytes,3,opt,name=provider_meta,json=providerMeta,proto3" json:"provider_meta,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ReadDataSource_Request) Reset()         { *m = ReadDataSource_Request{} }
-func (m *ReadDataSource_Request) String() string { return proto.CompactTextString(m) }
-func (*ReadDataSource_Request) ProtoMessage()    {}
-func (*ReadDataSource_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{16, 0}
+func (m *ValidateActionConfig_Request) Reset()         { *m = ValidateActionConfig_Request{} }
+func (m *ValidateActionConfig_Request) String() string { return proto.CompactTextString(m) }
+func (*ValidateActionConfig_Request) ProtoMessage()    {}
+func (*ValidateActionConfig_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{40, 0}
 }
 
-func (m *ReadDataSource_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadDataSource_Request.Unmarshal(m, b)
+func (m *ValidateActionConfig_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateActionConfig_Request.Unmarshal(m, b)
 }
-func (m *ReadDataSource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadDataSource_Request.Marshal(b, m, deterministic)
+func (m *ValidateActionConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateActionConfig_Request.Marshal(b, m, deterministic)
 }
-func (m *ReadDataSource_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadDataSource_Request.Merge(m, src)
+func (m *ValidateActionConfig_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateActionConfig_Request.Merge(m, src)
 }
-func (m *ReadDataSource_Request) XXX_Size() int {
-	return xxx_messageInfo_ReadDataSource_Request.Size(m)
+func (m *ValidateActionConfig_Request) XXX_Size() int {
+	return xxx_messageInfo_ValidateActionConfig_Request.Size(m)
 }
-func (m *ReadDataSource_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadDataSource_Request.DiscardUnknown(m)
+func (m *ValidateActionConfig_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateActionConfig_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadDataSource_Request proto.InternalMessageInfo
+var xxx_messageInfo_ValidateActionConfig_Request proto.InternalMessageInfo
 
-func (m *ReadDataSource_Request) GetTypeName() string {
+func (m *ValidateActionConfig_Request) GetActionType() string {
 	if m != nil {
-		return m.TypeName
+		return m.ActionType
 	}
 	return ""
 }
 
-func (m *ReadDataSource_Request) GetConfig() *DynamicValue {
+func (m *ValidateActionConfig_Request) GetConfig() *DynamicValue {
 	if m != nil {
 		return m.Config
 	}
 	return nil
 }
 
-func (m *ReadDataSource_Request) GetProviderMeta() *DynamicValue {
-	if m != nil {
-		return m.ProviderMeta
-	}
-	return nil
-}
-
-type ReadDataSource_Response struct {
-	State                *DynamicValue `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+type ValidateActionConfig_Response struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ReadDataSource_Response) Reset()         { *m = ReadDataSource_Response{} }
-func (m *ReadDataSource_Response) String() string { return proto.CompactTextString(m) }
-func (*ReadDataSource_Response) ProtoMessage()    {}
-func (*ReadDataSource_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{16, 1}
+func (m *ValidateActionConfig_Response) Reset()         { *m = ValidateActionConfig_Response{} }
+func (m *ValidateActionConfig_Response) String() string { return proto.CompactTextString(m) }
+func (*ValidateActionConfig_Response) ProtoMessage()    {}
+func (*ValidateActionConfig_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{40, 1}
 }
 
-func (m *ReadDataSource_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReadDataSource_Response.Unmarshal(m, b)
+func (m *ValidateActionConfig_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateActionConfig_Response.Unmarshal(m, b)
 }
-func (m *ReadDataSource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReadDataSource_Response.Marshal(b, m, deterministic)
+func (m *ValidateActionConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateActionConfig_Response.Marshal(b, m, deterministic)
 }
-func (m *ReadDataSource_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReadDataSource_Response.Merge(m, src)
+func (m *ValidateActionConfig_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateActionConfig_Response.Merge(m, src)
 }
-func (m *ReadDataSource_Response) XXX_Size() int {
-	return xxx_messageInfo_ReadDataSource_Response.Size(m)
+func (m *ValidateActionConfig_Response) XXX_Size() int {
+	return xxx_messageInfo_ValidateActionConfig_Response.Size(m)
 }
-func (m *ReadDataSource_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReadDataSource_Response.DiscardUnknown(m)
+func (m *ValidateActionConfig_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateActionConfig_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReadDataSource_Response proto.InternalMessageInfo
-
-func (m *ReadDataSource_Response) GetState() *DynamicValue {
-	if m != nil {
-		return m.State
-	}
-	return nil
-}
+var xxx_messageInfo_ValidateActionConfig_Response proto.InternalMessageInfo
 
-func (m *ReadDataSource_Response) GetDiagnostics() []*Diagnostic {
+func (m *ValidateActionConfig_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
 		return m.Diagnostics
 	}
 	return nil
 }
 
-type GetProvisionerSchema struct {
+type PlanAction struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetProvisionerSchema) Reset()         { *m = GetProvisionerSchema{} }
-func (m *GetProvisionerSchema) String() string { return proto.CompactTextString(m) }
-func (*GetProvisionerSchema) ProtoMessage()    {}
-func (*GetProvisionerSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{17}
+func (m *PlanAction) Reset()         { *m = PlanAction{} }
+func (m *PlanAction) String() string { return proto.CompactTextString(m) }
+func (*PlanAction) ProtoMessage()    {}
+func (*PlanAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{41}
 }
 
-func (m *GetProvisionerSchema) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProvisionerSchema.Unmarshal(m, b)
+func (m *PlanAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanAction.Unmarshal(m, b)
 }
-func (m *GetProvisionerSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProvisionerSchema.Marshal(b, m, deterministic)
+func (m *PlanAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanAction.Marshal(b, m, deterministic)
 }
-func (m *GetProvisionerSchema) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProvisionerSchema.Merge(m, src)
+func (m *PlanAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanAction.Merge(m, src)
 }
-func (m *GetProvisionerSchema) XXX_Size() int {
-	return xxx_messageInfo_GetProvisionerSchema.Size(m)
+func (m *PlanAction) XXX_Size() int {
+	return xxx_messageInfo_PlanAction.Size(m)
 }
-func (m *GetProvisionerSchema) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProvisionerSchema.DiscardUnknown(m)
+func (m *PlanAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanAction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProvisionerSchema proto.InternalMessageInfo
+var xxx_messageInfo_PlanAction proto.InternalMessageInfo
 
-type GetProvisionerSchema_Request struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type PlanAction_Request struct {
+	ActionType           string              `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	Config               *DynamicValue       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ClientCapabilities   *ClientCapabilities `protobuf:"bytes,3,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *GetProvisionerSchema_Request) Reset()         { *m = GetProvisionerSchema_Request{} }
-func (m *GetProvisionerSchema_Request) String() string { return proto.CompactTextString(m) }
-func (*GetProvisionerSchema_Request) ProtoMessage()    {}
-func (*GetProvisionerSchema_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{17, 0}
+func (m *PlanAction_Request) Reset()         { *m = PlanAction_Request{} }
+func (m *PlanAction_Request) String() string { return proto.CompactTextString(m) }
+func (*PlanAction_Request) ProtoMessage()    {}
+func (*PlanAction_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{41, 0}
 }
 
-func (m *GetProvisionerSchema_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProvisionerSchema_Request.Unmarshal(m, b)
+func (m *PlanAction_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanAction_Request.Unmarshal(m, b)
 }
-func (m *GetProvisionerSchema_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProvisionerSchema_Request.Marshal(b, m, deterministic)
+func (m *PlanAction_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanAction_Request.Marshal(b, m, deterministic)
 }
-func (m *GetProvisionerSchema_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProvisionerSchema_Request.Merge(m, src)
+func (m *PlanAction_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanAction_Request.Merge(m, src)
 }
-func (m *GetProvisionerSchema_Request) XXX_Size() int {
-	return xxx_messageInfo_GetProvisionerSchema_Request.Size(m)
+func (m *PlanAction_Request) XXX_Size() int {
+	return xxx_messageInfo_PlanAction_Request.Size(m)
 }
-func (m *GetProvisionerSchema_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProvisionerSchema_Request.DiscardUnknown(m)
+func (m *PlanAction_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanAction_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProvisionerSchema_Request proto.InternalMessageInfo
+var xxx_messageInfo_PlanAction_Request proto.InternalMessageInfo
 
-type GetProvisionerSchema_Response struct {
-	Provisioner          *Schema       `protobuf:"bytes,1,opt,name=provisioner,proto3" json:"provisioner,omitempty"`
-	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *PlanAction_Request) GetActionType() string {
+	if m != nil {
+		return m.ActionType
+	}
+	return ""
 }
 
-func (m *GetProvisionerSchema_Response) Reset()         { *m = GetProvisionerSchema_Response{} }
-func (m *GetProvisionerSchema_Response) String() string { return proto.CompactTextString(m) }
-func (*GetProvisionerSchema_Response) ProtoMessage()    {}
-func (*GetProvisionerSchema_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{17, 1}
+func (m *PlanAction_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
+	}
+	return nil
 }
 
-func (m *GetProvisionerSchema_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetProvisionerSchema_Response.Unmarshal(m, b)
+func (m *PlanAction_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
 }
-func (m *GetProvisionerSchema_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetProvisionerSchema_Response.Marshal(b, m, deterministic)
+
+type PlanAction_Response struct {
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// metadata
+	Deferred             *Deferred `protobuf:"bytes,2,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
-func (m *GetProvisionerSchema_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetProvisionerSchema_Response.Merge(m, src)
+
+func (m *PlanAction_Response) Reset()         { *m = PlanAction_Response{} }
+func (m *PlanAction_Response) String() string { return proto.CompactTextString(m) }
+func (*PlanAction_Response) ProtoMessage()    {}
+func (*PlanAction_Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{41, 1}
 }
-func (m *GetProvisionerSchema_Response) XXX_Size() int {
-	return xxx_messageInfo_GetProvisionerSchema_Response.Size(m)
+
+func (m *PlanAction_Response) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanAction_Response.Unmarshal(m, b)
 }
-func (m *GetProvisionerSchema_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetProvisionerSchema_Response.DiscardUnknown(m)
+func (m *PlanAction_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanAction_Response.Marshal(b, m, deterministic)
+}
+func (m *PlanAction_Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanAction_Response.Merge(m, src)
+}
+func (m *PlanAction_Response) XXX_Size() int {
+	return xxx_messageInfo_PlanAction_Response.Size(m)
+}
+func (m *PlanAction_Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanAction_Response.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetProvisionerSchema_Response proto.InternalMessageInfo
+var xxx_messageInfo_PlanAction_Response proto.InternalMessageInfo
 
-func (m *GetProvisionerSchema_Response) GetProvisioner() *Schema {
+func (m *PlanAction_Response) GetDiagnostics() []*Diagnostic {
 	if m != nil {
-		return m.Provisioner
+		return m.Diagnostics
 	}
 	return nil
 }
 
-func (m *GetProvisionerSchema_Response) GetDiagnostics() []*Diagnostic {
+func (m *PlanAction_Response) GetDeferred() *Deferred {
 	if m != nil {
-		return m.Diagnostics
+		return m.Deferred
 	}
 	return nil
 }
 
-type ValidateProvisionerConfig struct {
+type InvokeAction struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateProvisionerConfig) Reset()         { *m = ValidateProvisionerConfig{} }
-func (m *ValidateProvisionerConfig) String() string { return proto.CompactTextString(m) }
-func (*ValidateProvisionerConfig) ProtoMessage()    {}
-func (*ValidateProvisionerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{18}
-}
-
-func (m *ValidateProvisionerConfig) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateProvisionerConfig.Unmarshal(m, b)
-}
-func (m *ValidateProvisionerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateProvisionerConfig.Marshal(b, m, deterministic)
-}
-func (m *ValidateProvisionerConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateProvisionerConfig.Merge(m, src)
-}
-func (m *ValidateProvisionerConfig) XXX_Size() int {
-	return xxx_messageInfo_ValidateProvisionerConfig.Size(m)
-}
-func (m *ValidateProvisionerConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateProvisionerConfig.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ValidateProvisionerConfig proto.InternalMessageInfo
-
-type ValidateProvisionerConfig_Request struct {
-	Config               *DynamicValue `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
-}
-
-func (m *ValidateProvisionerConfig_Request) Reset()         { *m = ValidateProvisionerConfig_Request{} }
-func (m *ValidateProvisionerConfig_Request) String() string { return proto.CompactTextString(m) }
-func (*ValidateProvisionerConfig_Request) ProtoMessage()    {}
-func (*ValidateProvisionerConfig_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{18, 0}
+func (m *InvokeAction) Reset()         { *m = InvokeAction{} }
+func (m *InvokeAction) String() string { return proto.CompactTextString(m) }
+func (*InvokeAction) ProtoMessage()    {}
+func (*InvokeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{42}
 }
 
-func (m *ValidateProvisionerConfig_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateProvisionerConfig_Request.Unmarshal(m, b)
+func (m *InvokeAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeAction.Unmarshal(m, b)
 }
-func (m *ValidateProvisionerConfig_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateProvisionerConfig_Request.Marshal(b, m, deterministic)
+func (m *InvokeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeAction.Marshal(b, m, deterministic)
 }
-func (m *ValidateProvisionerConfig_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateProvisionerConfig_Request.Merge(m, src)
+func (m *InvokeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeAction.Merge(m, src)
 }
-func (m *ValidateProvisionerConfig_Request) XXX_Size() int {
-	return xxx_messageInfo_ValidateProvisionerConfig_Request.Size(m)
+func (m *InvokeAction) XXX_Size() int {
+	return xxx_messageInfo_InvokeAction.Size(m)
 }
-func (m *ValidateProvisionerConfig_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateProvisionerConfig_Request.DiscardUnknown(m)
+func (m *InvokeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeAction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateProvisionerConfig_Request proto.InternalMessageInfo
-
-func (m *ValidateProvisionerConfig_Request) GetConfig() *DynamicValue {
-	if m != nil {
-		return m.Config
-	}
-	return nil
-}
+var xxx_messageInfo_InvokeAction proto.InternalMessageInfo
 
-type ValidateProvisionerConfig_Response struct {
-	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type InvokeAction_Request struct {
+	ActionType           string              `protobuf:"bytes,1,opt,name=action_type,json=actionType,proto3" json:"action_type,omitempty"`
+	Config               *DynamicValue       `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	ClientCapabilities   *ClientCapabilities `protobuf:"bytes,3,opt,name=client_capabilities,json=clientCapabilities,proto3" json:"client_capabilities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-
-func (m *ValidateProvisionerConfig_Response) Reset()         { *m = ValidateProvisionerConfig_Response{} }
-func (m *ValidateProvisionerConfig_Response) String() string { return proto.CompactTextString(m) }
-func (*ValidateProvisionerConfig_Response) ProtoMessage()    {}
-func (*ValidateProvisionerConfig_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{18, 1}
+
+func (m *InvokeAction_Request) Reset()         { *m = InvokeAction_Request{} }
+func (m *InvokeAction_Request) String() string { return proto.CompactTextString(m) }
+func (*InvokeAction_Request) ProtoMessage()    {}
+func (*InvokeAction_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{42, 0}
 }
 
-func (m *ValidateProvisionerConfig_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateProvisionerConfig_Response.Unmarshal(m, b)
+func (m *InvokeAction_Request) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeAction_Request.Unmarshal(m, b)
 }
-func (m *ValidateProvisionerConfig_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateProvisionerConfig_Response.Marshal(b, m, deterministic)
+func (m *InvokeAction_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeAction_Request.Marshal(b, m, deterministic)
 }
-func (m *ValidateProvisionerConfig_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateProvisionerConfig_Response.Merge(m, src)
+func (m *InvokeAction_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeAction_Request.Merge(m, src)
 }
-func (m *ValidateProvisionerConfig_Response) XXX_Size() int {
-	return xxx_messageInfo_ValidateProvisionerConfig_Response.Size(m)
+func (m *InvokeAction_Request) XXX_Size() int {
+	return xxx_messageInfo_InvokeAction_Request.Size(m)
 }
-func (m *ValidateProvisionerConfig_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateProvisionerConfig_Response.DiscardUnknown(m)
+func (m *InvokeAction_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeAction_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateProvisionerConfig_Response proto.InternalMessageInfo
+var xxx_messageInfo_InvokeAction_Request proto.InternalMessageInfo
 
-func (m *ValidateProvisionerConfig_Response) GetDiagnostics() []*Diagnostic {
+func (m *InvokeAction_Request) GetActionType() string {
 	if m != nil {
-		return m.Diagnostics
+		return m.ActionType
+	}
+	return ""
+}
+
+func (m *InvokeAction_Request) GetConfig() *DynamicValue {
+	if m != nil {
+		return m.Config
 	}
 	return nil
 }
 
-type ProvisionResource struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *InvokeAction_Request) GetClientCapabilities() *ClientCapabilities {
+	if m != nil {
+		return m.ClientCapabilities
+	}
+	return nil
 }
 
-func (m *ProvisionResource) Reset()         { *m = ProvisionResource{} }
-func (m *ProvisionResource) String() string { return proto.CompactTextString(m) }
-func (*ProvisionResource) ProtoMessage()    {}
-func (*ProvisionResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{19}
+type InvokeAction_Event struct {
+	// Types that are valid to be assigned to Type:
+	//	*InvokeAction_Event_Progress_
+	//	*InvokeAction_Event_Completed_
+	Type                 isInvokeAction_Event_Type `protobuf_oneof:"type"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *ProvisionResource) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProvisionResource.Unmarshal(m, b)
+func (m *InvokeAction_Event) Reset()         { *m = InvokeAction_Event{} }
+func (m *InvokeAction_Event) String() string { return proto.CompactTextString(m) }
+func (*InvokeAction_Event) ProtoMessage()    {}
+func (*InvokeAction_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{42, 1}
 }
-func (m *ProvisionResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProvisionResource.Marshal(b, m, deterministic)
+
+func (m *InvokeAction_Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeAction_Event.Unmarshal(m, b)
 }
-func (m *ProvisionResource) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProvisionResource.Merge(m, src)
+func (m *InvokeAction_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeAction_Event.Marshal(b, m, deterministic)
 }
-func (m *ProvisionResource) XXX_Size() int {
-	return xxx_messageInfo_ProvisionResource.Size(m)
+func (m *InvokeAction_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeAction_Event.Merge(m, src)
 }
-func (m *ProvisionResource) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProvisionResource.DiscardUnknown(m)
+func (m *InvokeAction_Event) XXX_Size() int {
+	return xxx_messageInfo_InvokeAction_Event.Size(m)
+}
+func (m *InvokeAction_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeAction_Event.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProvisionResource proto.InternalMessageInfo
+var xxx_messageInfo_InvokeAction_Event proto.InternalMessageInfo
 
-type ProvisionResource_Request struct {
-	Config               *DynamicValue `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
-	Connection           *DynamicValue `protobuf:"bytes,2,opt,name=connection,proto3" json:"connection,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type isInvokeAction_Event_Type interface {
+	isInvokeAction_Event_Type()
 }
 
-func (m *ProvisionResource_Request) Reset()         { *m = ProvisionResource_Request{} }
-func (m *ProvisionResource_Request) String() string { return proto.CompactTextString(m) }
-func (*ProvisionResource_Request) ProtoMessage()    {}
-func (*ProvisionResource_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{19, 0}
+type InvokeAction_Event_Progress_ struct {
+	Progress *InvokeAction_Event_Progress `protobuf:"bytes,1,opt,name=progress,proto3,oneof"`
 }
 
-func (m *ProvisionResource_Request) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProvisionResource_Request.Unmarshal(m, b)
-}
-func (m *ProvisionResource_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProvisionResource_Request.Marshal(b, m, deterministic)
-}
-func (m *ProvisionResource_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProvisionResource_Request.Merge(m, src)
-}
-func (m *ProvisionResource_Request) XXX_Size() int {
-	return xxx_messageInfo_ProvisionResource_Request.Size(m)
-}
-func (m *ProvisionResource_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProvisionResource_Request.DiscardUnknown(m)
+type InvokeAction_Event_Completed_ struct {
+	Completed *InvokeAction_Event_Completed `protobuf:"bytes,2,opt,name=completed,proto3,oneof"`
 }
 
-var xxx_messageInfo_ProvisionResource_Request proto.InternalMessageInfo
+func (*InvokeAction_Event_Progress_) isInvokeAction_Event_Type() {}
 
-func (m *ProvisionResource_Request) GetConfig() *DynamicValue {
+func (*InvokeAction_Event_Completed_) isInvokeAction_Event_Type() {}
+
+func (m *InvokeAction_Event) GetType() isInvokeAction_Event_Type {
 	if m != nil {
-		return m.Config
+		return m.Type
 	}
 	return nil
 }
 
-func (m *ProvisionResource_Request) GetConnection() *DynamicValue {
-	if m != nil {
-		return m.Connection
+func (m *InvokeAction_Event) GetProgress() *InvokeAction_Event_Progress {
+	if x, ok := m.GetType().(*InvokeAction_Event_Progress_); ok {
+		return x.Progress
 	}
 	return nil
 }
 
-type ProvisionResource_Response struct {
-	Output               string        `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
-	Diagnostics          []*Diagnostic `protobuf:"bytes,2,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *InvokeAction_Event) GetCompleted() *InvokeAction_Event_Completed {
+	if x, ok := m.GetType().(*InvokeAction_Event_Completed_); ok {
+		return x.Completed
+	}
+	return nil
 }
 
-func (m *ProvisionResource_Response) Reset()         { *m = ProvisionResource_Response{} }
-func (m *ProvisionResource_Response) String() string { return proto.CompactTextString(m) }
-func (*ProvisionResource_Response) ProtoMessage()    {}
-func (*ProvisionResource_Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_17ae6090ff270234, []int{19, 1}
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*InvokeAction_Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*InvokeAction_Event_Progress_)(nil),
+		(*InvokeAction_Event_Completed_)(nil),
+	}
 }
 
-func (m *ProvisionResource_Response) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ProvisionResource_Response.Unmarshal(m, b)
+type InvokeAction_Event_Progress struct {
+	// message to be printed in the console / HCPT
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ProvisionResource_Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ProvisionResource_Response.Marshal(b, m, deterministic)
+
+func (m *InvokeAction_Event_Progress) Reset()         { *m = InvokeAction_Event_Progress{} }
+func (m *InvokeAction_Event_Progress) String() string { return proto.CompactTextString(m) }
+func (*InvokeAction_Event_Progress) ProtoMessage()    {}
+func (*InvokeAction_Event_Progress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{42, 1, 0}
 }
-func (m *ProvisionResource_Response) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProvisionResource_Response.Merge(m, src)
+
+func (m *InvokeAction_Event_Progress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeAction_Event_Progress.Unmarshal(m, b)
 }
-func (m *ProvisionResource_Response) XXX_Size() int {
-	return xxx_messageInfo_ProvisionResource_Response.Size(m)
+func (m *InvokeAction_Event_Progress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeAction_Event_Progress.Marshal(b, m, deterministic)
 }
-func (m *ProvisionResource_Response) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProvisionResource_Response.DiscardUnknown(m)
+func (m *InvokeAction_Event_Progress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeAction_Event_Progress.Merge(m, src)
+}
+func (m *InvokeAction_Event_Progress) XXX_Size() int {
+	return xxx_messageInfo_InvokeAction_Event_Progress.Size(m)
+}
+func (m *InvokeAction_Event_Progress) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeAction_Event_Progress.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProvisionResource_Response proto.InternalMessageInfo
+var xxx_messageInfo_InvokeAction_Event_Progress proto.InternalMessageInfo
 
-func (m *ProvisionResource_Response) GetOutput() string {
+func (m *InvokeAction_Event_Progress) GetMessage() string {
 	if m != nil {
-		return m.Output
+		return m.Message
 	}
 	return ""
 }
 
-func (m *ProvisionResource_Response) GetDiagnostics() []*Diagnostic {
+type InvokeAction_Event_Completed struct {
+	Diagnostics          []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *InvokeAction_Event_Completed) Reset()         { *m = InvokeAction_Event_Completed{} }
+func (m *InvokeAction_Event_Completed) String() string { return proto.CompactTextString(m) }
+func (*InvokeAction_Event_Completed) ProtoMessage()    {}
+func (*InvokeAction_Event_Completed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17ae6090ff270234, []int{42, 1, 1}
+}
+
+func (m *InvokeAction_Event_Completed) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvokeAction_Event_Completed.Unmarshal(m, b)
+}
+func (m *InvokeAction_Event_Completed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvokeAction_Event_Completed.Marshal(b, m, deterministic)
+}
+func (m *InvokeAction_Event_Completed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvokeAction_Event_Completed.Merge(m, src)
+}
+func (m *InvokeAction_Event_Completed) XXX_Size() int {
+	return xxx_messageInfo_InvokeAction_Event_Completed.Size(m)
+}
+func (m *InvokeAction_Event_Completed) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvokeAction_Event_Completed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvokeAction_Event_Completed proto.InternalMessageInfo
+
+func (m *InvokeAction_Event_Completed) GetDiagnostics() []*Diagnostic {
 	if m != nil {
 		return m.Diagnostics
 	}
@@ -2747,8 +6292,10 @@ func init() {
 	proto.RegisterEnum("tfplugin5.StringKind", StringKind_name, StringKind_value)
 	proto.RegisterEnum("tfplugin5.Diagnostic_Severity", Diagnostic_Severity_name, Diagnostic_Severity_value)
 	proto.RegisterEnum("tfplugin5.Schema_NestedBlock_NestingMode", Schema_NestedBlock_NestingMode_name, Schema_NestedBlock_NestingMode_value)
+	proto.RegisterEnum("tfplugin5.Deferred_Reason", Deferred_Reason_name, Deferred_Reason_value)
 	proto.RegisterType((*DynamicValue)(nil), "tfplugin5.DynamicValue")
 	proto.RegisterType((*Diagnostic)(nil), "tfplugin5.Diagnostic")
+	proto.RegisterType((*FunctionError)(nil), "tfplugin5.FunctionError")
 	proto.RegisterType((*AttributePath)(nil), "tfplugin5.AttributePath")
 	proto.RegisterType((*AttributePath_Step)(nil), "tfplugin5.AttributePath.Step")
 	proto.RegisterType((*Stop)(nil), "tfplugin5.Stop")
@@ -2760,10 +6307,33 @@ func init() {
 	proto.RegisterType((*Schema_Block)(nil), "tfplugin5.Schema.Block")
 	proto.RegisterType((*Schema_Attribute)(nil), "tfplugin5.Schema.Attribute")
 	proto.RegisterType((*Schema_NestedBlock)(nil), "tfplugin5.Schema.NestedBlock")
+	proto.RegisterType((*ResourceIdentitySchema)(nil), "tfplugin5.ResourceIdentitySchema")
+	proto.RegisterType((*ResourceIdentitySchema_IdentityAttribute)(nil), "tfplugin5.ResourceIdentitySchema.IdentityAttribute")
+	proto.RegisterType((*ResourceIdentityData)(nil), "tfplugin5.ResourceIdentityData")
+	proto.RegisterType((*ServerCapabilities)(nil), "tfplugin5.ServerCapabilities")
+	proto.RegisterType((*ClientCapabilities)(nil), "tfplugin5.ClientCapabilities")
+	proto.RegisterType((*Function)(nil), "tfplugin5.Function")
+	proto.RegisterType((*Function_Parameter)(nil), "tfplugin5.Function.Parameter")
+	proto.RegisterType((*Function_Return)(nil), "tfplugin5.Function.Return")
+	proto.RegisterType((*ActionSchema)(nil), "tfplugin5.ActionSchema")
+	proto.RegisterType((*Deferred)(nil), "tfplugin5.Deferred")
+	proto.RegisterType((*GetMetadata)(nil), "tfplugin5.GetMetadata")
+	proto.RegisterType((*GetMetadata_Request)(nil), "tfplugin5.GetMetadata.Request")
+	proto.RegisterType((*GetMetadata_Response)(nil), "tfplugin5.GetMetadata.Response")
+	proto.RegisterType((*GetMetadata_FunctionMetadata)(nil), "tfplugin5.GetMetadata.FunctionMetadata")
+	proto.RegisterType((*GetMetadata_DataSourceMetadata)(nil), "tfplugin5.GetMetadata.DataSourceMetadata")
+	proto.RegisterType((*GetMetadata_ResourceMetadata)(nil), "tfplugin5.GetMetadata.ResourceMetadata")
+	proto.RegisterType((*GetMetadata_EphemeralResourceMetadata)(nil), "tfplugin5.GetMetadata.EphemeralResourceMetadata")
+	proto.RegisterType((*GetMetadata_ListResourceMetadata)(nil), "tfplugin5.GetMetadata.ListResourceMetadata")
+	proto.RegisterType((*GetMetadata_ActionMetadata)(nil), "tfplugin5.GetMetadata.ActionMetadata")
 	proto.RegisterType((*GetProviderSchema)(nil), "tfplugin5.GetProviderSchema")
 	proto.RegisterType((*GetProviderSchema_Request)(nil), "tfplugin5.GetProviderSchema.Request")
 	proto.RegisterType((*GetProviderSchema_Response)(nil), "tfplugin5.GetProviderSchema.Response")
+	proto.RegisterMapType((map[string]*ActionSchema)(nil), "tfplugin5.GetProviderSchema.Response.ActionSchemasEntry")
 	proto.RegisterMapType((map[string]*Schema)(nil), "tfplugin5.GetProviderSchema.Response.DataSourceSchemasEntry")
+	proto.RegisterMapType((map[string]*Schema)(nil), "tfplugin5.GetProviderSchema.Response.EphemeralResourceSchemasEntry")
+	proto.RegisterMapType((map[string]*Function)(nil), "tfplugin5.GetProviderSchema.Response.FunctionsEntry")
+	proto.RegisterMapType((map[string]*Schema)(nil), "tfplugin5.GetProviderSchema.Response.ListResourceSchemasEntry")
 	proto.RegisterMapType((map[string]*Schema)(nil), "tfplugin5.GetProviderSchema.Response.ResourceSchemasEntry")
 	proto.RegisterType((*PrepareProviderConfig)(nil), "tfplugin5.PrepareProviderConfig")
 	proto.RegisterType((*PrepareProviderConfig_Request)(nil), "tfplugin5.PrepareProviderConfig.Request")
@@ -2793,6 +6363,9 @@ func init() {
 	proto.RegisterType((*ImportResourceState_Request)(nil), "tfplugin5.ImportResourceState.Request")
 	proto.RegisterType((*ImportResourceState_ImportedResource)(nil), "tfplugin5.ImportResourceState.ImportedResource")
 	proto.RegisterType((*ImportResourceState_Response)(nil), "tfplugin5.ImportResourceState.Response")
+	proto.RegisterType((*MoveResourceState)(nil), "tfplugin5.MoveResourceState")
+	proto.RegisterType((*MoveResourceState_Request)(nil), "tfplugin5.MoveResourceState.Request")
+	proto.RegisterType((*MoveResourceState_Response)(nil), "tfplugin5.MoveResourceState.Response")
 	proto.RegisterType((*ReadDataSource)(nil), "tfplugin5.ReadDataSource")
 	proto.RegisterType((*ReadDataSource_Request)(nil), "tfplugin5.ReadDataSource.Request")
 	proto.RegisterType((*ReadDataSource_Response)(nil), "tfplugin5.ReadDataSource.Response")
@@ -2805,140 +6378,322 @@ func init() {
 	proto.RegisterType((*ProvisionResource)(nil), "tfplugin5.ProvisionResource")
 	proto.RegisterType((*ProvisionResource_Request)(nil), "tfplugin5.ProvisionResource.Request")
 	proto.RegisterType((*ProvisionResource_Response)(nil), "tfplugin5.ProvisionResource.Response")
+	proto.RegisterType((*GetFunctions)(nil), "tfplugin5.GetFunctions")
+	proto.RegisterType((*GetFunctions_Request)(nil), "tfplugin5.GetFunctions.Request")
+	proto.RegisterType((*GetFunctions_Response)(nil), "tfplugin5.GetFunctions.Response")
+	proto.RegisterMapType((map[string]*Function)(nil), "tfplugin5.GetFunctions.Response.FunctionsEntry")
+	proto.RegisterType((*CallFunction)(nil), "tfplugin5.CallFunction")
+	proto.RegisterType((*CallFunction_Request)(nil), "tfplugin5.CallFunction.Request")
+	proto.RegisterType((*CallFunction_Response)(nil), "tfplugin5.CallFunction.Response")
+	proto.RegisterType((*ValidateEphemeralResourceConfig)(nil), "tfplugin5.ValidateEphemeralResourceConfig")
+	proto.RegisterType((*ValidateEphemeralResourceConfig_Request)(nil), "tfplugin5.ValidateEphemeralResourceConfig.Request")
+	proto.RegisterType((*ValidateEphemeralResourceConfig_Response)(nil), "tfplugin5.ValidateEphemeralResourceConfig.Response")
+	proto.RegisterType((*OpenEphemeralResource)(nil), "tfplugin5.OpenEphemeralResource")
+	proto.RegisterType((*OpenEphemeralResource_Request)(nil), "tfplugin5.OpenEphemeralResource.Request")
+	proto.RegisterType((*OpenEphemeralResource_Response)(nil), "tfplugin5.OpenEphemeralResource.Response")
+	proto.RegisterType((*RenewEphemeralResource)(nil), "tfplugin5.RenewEphemeralResource")
+	proto.RegisterType((*RenewEphemeralResource_Request)(nil), "tfplugin5.RenewEphemeralResource.Request")
+	proto.RegisterType((*RenewEphemeralResource_Response)(nil), "tfplugin5.RenewEphemeralResource.Response")
+	proto.RegisterType((*CloseEphemeralResource)(nil), "tfplugin5.CloseEphemeralResource")
+	proto.RegisterType((*CloseEphemeralResource_Request)(nil), "tfplugin5.CloseEphemeralResource.Request")
+	proto.RegisterType((*CloseEphemeralResource_Response)(nil), "tfplugin5.CloseEphemeralResource.Response")
+	proto.RegisterType((*GetResourceIdentitySchemas)(nil), "tfplugin5.GetResourceIdentitySchemas")
+	proto.RegisterType((*GetResourceIdentitySchemas_Request)(nil), "tfplugin5.GetResourceIdentitySchemas.Request")
+	proto.RegisterType((*GetResourceIdentitySchemas_Response)(nil), "tfplugin5.GetResourceIdentitySchemas.Response")
+	proto.RegisterMapType((map[string]*ResourceIdentitySchema)(nil), "tfplugin5.GetResourceIdentitySchemas.Response.IdentitySchemasEntry")
+	proto.RegisterType((*UpgradeResourceIdentity)(nil), "tfplugin5.UpgradeResourceIdentity")
+	proto.RegisterType((*UpgradeResourceIdentity_Request)(nil), "tfplugin5.UpgradeResourceIdentity.Request")
+	proto.RegisterType((*UpgradeResourceIdentity_Response)(nil), "tfplugin5.UpgradeResourceIdentity.Response")
+	proto.RegisterType((*ListResource)(nil), "tfplugin5.ListResource")
+	proto.RegisterType((*ListResource_Request)(nil), "tfplugin5.ListResource.Request")
+	proto.RegisterType((*ListResource_Event)(nil), "tfplugin5.ListResource.Event")
+	proto.RegisterType((*ValidateListResourceConfig)(nil), "tfplugin5.ValidateListResourceConfig")
+	proto.RegisterType((*ValidateListResourceConfig_Request)(nil), "tfplugin5.ValidateListResourceConfig.Request")
+	proto.RegisterType((*ValidateListResourceConfig_Response)(nil), "tfplugin5.ValidateListResourceConfig.Response")
+	proto.RegisterType((*ValidateActionConfig)(nil), "tfplugin5.ValidateActionConfig")
+	proto.RegisterType((*ValidateActionConfig_Request)(nil), "tfplugin5.ValidateActionConfig.Request")
+	proto.RegisterType((*ValidateActionConfig_Response)(nil), "tfplugin5.ValidateActionConfig.Response")
+	proto.RegisterType((*PlanAction)(nil), "tfplugin5.PlanAction")
+	proto.RegisterType((*PlanAction_Request)(nil), "tfplugin5.PlanAction.Request")
+	proto.RegisterType((*PlanAction_Response)(nil), "tfplugin5.PlanAction.Response")
+	proto.RegisterType((*InvokeAction)(nil), "tfplugin5.InvokeAction")
+	proto.RegisterType((*InvokeAction_Request)(nil), "tfplugin5.InvokeAction.Request")
+	proto.RegisterType((*InvokeAction_Event)(nil), "tfplugin5.InvokeAction.Event")
+	proto.RegisterType((*InvokeAction_Event_Progress)(nil), "tfplugin5.InvokeAction.Event.Progress")
+	proto.RegisterType((*InvokeAction_Event_Completed)(nil), "tfplugin5.InvokeAction.Event.Completed")
 }
 
 func init() { proto.RegisterFile("tfplugin5.proto", fileDescriptor_17ae6090ff270234) }
 
 var fileDescriptor_17ae6090ff270234 = []byte{
-	// 2045 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x73, 0x23, 0x47,
-	0x15, 0xdf, 0x19, 0x49, 0xb6, 0xf4, 0x24, 0xdb, 0x72, 0xef, 0x07, 0x62, 0xf2, 0x81, 0x11, 0x04,
-	0x3b, 0x24, 0x2b, 0x07, 0x1b, 0x36, 0xc1, 0x6c, 0xa5, 0xe2, 0xb5, 0x8d, 0xd7, 0xb5, 0x6b, 0xaf,
-	0x69, 0xed, 0xae, 0x29, 0xa8, 0x8a, 0xaa, 0xad, 0x69, 0xcb, 0x83, 0xa5, 0x99, 0x49, 0x4f, 0xcb,
-	0x6b, 0x15, 0x47, 0x0a, 0xce, 0x14, 0x54, 0xe0, 0x00, 0x5c, 0xe0, 0x40, 0x71, 0xe2, 0x46, 0xf1,
-	0x75, 0xe1, 0xce, 0x81, 0x3b, 0xdc, 0x52, 0x1c, 0xb9, 0xf0, 0x17, 0x50, 0xdd, 0xd3, 0x33, 0xd3,
-	0x92, 0x46, 0xf6, 0xd8, 0x4e, 0x8a, 0xca, 0x4d, 0xfd, 0xde, 0xaf, 0xdf, 0x7b, 0xfd, 0xde, 0xaf,
-	0x5f, 0x77, 0x8f, 0x60, 0x8e, 0x1f, 0xf9, 0xdd, 0x7e, 0xc7, 0x71, 0xbf, 0xd6, 0xf0, 0x99, 0xc7,
-	0x3d, 0x54, 0x8a, 0x05, 0xf5, 0xfb, 0x50, 0xd9, 0x1c, 0xb8, 0xa4, 0xe7, 0xb4, 0x9f, 0x93, 0x6e,
-	0x9f, 0xa2, 0x1a, 0x4c, 0xf7, 0x82, 0x8e, 0x4f, 0xda, 0x27, 0x35, 0x63, 0xc1, 0x58, 0xaa, 0xe0,
-	0x68, 0x88, 0x10, 0xe4, 0xbf, 0x17, 0x78, 0x6e, 0xcd, 0x94, 0x62, 0xf9, 0xbb, 0xfe, 0x91, 0x01,
-	0xb0, 0xe9, 0x90, 0x8e, 0xeb, 0x05, 0xdc, 0x69, 0xa3, 0x35, 0x28, 0x06, 0xf4, 0x94, 0x32, 0x87,
-	0x0f, 0xe4, 0xec, 0xd9, 0x95, 0x57, 0x1b, 0x89, 0xef, 0x04, 0xd8, 0x68, 0x2a, 0x14, 0x8e, 0xf1,
-	0xc2, 0x71, 0xd0, 0xef, 0xf5, 0x08, 0x1b, 0x48, 0x0f, 0x25, 0x1c, 0x0d, 0xd1, 0x1d, 0x98, 0xb2,
-	0x29, 0x27, 0x4e, 0xb7, 0x96, 0x93, 0x0a, 0x35, 0x42, 0xf7, 0xa0, 0x44, 0x38, 0x67, 0xce, 0x61,
-	0x9f, 0xd3, 0x5a, 0x7e, 0xc1, 0x58, 0x2a, 0xaf, 0xd4, 0x34, 0x77, 0xeb, 0x91, 0x6e, 0x9f, 0xf0,
-	0x63, 0x9c, 0x40, 0xeb, 0xcb, 0x50, 0x8c, 0xfc, 0xa3, 0x32, 0x4c, 0xef, 0xec, 0x3d, 0x5f, 0x7f,
-	0xbc, 0xb3, 0x59, 0xbd, 0x81, 0x4a, 0x50, 0xd8, 0xc2, 0xf8, 0x09, 0xae, 0x1a, 0x42, 0x7e, 0xb0,
-	0x8e, 0xf7, 0x76, 0xf6, 0xb6, 0xab, 0x66, 0xfd, 0x5f, 0x06, 0xcc, 0x0c, 0x59, 0x43, 0xab, 0x50,
-	0x08, 0x38, 0xf5, 0x83, 0x9a, 0xb1, 0x90, 0x5b, 0x2a, 0xaf, 0xbc, 0x32, 0xc9, 0x6d, 0xa3, 0xc9,
-	0xa9, 0x8f, 0x43, 0xac, 0xf5, 0xa1, 0x01, 0x79, 0x31, 0x46, 0x8b, 0x30, 0x1b, 0x47, 0xd3, 0x72,
-	0x49, 0x8f, 0xca, 0x64, 0x95, 0x1e, 0xde, 0xc0, 0x33, 0xb1, 0x7c, 0x8f, 0xf4, 0x28, 0x6a, 0x00,
-	0xa2, 0x5d, 0xda, 0xa3, 0x2e, 0x6f, 0x9d, 0xd0, 0x41, 0x2b, 0xe0, 0xcc, 0x71, 0x3b, 0x61, 0x7a,
-	0x1e, 0xde, 0xc0, 0x55, 0xa5, 0x7b, 0x44, 0x07, 0x4d, 0xa9, 0x41, 0x4b, 0x30, 0xa7, 0xe3, 0x1d,
-	0x97, 0xcb, 0x94, 0xe5, 0x84, 0xe5, 0x04, 0xbc, 0xe3, 0xf2, 0x07, 0x20, 0x2a, 0xd5, 0xa5, 0x6d,
-	0xee, 0xb1, 0xfa, 0xaa, 0x08, 0xcb, 0xf3, 0xad, 0x12, 0x4c, 0x63, 0xfa, 0x41, 0x9f, 0x06, 0xdc,
-	0x5a, 0x80, 0x22, 0xa6, 0x81, 0xef, 0xb9, 0x01, 0x45, 0xb7, 0xa0, 0xb0, 0xc5, 0x98, 0xc7, 0xc2,
-	0x20, 0x71, 0x38, 0xa8, 0xff, 0xcc, 0x80, 0x22, 0x26, 0x2f, 0x9a, 0x9c, 0x70, 0x1a, 0x53, 0xc3,
-	0x48, 0xa8, 0x81, 0xd6, 0x60, 0xfa, 0xa8, 0x4b, 0x78, 0x8f, 0xf8, 0x35, 0x53, 0x26, 0x69, 0x41,
-	0x4b, 0x52, 0x34, 0xb3, 0xf1, 0xcd, 0x10, 0xb2, 0xe5, 0x72, 0x36, 0xc0, 0xd1, 0x04, 0x6b, 0x0d,
-	0x2a, 0xba, 0x02, 0x55, 0x21, 0x77, 0x42, 0x07, 0x2a, 0x00, 0xf1, 0x53, 0x04, 0x75, 0x2a, 0xf8,
-	0xaa, 0xb8, 0x12, 0x0e, 0xd6, 0xcc, 0x77, 0x8c, 0xfa, 0xdf, 0xa7, 0x61, 0xaa, 0xd9, 0x3e, 0xa6,
-	0x3d, 0x22, 0x28, 0x75, 0x4a, 0x59, 0xe0, 0xa8, 0xc8, 0x72, 0x38, 0x1a, 0xa2, 0xbb, 0x50, 0x38,
-	0xec, 0x7a, 0xed, 0x13, 0x39, 0xbd, 0xbc, 0xf2, 0x19, 0x2d, 0xb4, 0x70, 0x6e, 0xe3, 0x81, 0x50,
-	0xe3, 0x10, 0x65, 0xfd, 0xda, 0x84, 0x82, 0x14, 0x9c, 0x63, 0xf2, 0x1b, 0x00, 0x71, 0xf1, 0x02,
-	0xb5, 0xe4, 0x97, 0xc6, 0xed, 0xc6, 0xf4, 0xc0, 0x1a, 0x1c, 0xbd, 0x0b, 0x65, 0xe9, 0xa9, 0xc5,
-	0x07, 0x3e, 0x0d, 0x6a, 0xb9, 0x31, 0x56, 0xa9, 0xd9, 0x7b, 0x34, 0xe0, 0xd4, 0x0e, 0x63, 0x03,
-	0x39, 0xe3, 0xa9, 0x98, 0x80, 0x16, 0xa0, 0x6c, 0xd3, 0xa0, 0xcd, 0x1c, 0x9f, 0x8b, 0xd0, 0xf2,
-	0x32, 0x29, 0xba, 0x08, 0xbd, 0x07, 0x55, 0x6d, 0xd8, 0x3a, 0x71, 0x5c, 0xbb, 0x56, 0x90, 0x5b,
-	0xf4, 0xb6, 0xee, 0x46, 0xf2, 0xe8, 0x91, 0xe3, 0xda, 0x78, 0x4e, 0x83, 0x0b, 0x01, 0x7a, 0x15,
-	0xc0, 0xa6, 0x3e, 0xa3, 0x6d, 0xc2, 0xa9, 0x5d, 0x9b, 0x5a, 0x30, 0x96, 0x8a, 0x58, 0x93, 0x58,
-	0xbf, 0x33, 0xa1, 0x14, 0xaf, 0x4e, 0x50, 0x22, 0x61, 0x36, 0x96, 0xbf, 0x85, 0x4c, 0xac, 0x2f,
-	0xea, 0x20, 0xe2, 0xf7, 0x68, 0xe4, 0xb9, 0xf1, 0xc8, 0x2d, 0x28, 0x32, 0xfa, 0x41, 0xdf, 0x61,
-	0xd4, 0x96, 0x0b, 0x2b, 0xe2, 0x78, 0x2c, 0x74, 0x9e, 0x44, 0x91, 0xae, 0x5c, 0x4d, 0x11, 0xc7,
-	0x63, 0xa1, 0x6b, 0x7b, 0x3d, 0xbf, 0x9f, 0x44, 0x1b, 0x8f, 0xd1, 0xcb, 0x50, 0x0a, 0xa8, 0x1b,
-	0x38, 0xdc, 0x39, 0xa5, 0xb5, 0x69, 0xa9, 0x4c, 0x04, 0xa9, 0xb9, 0x2a, 0x5e, 0x23, 0x57, 0xa5,
-	0xb1, 0x5c, 0xfd, 0xd6, 0x84, 0xb2, 0x56, 0x4b, 0xf4, 0x12, 0x94, 0x44, 0x36, 0xb4, 0x66, 0x80,
-	0x8b, 0x42, 0x20, 0xbb, 0xc0, 0xe5, 0xc8, 0x8a, 0x36, 0x60, 0xda, 0xa5, 0x01, 0x17, 0x9d, 0x22,
-	0x27, 0x83, 0x7e, 0xfd, 0x5c, 0x1e, 0xc9, 0xdf, 0x8e, 0xdb, 0xd9, 0xf5, 0x6c, 0x8a, 0xa3, 0x99,
-	0x22, 0xa0, 0x9e, 0xe3, 0xb6, 0x1c, 0x4e, 0x7b, 0x81, 0xcc, 0x7a, 0x0e, 0x17, 0x7b, 0x8e, 0xbb,
-	0x23, 0xc6, 0x52, 0x49, 0xce, 0x94, 0xb2, 0xa0, 0x94, 0xe4, 0x4c, 0x2a, 0xeb, 0xbb, 0xe1, 0xca,
-	0x94, 0xc5, 0xe1, 0x06, 0x0b, 0x30, 0xd5, 0xdc, 0xd9, 0xdb, 0x7e, 0xbc, 0x55, 0x35, 0x50, 0x11,
-	0xf2, 0x8f, 0x77, 0x9a, 0x4f, 0xab, 0x26, 0x9a, 0x86, 0x5c, 0x73, 0xeb, 0x69, 0x35, 0x27, 0x7e,
-	0xec, 0xae, 0xef, 0x57, 0xf3, 0xa2, 0x11, 0x6f, 0xe3, 0x27, 0xcf, 0xf6, 0xab, 0x85, 0xfa, 0x3f,
-	0xf2, 0x30, 0xbf, 0x4d, 0xf9, 0x3e, 0xf3, 0x4e, 0x1d, 0x9b, 0xb2, 0x30, 0x7e, 0xbd, 0x55, 0xfd,
-	0x3e, 0xaf, 0xf5, 0xaa, 0xbb, 0x50, 0xf4, 0x15, 0x52, 0xa6, 0xb1, 0xbc, 0x32, 0x3f, 0xb6, 0x78,
-	0x1c, 0x43, 0x10, 0x85, 0x2a, 0xa3, 0x81, 0xd7, 0x67, 0x6d, 0xda, 0x0a, 0xa4, 0x32, 0xda, 0xb9,
-	0x6b, 0xda, 0xb4, 0x31, 0xf7, 0x8d, 0xc8, 0x9f, 0xf8, 0x21, 0x67, 0x87, 0xf2, 0x20, 0x6c, 0x63,
-	0x73, 0x6c, 0x58, 0x8a, 0xba, 0x70, 0xd3, 0x26, 0x9c, 0xb4, 0x46, 0x3c, 0x85, 0xbb, 0xfc, 0x7e,
-	0x36, 0x4f, 0x9b, 0x84, 0x93, 0xe6, 0xb8, 0xaf, 0x79, 0x7b, 0x54, 0x8e, 0xde, 0x86, 0xb2, 0x1d,
-	0x9f, 0xb4, 0xa2, 0x78, 0xc2, 0xcb, 0xed, 0xd4, 0x73, 0x18, 0xeb, 0x48, 0x74, 0x0f, 0x66, 0xa2,
-	0xcc, 0xb4, 0x7a, 0x94, 0x13, 0x59, 0xda, 0xd4, 0x0c, 0x56, 0x22, 0xdc, 0x2e, 0xe5, 0xc4, 0x7a,
-	0x06, 0xb7, 0xd2, 0xf2, 0x90, 0xd2, 0xb5, 0x17, 0xf5, 0xae, 0x9d, 0x6a, 0x39, 0x69, 0xe4, 0xd6,
-	0x01, 0xdc, 0x49, 0x5f, 0xf4, 0x35, 0x0d, 0xd7, 0xff, 0x69, 0xc0, 0xed, 0x7d, 0x46, 0x7d, 0xc2,
-	0x68, 0x94, 0xed, 0x0d, 0xcf, 0x3d, 0x72, 0x3a, 0xd6, 0x5a, 0x4c, 0x2b, 0xb4, 0x0c, 0x53, 0x6d,
-	0x29, 0x54, 0x3c, 0xd2, 0x77, 0x9d, 0x7e, 0x61, 0xc2, 0x0a, 0x66, 0xfd, 0xd0, 0xd0, 0x78, 0xf8,
-	0x1e, 0xcc, 0xf9, 0xa1, 0x07, 0xbb, 0x95, 0xcd, 0xcc, 0x6c, 0x84, 0x0f, 0x43, 0x19, 0xad, 0xa2,
-	0x99, 0xb5, 0x8a, 0xf5, 0x1f, 0x9b, 0x70, 0xeb, 0x99, 0xdf, 0x61, 0xc4, 0xa6, 0x71, 0x55, 0xc4,
-	0x51, 0x6b, 0xb1, 0x64, 0x71, 0xe7, 0xb6, 0x1b, 0xed, 0x88, 0x33, 0x87, 0x8f, 0xb8, 0xb7, 0xa0,
-	0xc4, 0xc8, 0x8b, 0x56, 0x20, 0xcc, 0xc9, 0xde, 0x52, 0x5e, 0xb9, 0x99, 0x72, 0xa8, 0xe3, 0x22,
-	0x53, 0xbf, 0xac, 0x1f, 0xe8, 0x49, 0x79, 0x17, 0x66, 0xfb, 0x61, 0x60, 0xb6, 0xb2, 0x71, 0x41,
-	0x4e, 0x66, 0x22, 0x78, 0x78, 0xcb, 0xb8, 0x72, 0x4a, 0xfe, 0x6c, 0x80, 0xf5, 0x9c, 0x74, 0x1d,
-	0x5b, 0x04, 0xa7, 0x72, 0x22, 0xce, 0x4d, 0x55, 0xf5, 0x83, 0x8c, 0x89, 0x49, 0x28, 0x61, 0x66,
-	0xa3, 0xc4, 0x86, 0xb6, 0xf8, 0x91, 0xe0, 0x8d, 0xcc, 0xc1, 0xff, 0xd1, 0x80, 0x5a, 0x14, 0x7c,
-	0xb2, 0x1f, 0x3e, 0x15, 0xa1, 0xff, 0xc9, 0x80, 0x52, 0x18, 0x68, 0x9f, 0x51, 0xab, 0x93, 0xc4,
-	0xfa, 0x06, 0xcc, 0x73, 0xca, 0x18, 0x39, 0xf2, 0x58, 0xaf, 0xa5, 0xdf, 0xa7, 0x4a, 0xb8, 0x1a,
-	0x2b, 0x9e, 0x2b, 0xd6, 0xfd, 0x7f, 0x62, 0xff, 0xc8, 0x84, 0x0a, 0xa6, 0xc4, 0x8e, 0xf8, 0x62,
-	0xfd, 0xd5, 0xc8, 0x98, 0xeb, 0xfb, 0x30, 0xd3, 0xee, 0x33, 0x26, 0x2e, 0xe1, 0x21, 0xcb, 0x2f,
-	0x08, 0xbb, 0xa2, 0xd0, 0x21, 0xc9, 0x6b, 0x30, 0xed, 0x33, 0xe7, 0x34, 0xda, 0x61, 0x15, 0x1c,
-	0x0d, 0x85, 0xdd, 0xe1, 0xf6, 0x9c, 0xbf, 0xc0, 0xee, 0x50, 0x93, 0xfe, 0xa9, 0xbe, 0x13, 0xbf,
-	0x0a, 0x25, 0x97, 0xbe, 0xc8, 0xb6, 0x09, 0x8b, 0x2e, 0x7d, 0x71, 0xbd, 0xfd, 0x37, 0x79, 0x4d,
-	0xf5, 0xff, 0xe6, 0x01, 0xed, 0x77, 0x89, 0x1b, 0x65, 0x79, 0xe3, 0x98, 0xb8, 0x1d, 0x6a, 0xfd,
-	0xc5, 0xcc, 0x98, 0xeb, 0x77, 0xa0, 0xec, 0x33, 0xc7, 0x63, 0xd9, 0x32, 0x0d, 0x12, 0x1b, 0x2e,
-	0x66, 0x0b, 0x90, 0xcf, 0x3c, 0xdf, 0x0b, 0xa8, 0xdd, 0x4a, 0x72, 0x91, 0x3b, 0xdf, 0x40, 0x35,
-	0x9a, 0xb2, 0x17, 0xe5, 0x24, 0x21, 0x67, 0x3e, 0x13, 0x39, 0xd1, 0x17, 0x44, 0x15, 0x45, 0xc4,
-	0x51, 0x46, 0x0a, 0x32, 0x23, 0x15, 0x29, 0xdc, 0x9f, 0x54, 0xea, 0xa9, 0xcb, 0x94, 0xfa, 0x57,
-	0xa6, 0x56, 0x6a, 0x61, 0xaa, 0x4b, 0x5c, 0x37, 0x6b, 0xcf, 0xad, 0x28, 0x74, 0xb8, 0xbc, 0x0d,
-	0x71, 0x41, 0x92, 0x77, 0xed, 0xa0, 0xc5, 0xa8, 0xdf, 0x25, 0x6d, 0xaa, 0xea, 0x3e, 0xf9, 0xa5,
-	0x3d, 0x17, 0xcd, 0xc0, 0xe1, 0x04, 0xb4, 0x08, 0x73, 0x51, 0x08, 0xc3, 0x34, 0x98, 0x55, 0xe2,
-	0x68, 0xd9, 0x57, 0xbe, 0xb9, 0xbc, 0x09, 0xa8, 0x4b, 0x3b, 0xa4, 0x3d, 0x90, 0xef, 0xa7, 0x56,
-	0x30, 0x08, 0x38, 0xed, 0xa9, 0x07, 0x41, 0x35, 0xd4, 0x88, 0x7e, 0xdf, 0x94, 0xf2, 0xfa, 0x4f,
-	0xf2, 0x70, 0x73, 0xdd, 0xf7, 0xbb, 0x83, 0x11, 0xd6, 0xfd, 0xe1, 0x93, 0x67, 0xdd, 0x58, 0x35,
-	0x72, 0x97, 0xa9, 0xc6, 0xa5, 0xc9, 0x96, 0x92, 0xf9, 0x42, 0x6a, 0xe6, 0xaf, 0x47, 0xb8, 0xbf,
-	0x5d, 0xbf, 0xb7, 0x68, 0x2d, 0xc2, 0x1c, 0x6e, 0x7b, 0x23, 0xa4, 0xc8, 0x5d, 0x93, 0x14, 0xf9,
-	0x09, 0xa4, 0xf8, 0x8f, 0x09, 0x37, 0x77, 0x7a, 0xbe, 0xc7, 0xf8, 0xf0, 0xad, 0xe9, 0x5e, 0x46,
-	0x4e, 0xcc, 0x82, 0xe9, 0xd8, 0xea, 0x6b, 0x84, 0xe9, 0xd8, 0xd6, 0x19, 0x54, 0x43, 0x73, 0x34,
-	0x3e, 0x42, 0x2e, 0x7c, 0xe5, 0x65, 0xa2, 0x53, 0x88, 0x9a, 0xdc, 0x53, 0xad, 0xdf, 0xe8, 0xd5,
-	0x78, 0x1f, 0x90, 0xa3, 0xc2, 0x68, 0x45, 0xcf, 0x92, 0xe8, 0x18, 0x5c, 0xd6, 0x5c, 0xa4, 0x2c,
-	0xbd, 0x31, 0x1a, 0x3f, 0x9e, 0x77, 0x46, 0x24, 0xc1, 0xd5, 0xef, 0x64, 0xbf, 0x34, 0x61, 0x56,
-	0x9c, 0xaf, 0xc9, 0x95, 0xc6, 0xfa, 0xd0, 0xf8, 0x84, 0x6e, 0x33, 0xe3, 0xf4, 0xce, 0x5d, 0x86,
-	0xde, 0x6c, 0xe8, 0x81, 0x59, 0xc8, 0xc4, 0x6c, 0x55, 0xa5, 0x2b, 0xa7, 0xe7, 0x17, 0x06, 0xdc,
-	0x8a, 0x5e, 0x83, 0xe2, 0x16, 0x94, 0xf6, 0xf2, 0x3d, 0xd3, 0xe2, 0x5a, 0x15, 0x2d, 0x29, 0xc6,
-	0x4e, 0x7e, 0xfb, 0xea, 0xa8, 0x6b, 0x14, 0xcf, 0x80, 0xcf, 0x46, 0x77, 0x52, 0x2d, 0xc4, 0x8f,
-	0xe1, 0x15, 0xf5, 0xb1, 0xdc, 0xdd, 0xfe, 0x6d, 0xc0, 0x7c, 0x1c, 0x56, 0x7c, 0x81, 0x0b, 0xae,
-	0x1e, 0x16, 0x7a, 0x1b, 0xa0, 0xed, 0xb9, 0x2e, 0x6d, 0xf3, 0xe8, 0x59, 0x74, 0x5e, 0xc3, 0x4f,
-	0xa0, 0xd6, 0x77, 0xb5, 0xf5, 0xdc, 0x81, 0x29, 0xaf, 0xcf, 0xfd, 0x3e, 0x57, 0x84, 0x56, 0xa3,
-	0x2b, 0x97, 0xe1, 0xcb, 0xaf, 0x01, 0x24, 0x1f, 0xa1, 0x50, 0x09, 0x0a, 0xfb, 0x8f, 0xd7, 0x77,
-	0xf6, 0xaa, 0x37, 0x50, 0x05, 0x8a, 0xbb, 0xeb, 0xf8, 0xd1, 0xe6, 0x93, 0x83, 0xbd, 0xaa, 0xb1,
-	0xf2, 0xf3, 0x12, 0x14, 0xa3, 0x87, 0x2e, 0xfa, 0x36, 0x94, 0xb6, 0x29, 0x57, 0x1f, 0x48, 0xbf,
-	0x78, 0xc1, 0xb7, 0x87, 0x90, 0x67, 0xaf, 0x65, 0xfa, 0x42, 0x81, 0xba, 0x13, 0x5e, 0xd5, 0x68,
-	0x49, 0x9b, 0x9f, 0x8a, 0x88, 0x3d, 0xbd, 0x9e, 0x01, 0xa9, 0xbc, 0x7d, 0xff, 0xbc, 0x27, 0x1d,
-	0xba, 0xab, 0x19, 0x9a, 0x0c, 0x8b, 0xfd, 0x36, 0xb2, 0xc2, 0x95, 0xf3, 0xfe, 0xe4, 0x27, 0x19,
-	0x7a, 0x23, 0xc5, 0xd6, 0x28, 0x28, 0x76, 0xfc, 0x66, 0x36, 0xb0, 0x72, 0xeb, 0xa4, 0xbf, 0xec,
-	0xd1, 0xa2, 0x66, 0x25, 0x0d, 0x10, 0xbb, 0x5b, 0xba, 0x18, 0xa8, 0x5c, 0x3d, 0xd4, 0x5e, 0x6e,
-	0xe8, 0x65, 0x6d, 0x5a, 0x2c, 0x8d, 0x8d, 0xbe, 0x32, 0x41, 0xab, 0x2c, 0x7d, 0x6b, 0xf8, 0x1d,
-	0x85, 0x3e, 0xa7, 0x7f, 0x31, 0xd0, 0x14, 0xb1, 0xbd, 0x85, 0xc9, 0x00, 0x65, 0xb2, 0x9d, 0xf6,
-	0x68, 0x40, 0x3a, 0x4d, 0xc7, 0xd5, 0xb1, 0xf9, 0x2f, 0x5d, 0x04, 0x53, 0x4e, 0x8e, 0x52, 0x2f,
-	0x89, 0x48, 0x9f, 0x9e, 0xa2, 0x8f, 0xdd, 0x2c, 0x5e, 0x88, 0x4b, 0xfc, 0xa4, 0x1c, 0xbe, 0x43,
-	0x7e, 0xd2, 0x0e, 0xe7, 0x34, 0x3f, 0xe9, 0x38, 0xe5, 0xe7, 0x60, 0xf4, 0xbc, 0x45, 0x9f, 0x1f,
-	0x49, 0x74, 0xa2, 0x8a, 0xad, 0xd7, 0xcf, 0x83, 0x28, 0xc3, 0x5f, 0x0f, 0xff, 0x3e, 0x42, 0x43,
-	0xdf, 0xa5, 0xb9, 0xe7, 0xc7, 0x46, 0x6a, 0xe3, 0x8a, 0x70, 0xea, 0xca, 0x8f, 0x72, 0x50, 0xd6,
-	0xce, 0x0f, 0xf4, 0xbe, 0xde, 0x9c, 0x16, 0x53, 0xda, 0x8e, 0x7e, 0x14, 0xa6, 0xb2, 0x7a, 0x02,
-	0x50, 0x85, 0x7a, 0x76, 0xce, 0xb1, 0x85, 0xd2, 0xf6, 0xe2, 0x18, 0x2a, 0x76, 0x7a, 0x37, 0x23,
-	0x5a, 0x79, 0x3e, 0x4c, 0x39, 0x91, 0x86, 0xda, 0xef, 0x98, 0x36, 0xb5, 0xfd, 0xa6, 0xa1, 0x42,
-	0x0f, 0x6f, 0x19, 0xd7, 0x28, 0xc4, 0x83, 0xd5, 0xef, 0x7c, 0xa5, 0xe3, 0xf0, 0xe3, 0xfe, 0x61,
-	0xa3, 0xed, 0xf5, 0x96, 0x8f, 0x49, 0x70, 0xec, 0xb4, 0x3d, 0xe6, 0x2f, 0xc7, 0x1f, 0x63, 0x96,
-	0x1d, 0x97, 0x53, 0xe6, 0x92, 0xee, 0x72, 0x6c, 0xe2, 0x70, 0x4a, 0xfe, 0x99, 0xbc, 0xfa, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x5b, 0x86, 0xff, 0x5f, 0x1e, 0x00, 0x00,
+	// 4265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x5b, 0x6c, 0x24, 0xd9,
+	0x55, 0x53, 0xfd, 0xb0, 0xbb, 0x4f, 0xb7, 0xdb, 0xed, 0xeb, 0x79, 0xf4, 0xd6, 0xee, 0x3c, 0xb6,
+	0xc9, 0xec, 0xcc, 0xec, 0x66, 0xda, 0x13, 0x4f, 0x76, 0x76, 0x33, 0x59, 0x36, 0xf1, 0xd8, 0x1e,
+	0x8f, 0x77, 0x66, 0x6c, 0x4f, 0x79, 0x1e, 0x40, 0xa4, 0x6d, 0x6a, 0xba, 0xef, 0x78, 0x2a, 0xae,
+	0xae, 0xea, 0x54, 0x55, 0xdb, 0x63, 0xf1, 0x95, 0xa0, 0x40, 0xb4, 0x48, 0x08, 0x3e, 0x88, 0x44,
+	0x82, 0xf8, 0x58, 0x14, 0x2d, 0x52, 0x7e, 0xa2, 0x08, 0x04, 0x12, 0x20, 0x3e, 0xe0, 0x03, 0x3e,
+	0x11, 0x48, 0x44, 0x02, 0xbe, 0xd0, 0xfe, 0x80, 0x90, 0x90, 0xe0, 0x87, 0x3f, 0x74, 0x9f, 0x75,
+	0xeb, 0xd5, 0x2e, 0x3f, 0x26, 0xd1, 0xe6, 0xcf, 0x75, 0xcf, 0xb9, 0xe7, 0x7d, 0xce, 0x3d, 0xf7,
+	0xd1, 0x86, 0xe9, 0xe0, 0xd9, 0xd0, 0x1e, 0x6d, 0x59, 0xce, 0xdb, 0x9d, 0xa1, 0xe7, 0x06, 0x2e,
+	0xaa, 0xca, 0x01, 0xfd, 0xfc, 0x96, 0xeb, 0x6e, 0xd9, 0x78, 0x8e, 0x02, 0x9e, 0x8e, 0x9e, 0xcd,
+	0x05, 0xd6, 0x00, 0xfb, 0x81, 0x39, 0x18, 0x32, 0xdc, 0xf6, 0x7b, 0x50, 0x5f, 0xda, 0x73, 0xcc,
+	0x81, 0xd5, 0x7b, 0x6c, 0xda, 0x23, 0x8c, 0x5a, 0x30, 0x39, 0xf0, 0xb7, 0x86, 0x66, 0x6f, 0xbb,
+	0xa5, 0x5d, 0xd0, 0x2e, 0xd7, 0x0d, 0xf1, 0x89, 0x10, 0x94, 0xbe, 0xee, 0xbb, 0x4e, 0xab, 0x40,
+	0x87, 0xe9, 0xdf, 0xed, 0x7f, 0xd7, 0x00, 0x96, 0x2c, 0x73, 0xcb, 0x71, 0xfd, 0xc0, 0xea, 0xa1,
+	0x9b, 0x50, 0xf1, 0xf1, 0x0e, 0xf6, 0xac, 0x60, 0x8f, 0xce, 0x6e, 0xcc, 0x9f, 0xeb, 0x84, 0xc2,
+	0x85, 0x88, 0x9d, 0x4d, 0x8e, 0x65, 0x48, 0x7c, 0xc2, 0xd8, 0x1f, 0x0d, 0x06, 0xa6, 0xb7, 0x47,
+	0x39, 0x54, 0x0d, 0xf1, 0x89, 0x4e, 0xc3, 0x44, 0x1f, 0x07, 0xa6, 0x65, 0xb7, 0x8a, 0x14, 0xc0,
+	0xbf, 0xd0, 0x0d, 0xa8, 0x9a, 0x41, 0xe0, 0x59, 0x4f, 0x47, 0x01, 0x6e, 0x95, 0x2e, 0x68, 0x97,
+	0x6b, 0xf3, 0x2d, 0x85, 0xdd, 0x82, 0x80, 0x6d, 0x98, 0xc1, 0x73, 0x23, 0x44, 0x6d, 0xcf, 0x41,
+	0x45, 0xf0, 0x47, 0x35, 0x98, 0x5c, 0x5d, 0x7b, 0xbc, 0x70, 0x6f, 0x75, 0xa9, 0x79, 0x02, 0x55,
+	0xa1, 0xbc, 0x6c, 0x18, 0xeb, 0x46, 0x53, 0x23, 0xe3, 0x4f, 0x16, 0x8c, 0xb5, 0xd5, 0xb5, 0x95,
+	0x66, 0xa1, 0xbd, 0x0d, 0x53, 0xb7, 0x47, 0x4e, 0x2f, 0xb0, 0x5c, 0x67, 0xd9, 0xf3, 0x5c, 0x8f,
+	0x98, 0x22, 0xc0, 0x2f, 0x02, 0xaa, 0x63, 0xd5, 0xa0, 0x7f, 0xa3, 0x6b, 0x30, 0xf3, 0x8c, 0x23,
+	0x75, 0x4d, 0x6f, 0x6b, 0x34, 0xc0, 0x4e, 0x40, 0x35, 0x29, 0xde, 0x39, 0x61, 0x34, 0x05, 0x68,
+	0x81, 0x43, 0xbe, 0xa3, 0x69, 0xb7, 0x4e, 0x02, 0xea, 0x26, 0xa6, 0xb4, 0xff, 0x55, 0x83, 0xa9,
+	0x88, 0xe8, 0xe8, 0x3a, 0x94, 0xfd, 0x00, 0x0f, 0xfd, 0x96, 0x76, 0xa1, 0x78, 0xb9, 0x36, 0x7f,
+	0x36, 0x4b, 0xc7, 0xce, 0x66, 0x80, 0x87, 0x06, 0xc3, 0xd5, 0x7f, 0x4f, 0x83, 0x12, 0xf9, 0x46,
+	0x97, 0xa0, 0x21, 0x55, 0xef, 0x3a, 0xe6, 0x00, 0x33, 0xa9, 0xef, 0x9c, 0x30, 0xa6, 0xe4, 0xf8,
+	0x9a, 0x39, 0xc0, 0xa8, 0x03, 0x08, 0xdb, 0x98, 0xc8, 0xd0, 0xdd, 0xc6, 0x7b, 0x5d, 0x3f, 0xf0,
+	0x2c, 0x67, 0x8b, 0xf9, 0x82, 0x68, 0xc0, 0x61, 0x77, 0xf1, 0xde, 0x26, 0x85, 0xa0, 0xcb, 0x30,
+	0xad, 0xe2, 0x5b, 0x4e, 0xd0, 0x2a, 0x72, 0x75, 0xa7, 0x42, 0xe4, 0x55, 0x27, 0xb8, 0x05, 0x24,
+	0x2c, 0x6c, 0xdc, 0x0b, 0x5c, 0xaf, 0x7d, 0x9d, 0x88, 0xe5, 0x0e, 0xf5, 0x2a, 0x4c, 0x1a, 0xf8,
+	0x1b, 0x23, 0xec, 0x07, 0xfa, 0x05, 0xa8, 0x18, 0xd8, 0x1f, 0xba, 0x8e, 0x8f, 0xd1, 0x49, 0x28,
+	0x53, 0x13, 0x73, 0xd3, 0xb2, 0x8f, 0xf6, 0x77, 0x35, 0xa8, 0x18, 0xe6, 0xee, 0x66, 0x60, 0x06,
+	0x58, 0xc6, 0xa1, 0x16, 0xc6, 0x21, 0xba, 0x09, 0x93, 0xcf, 0x6c, 0x33, 0x18, 0x98, 0xc3, 0x56,
+	0x81, 0x1a, 0xe9, 0x82, 0x62, 0x24, 0x31, 0xb3, 0x73, 0x9b, 0xa1, 0x2c, 0x3b, 0x81, 0xb7, 0x67,
+	0x88, 0x09, 0xfa, 0x4d, 0xa8, 0xab, 0x00, 0xd4, 0x84, 0xe2, 0x36, 0xde, 0xe3, 0x02, 0x90, 0x3f,
+	0x89, 0x50, 0x3b, 0x24, 0x39, 0x78, 0x60, 0xb2, 0x8f, 0x9b, 0x85, 0x77, 0xb5, 0xf6, 0x7f, 0x4e,
+	0xc2, 0xc4, 0x66, 0xef, 0x39, 0x1e, 0x98, 0x24, 0x7e, 0x77, 0xb0, 0xe7, 0x5b, 0x5c, 0xb2, 0xa2,
+	0x21, 0x3e, 0xd1, 0x55, 0x28, 0x3f, 0xb5, 0xdd, 0xde, 0x36, 0x9d, 0x5e, 0x9b, 0x3f, 0xa3, 0x88,
+	0xc6, 0xe6, 0x76, 0x6e, 0x11, 0xb0, 0xc1, 0xb0, 0xf4, 0x8f, 0x0b, 0x50, 0xa6, 0x03, 0x63, 0x48,
+	0x7e, 0x19, 0x40, 0x3a, 0xcf, 0xe7, 0x2a, 0xbf, 0x9a, 0xa4, 0x2b, 0xc3, 0xc3, 0x50, 0xd0, 0xd1,
+	0xfb, 0x50, 0xa3, 0x9c, 0xba, 0xc1, 0xde, 0x10, 0xfb, 0xad, 0x62, 0x22, 0xaa, 0xf8, 0xec, 0x35,
+	0xec, 0x07, 0xb8, 0xcf, 0x64, 0x03, 0x3a, 0xe3, 0x21, 0x99, 0x80, 0x2e, 0x40, 0xad, 0x8f, 0xfd,
+	0x9e, 0x67, 0x0d, 0x49, 0xe4, 0xd2, 0xcc, 0xab, 0x1a, 0xea, 0x10, 0xfa, 0x2a, 0x34, 0x95, 0xcf,
+	0xee, 0xb6, 0xe5, 0xf4, 0x5b, 0x65, 0x5a, 0x0f, 0x4e, 0xa9, 0x6c, 0x68, 0x1c, 0xdd, 0xb5, 0x9c,
+	0xbe, 0x31, 0xad, 0xa0, 0x93, 0x01, 0x74, 0x0e, 0xa0, 0x8f, 0x87, 0x1e, 0xee, 0x99, 0x01, 0xee,
+	0xb7, 0x26, 0x2e, 0x68, 0x97, 0x2b, 0x86, 0x32, 0xa2, 0xff, 0x5d, 0x01, 0xaa, 0x52, 0x3b, 0x12,
+	0x12, 0x61, 0x64, 0x1b, 0xf4, 0x6f, 0x32, 0x46, 0xf4, 0x13, 0xe5, 0x8a, 0xfc, 0x1d, 0x97, 0xbc,
+	0x98, 0x94, 0x5c, 0x87, 0x8a, 0x87, 0xbf, 0x31, 0xb2, 0x3c, 0xdc, 0xa7, 0x8a, 0x55, 0x0c, 0xf9,
+	0x4d, 0x60, 0x2e, 0xc5, 0x32, 0x6d, 0xaa, 0x4d, 0xc5, 0x90, 0xdf, 0x04, 0xd6, 0x73, 0x07, 0xc3,
+	0x51, 0x28, 0xad, 0xfc, 0x46, 0xaf, 0x41, 0xd5, 0xc7, 0x8e, 0x6f, 0x05, 0xd6, 0x0e, 0x6e, 0x4d,
+	0x52, 0x60, 0x38, 0x90, 0x6a, 0xab, 0xca, 0x11, 0x6c, 0x55, 0x8d, 0xdb, 0x0a, 0x9d, 0x05, 0xd8,
+	0xf5, 0xac, 0x00, 0x77, 0x5d, 0xc7, 0xde, 0x6b, 0x01, 0x13, 0x80, 0x8e, 0xac, 0x3b, 0xf6, 0x9e,
+	0xfe, 0x49, 0x01, 0x6a, 0x8a, 0xab, 0xd1, 0xab, 0x50, 0x25, 0xc6, 0x52, 0x6a, 0x85, 0x51, 0x21,
+	0x03, 0xb4, 0x48, 0x1c, 0x2c, 0x96, 0xd1, 0x22, 0x4c, 0x3a, 0xd8, 0x0f, 0x48, 0x21, 0x29, 0x52,
+	0x9d, 0xae, 0x8c, 0x0d, 0x33, 0xfa, 0xb7, 0xe5, 0x6c, 0xdd, 0x77, 0xfb, 0xd8, 0x10, 0x33, 0x89,
+	0x40, 0x03, 0xcb, 0xe9, 0x5a, 0x01, 0x1e, 0xf8, 0xd4, 0x29, 0x45, 0xa3, 0x32, 0xb0, 0x9c, 0x55,
+	0xf2, 0x4d, 0x81, 0xe6, 0x0b, 0x0e, 0x2c, 0x73, 0xa0, 0xf9, 0x82, 0x02, 0xdb, 0xf7, 0xa1, 0xa6,
+	0x50, 0x8c, 0x16, 0x7b, 0x80, 0x89, 0xcd, 0xd5, 0xb5, 0x95, 0x7b, 0xcb, 0x4d, 0x0d, 0x55, 0xa0,
+	0x74, 0x6f, 0x75, 0xf3, 0x61, 0xb3, 0x80, 0x26, 0xa1, 0xb8, 0xb9, 0xfc, 0xb0, 0x59, 0x24, 0x7f,
+	0xdc, 0x5f, 0xd8, 0x68, 0x96, 0xc8, 0xa2, 0xb0, 0x62, 0xac, 0x3f, 0xda, 0x68, 0x96, 0xdb, 0x3f,
+	0x29, 0xc0, 0x69, 0x03, 0xfb, 0xee, 0xc8, 0xeb, 0xe1, 0xd5, 0x3e, 0x76, 0x02, 0x2b, 0xd8, 0xdb,
+	0x37, 0xfb, 0xfb, 0x30, 0x6b, 0x71, 0xdc, 0x6e, 0x22, 0x67, 0xaf, 0xab, 0x65, 0x2a, 0x95, 0x72,
+	0x47, 0x7c, 0x86, 0xb9, 0x8c, 0xac, 0xf8, 0x90, 0xaf, 0xff, 0x95, 0x06, 0x33, 0x09, 0xcc, 0xdc,
+	0x79, 0xd1, 0x81, 0x59, 0x11, 0xe5, 0xdd, 0x67, 0xae, 0xd7, 0xb5, 0x06, 0x43, 0xd7, 0x63, 0xe5,
+	0xbc, 0x62, 0xcc, 0x08, 0xd0, 0x6d, 0xd7, 0x5b, 0xa5, 0x00, 0x82, 0x2f, 0x22, 0x5f, 0xc5, 0x67,
+	0x09, 0x33, 0x23, 0x40, 0x21, 0x7e, 0x2c, 0xef, 0xca, 0x89, 0xbc, 0x6b, 0x3f, 0x84, 0x93, 0x71,
+	0xfd, 0x97, 0xcc, 0xc0, 0x44, 0xef, 0xc1, 0x94, 0xb4, 0x5e, 0xdf, 0x0c, 0xcc, 0x96, 0x96, 0x88,
+	0x3b, 0xb5, 0x7d, 0x31, 0xea, 0x96, 0x32, 0xbb, 0xfd, 0xc7, 0x1a, 0xa0, 0x4d, 0xec, 0xed, 0x60,
+	0x6f, 0xd1, 0x1c, 0x9a, 0x4f, 0x2d, 0xdb, 0x0a, 0x2c, 0xec, 0xa3, 0xd7, 0xa1, 0x3e, 0xb4, 0x4d,
+	0xa7, 0xdb, 0xc7, 0x7e, 0xe0, 0xb9, 0xac, 0xd4, 0x57, 0x8c, 0x1a, 0x19, 0x5b, 0x62, 0x43, 0xe8,
+	0x2b, 0xf0, 0xda, 0x16, 0x0e, 0xba, 0x43, 0xcf, 0xdd, 0xb1, 0xfa, 0xd8, 0xeb, 0xfa, 0xd4, 0x19,
+	0x5d, 0x99, 0xff, 0x05, 0x3a, 0xe5, 0x95, 0x2d, 0x1c, 0x6c, 0x70, 0x14, 0xe6, 0xae, 0x75, 0x51,
+	0x10, 0x3a, 0x30, 0x3b, 0x70, 0x77, 0x70, 0xd7, 0xe3, 0x5a, 0x75, 0xfd, 0xc0, 0x0c, 0xb0, 0x30,
+	0x29, 0x01, 0x09, 0x7d, 0xe9, 0xda, 0xd4, 0xfe, 0x96, 0x06, 0x68, 0xd1, 0xb6, 0xb0, 0x13, 0x44,
+	0x44, 0xbd, 0x42, 0xaa, 0xc3, 0x33, 0xec, 0x79, 0xa6, 0xdd, 0x35, 0x6d, 0xdb, 0xdd, 0xc5, 0x7d,
+	0x2e, 0xee, 0xb4, 0x18, 0x5f, 0x60, 0xc3, 0x68, 0x01, 0xce, 0x86, 0x69, 0xae, 0x84, 0x9a, 0x9c,
+	0xc7, 0x64, 0xd6, 0x65, 0xe6, 0x87, 0xe1, 0xc3, 0x49, 0xb4, 0x7f, 0xbb, 0x0c, 0x15, 0xd1, 0xe9,
+	0xa0, 0x5f, 0x04, 0x18, 0x9a, 0x9e, 0x39, 0xc0, 0x01, 0xf6, 0xd2, 0x7a, 0x0f, 0x81, 0xd8, 0xd9,
+	0x10, 0x58, 0x86, 0x32, 0x01, 0xdd, 0x03, 0xb4, 0x63, 0x7a, 0x96, 0xd9, 0xb7, 0x7a, 0x5d, 0x39,
+	0xcc, 0xcb, 0xc6, 0x3e, 0x64, 0x66, 0xc4, 0x44, 0x39, 0x84, 0xe6, 0x61, 0xc2, 0xc3, 0xc1, 0xc8,
+	0x63, 0x45, 0xbb, 0x36, 0xaf, 0xa7, 0x51, 0x30, 0x28, 0x86, 0xc1, 0x31, 0xd5, 0x8e, 0xb2, 0x14,
+	0xed, 0x28, 0xf7, 0x8d, 0xc7, 0xd4, 0xaa, 0x3c, 0x71, 0xa0, 0xaa, 0x3c, 0x07, 0xb3, 0xa2, 0x06,
+	0x13, 0x0a, 0x03, 0xec, 0xfb, 0xe6, 0x16, 0xab, 0xff, 0x55, 0x03, 0x29, 0xa0, 0xfb, 0x0c, 0xa2,
+	0xff, 0x8f, 0x06, 0xd5, 0x50, 0xe1, 0xbc, 0xa9, 0x7b, 0x19, 0x9a, 0xd4, 0xbf, 0x5d, 0x67, 0x64,
+	0xdb, 0x5d, 0xd6, 0xa6, 0xb0, 0x20, 0x6b, 0xd0, 0xf1, 0xb5, 0x91, 0x6d, 0xb3, 0xce, 0xfe, 0x1a,
+	0x9c, 0x64, 0x98, 0x23, 0x67, 0xdb, 0x71, 0x77, 0x1d, 0x86, 0xec, 0xf3, 0xac, 0x45, 0x14, 0xf6,
+	0x88, 0x81, 0xe8, 0x04, 0xff, 0xa7, 0x61, 0x26, 0xfd, 0x35, 0x98, 0x60, 0x6e, 0x93, 0xda, 0x69,
+	0xa1, 0x76, 0xed, 0x2f, 0x41, 0x7d, 0x81, 0xfa, 0x96, 0x97, 0xd9, 0x2b, 0x30, 0xc1, 0x32, 0x91,
+	0xd7, 0x81, 0x99, 0xc4, 0x72, 0x62, 0x70, 0x84, 0xf6, 0xc7, 0x1a, 0x54, 0x96, 0x68, 0x8a, 0xe0,
+	0x3e, 0x0b, 0x1f, 0x53, 0x74, 0x8d, 0x8d, 0x48, 0xf8, 0x08, 0xa4, 0x8e, 0x41, 0x31, 0x0c, 0x8e,
+	0xd9, 0x7e, 0x4a, 0x24, 0x23, 0x7f, 0x91, 0x75, 0xe3, 0xd1, 0xda, 0xdd, 0xb5, 0xf5, 0x27, 0x6b,
+	0xcd, 0x13, 0xe8, 0x55, 0x38, 0x63, 0x2c, 0x6f, 0xae, 0x3f, 0x32, 0x16, 0x97, 0xbb, 0x8b, 0xeb,
+	0x6b, 0xb7, 0x57, 0x57, 0xba, 0x02, 0xa8, 0x11, 0xe0, 0x86, 0xb1, 0xfe, 0x78, 0x75, 0x69, 0xd9,
+	0x88, 0x03, 0x0b, 0x68, 0x06, 0xa6, 0x16, 0x6e, 0x6d, 0x2e, 0xaf, 0x3d, 0xec, 0x6e, 0x18, 0xcb,
+	0xc6, 0xf2, 0x83, 0x66, 0xb1, 0xfd, 0xe3, 0x49, 0xa8, 0xad, 0xe0, 0xe0, 0x3e, 0x0e, 0x4c, 0x52,
+	0xdd, 0xd4, 0xae, 0xf8, 0xbf, 0x4a, 0x4a, 0x5b, 0xbc, 0x06, 0xb3, 0x3e, 0xad, 0x63, 0xdd, 0x9e,
+	0x52, 0x1d, 0x5a, 0x5a, 0x22, 0x9b, 0x92, 0xd5, 0xce, 0x40, 0x7e, 0x62, 0x0c, 0xbd, 0x03, 0xb5,
+	0xbe, 0xdc, 0x8d, 0x89, 0xc5, 0xe8, 0x54, 0xea, 0x5e, 0xcd, 0x50, 0x31, 0xd1, 0x3d, 0xa8, 0x13,
+	0x41, 0xbb, 0xac, 0x74, 0x89, 0xe6, 0x51, 0x5d, 0xd5, 0x15, 0x75, 0x3a, 0xa4, 0x08, 0x6f, 0x52,
+	0x4c, 0x31, 0x64, 0xd4, 0xfa, 0x72, 0xcc, 0x47, 0xcb, 0x50, 0x15, 0xf5, 0x91, 0xc4, 0x21, 0x21,
+	0x75, 0x29, 0x83, 0x94, 0xa8, 0x96, 0x92, 0x50, 0x38, 0x93, 0x90, 0x11, 0xfb, 0x28, 0xd2, 0x03,
+	0x8c, 0x23, 0x23, 0x6a, 0x45, 0x48, 0x46, 0xce, 0x44, 0x26, 0xcc, 0xe2, 0xe1, 0x73, 0x3c, 0xc0,
+	0xa4, 0xd8, 0x86, 0x72, 0x4d, 0x50, 0x82, 0xd7, 0x32, 0x08, 0x2e, 0x8b, 0x19, 0x09, 0x01, 0x11,
+	0x8e, 0x83, 0x7c, 0x64, 0x40, 0xc3, 0xb6, 0xfc, 0x40, 0xa1, 0x3e, 0x49, 0xa9, 0xbf, 0x95, 0x41,
+	0xfd, 0x9e, 0xe5, 0x07, 0x09, 0xc2, 0x53, 0xb6, 0x32, 0xea, 0xa3, 0xaf, 0xc0, 0xa4, 0xc9, 0x75,
+	0xaf, 0x52, 0x62, 0x17, 0x33, 0x88, 0x2d, 0x44, 0x35, 0x17, 0xb3, 0x3e, 0x28, 0x55, 0x2a, 0xcd,
+	0xaa, 0xfe, 0x06, 0x34, 0xe3, 0xc6, 0x49, 0x2b, 0x42, 0xfa, 0x17, 0x00, 0x25, 0xdd, 0x3a, 0xb6,
+	0x69, 0xd4, 0xe7, 0xa0, 0x19, 0x57, 0x62, 0xfc, 0x84, 0x77, 0xe1, 0x95, 0x4c, 0xbb, 0x8e, 0x9f,
+	0x79, 0x1d, 0x4e, 0xa6, 0xd9, 0x6c, 0xfc, 0xa4, 0xab, 0xd0, 0x88, 0xda, 0x66, 0x2c, 0x7a, 0xfb,
+	0x2f, 0x6b, 0x30, 0xb3, 0x12, 0x5f, 0xf8, 0xd5, 0xd4, 0xfd, 0x7e, 0x4d, 0x49, 0xdd, 0xab, 0x50,
+	0x11, 0x5d, 0x44, 0x76, 0xd1, 0x92, 0x28, 0x08, 0x43, 0x33, 0x6c, 0x19, 0x28, 0x50, 0xa4, 0xe7,
+	0xcd, 0xa8, 0x5b, 0xa3, 0xec, 0x3b, 0x82, 0x9f, 0x4c, 0x14, 0x36, 0xee, 0xb3, 0xcd, 0xee, 0xb4,
+	0x17, 0x1d, 0x45, 0x36, 0xcc, 0x2a, 0x79, 0x2c, 0x39, 0xb1, 0x74, 0x7e, 0x2f, 0x1f, 0xa7, 0x30,
+	0x0c, 0x22, 0xbc, 0x66, 0xfa, 0xf1, 0xf1, 0x78, 0xb9, 0x29, 0xe5, 0x2e, 0x37, 0x37, 0x60, 0x4a,
+	0xb6, 0x60, 0x03, 0x1c, 0x98, 0xad, 0x72, 0x96, 0x05, 0xeb, 0x02, 0x8f, 0xf8, 0x30, 0xab, 0x5e,
+	0x4e, 0x1c, 0xb6, 0x5e, 0x1a, 0x6a, 0x85, 0x61, 0x29, 0xfb, 0xc5, 0x7c, 0x46, 0x12, 0x39, 0xc5,
+	0x8d, 0xa3, 0x94, 0x9b, 0x6f, 0x6a, 0xa0, 0x27, 0xeb, 0x8d, 0x74, 0x45, 0x85, 0x72, 0x59, 0xcc,
+	0xc7, 0x25, 0x91, 0x2d, 0x11, 0x8f, 0xb4, 0x70, 0x06, 0x18, 0x79, 0x70, 0x2a, 0x52, 0x8f, 0x24,
+	0x77, 0x56, 0x49, 0xde, 0xcf, 0xc7, 0x5d, 0xcd, 0xb8, 0x08, 0xe3, 0x59, 0x3b, 0x09, 0x41, 0x5d,
+	0x68, 0xb0, 0xca, 0x23, 0x99, 0xd5, 0x28, 0xb3, 0x77, 0xf3, 0x31, 0x53, 0xfb, 0x01, 0xce, 0x66,
+	0xca, 0x54, 0xc7, 0xf4, 0x47, 0xe1, 0x5e, 0x42, 0x45, 0x4b, 0x39, 0xd8, 0xb9, 0xa4, 0x1e, 0xec,
+	0xa4, 0x86, 0x55, 0x78, 0xd6, 0xa3, 0x3f, 0x81, 0xd3, 0xe9, 0x11, 0x7f, 0x54, 0xc2, 0x0f, 0xa0,
+	0x11, 0x8d, 0x92, 0x14, 0x82, 0x57, 0xa2, 0x04, 0x67, 0x53, 0xda, 0x5f, 0x95, 0xe4, 0x87, 0x70,
+	0x76, 0x6c, 0x48, 0x1c, 0x55, 0xe4, 0x5f, 0x86, 0x56, 0x96, 0xd3, 0x8f, 0x4e, 0x1a, 0x25, 0x5d,
+	0x9c, 0x42, 0xf4, 0x6a, 0x94, 0xa8, 0xba, 0x23, 0x54, 0xe7, 0x2b, 0xa4, 0x3f, 0x28, 0x55, 0xa0,
+	0x59, 0x6b, 0xff, 0x8b, 0x06, 0xa7, 0x36, 0x3c, 0x3c, 0x34, 0x3d, 0x2c, 0x62, 0x6c, 0xd1, 0x75,
+	0x9e, 0x59, 0x5b, 0xfa, 0x4d, 0x59, 0xc2, 0xd1, 0x1c, 0x4c, 0xf4, 0xe8, 0xe0, 0x7e, 0x1b, 0x4e,
+	0x8e, 0xa6, 0x7f, 0x5b, 0x53, 0x6a, 0xfe, 0x57, 0x61, 0x7a, 0xc8, 0x38, 0xf4, 0xbb, 0xf9, 0xc8,
+	0x34, 0x04, 0x3e, 0x13, 0xe5, 0xd0, 0x0d, 0x5a, 0xfb, 0x77, 0x0a, 0x70, 0xf2, 0xd1, 0x70, 0xcb,
+	0x33, 0xfb, 0xd1, 0x0d, 0xa6, 0xee, 0x85, 0xca, 0x8d, 0x3d, 0xe1, 0x51, 0x4e, 0x32, 0x0a, 0xd1,
+	0x93, 0x8c, 0x6b, 0x50, 0xf5, 0xcc, 0x5d, 0x65, 0x23, 0x1b, 0x8d, 0x43, 0x71, 0xcc, 0x6a, 0x54,
+	0x3c, 0xfe, 0x97, 0xfe, 0xeb, 0xaa, 0x51, 0xde, 0x87, 0xc6, 0x88, 0x09, 0xd6, 0xe7, 0x34, 0xf6,
+	0xb1, 0xc9, 0x94, 0x40, 0xa7, 0xc4, 0x0e, 0x6f, 0x92, 0xdf, 0x2a, 0x80, 0xfe, 0xd8, 0xb4, 0xad,
+	0xbe, 0x19, 0x48, 0x9b, 0x90, 0x93, 0x4c, 0xee, 0xf5, 0x4f, 0xb4, 0x9c, 0x96, 0x09, 0x63, 0xa2,
+	0x90, 0x2b, 0x26, 0xc8, 0x2a, 0xd4, 0xa3, 0x5b, 0xfa, 0xe8, 0x2a, 0x54, 0x4c, 0xac, 0x42, 0xc9,
+	0x8d, 0xbf, 0x81, 0x7a, 0x89, 0x31, 0x7d, 0x51, 0xb1, 0x66, 0xcc, 0x1a, 0x5a, 0x6e, 0x6b, 0xfc,
+	0xb9, 0x06, 0x2d, 0x61, 0x8d, 0xb0, 0x9e, 0x71, 0x5b, 0x3c, 0x79, 0x49, 0xa6, 0x38, 0x1e, 0xd1,
+	0x3f, 0x2a, 0x40, 0x95, 0x09, 0x3a, 0xf2, 0xb0, 0xfe, 0x67, 0x8a, 0xdf, 0xde, 0x82, 0x99, 0x00,
+	0x7b, 0x9e, 0xf9, 0xcc, 0xf5, 0x06, 0x5d, 0xf5, 0x20, 0xae, 0x6a, 0x34, 0x25, 0xe0, 0x31, 0x8f,
+	0xe3, 0x9f, 0x0f, 0x3f, 0xfe, 0x6f, 0x09, 0xea, 0x06, 0x36, 0xfb, 0x22, 0xa2, 0xf5, 0x9f, 0x14,
+	0x72, 0x3a, 0xef, 0x3d, 0x98, 0xea, 0x8d, 0x3c, 0x8f, 0xe8, 0xc3, 0xf2, 0x70, 0x1f, 0x33, 0xd4,
+	0x39, 0x36, 0x4b, 0xc3, 0x16, 0x4c, 0x0e, 0x3d, 0x6b, 0x47, 0xd4, 0x80, 0xba, 0x21, 0x3e, 0x09,
+	0xdd, 0x68, 0xb3, 0x56, 0xda, 0x87, 0x6e, 0xbc, 0x65, 0x4b, 0x33, 0x72, 0xf9, 0x90, 0x46, 0x46,
+	0x1f, 0x40, 0x53, 0x68, 0x29, 0xce, 0x04, 0x79, 0xff, 0x77, 0x7e, 0xcc, 0xa1, 0x2b, 0xc9, 0x08,
+	0x63, 0x9a, 0x4f, 0x14, 0x83, 0xfa, 0x77, 0x0a, 0x8a, 0xc7, 0xbe, 0x08, 0x55, 0x07, 0xef, 0xe6,
+	0x2b, 0x61, 0x15, 0x07, 0xef, 0x1e, 0xad, 0x7a, 0x8d, 0xb1, 0xf7, 0x1c, 0x54, 0xfa, 0xfc, 0xec,
+	0xa2, 0x55, 0x4a, 0x94, 0x63, 0x71, 0xac, 0x61, 0x48, 0x24, 0x74, 0x0b, 0xea, 0x44, 0x72, 0x69,
+	0x8e, 0x72, 0x3e, 0x73, 0xd4, 0x1c, 0xbc, 0x2b, 0x06, 0xda, 0xbf, 0x39, 0x09, 0x68, 0xc3, 0x36,
+	0x1d, 0x81, 0xb9, 0xf8, 0xdc, 0x74, 0xb6, 0xb0, 0xfe, 0x8f, 0xc5, 0x9c, 0xc1, 0xf7, 0x2e, 0xd4,
+	0x86, 0x9e, 0xe5, 0x7a, 0xf9, 0x42, 0x0f, 0x28, 0x2e, 0xb3, 0xe0, 0x32, 0xa0, 0xa1, 0xe7, 0x0e,
+	0x5d, 0x1f, 0xf7, 0xbb, 0xa1, 0x03, 0x8a, 0xe3, 0x09, 0x34, 0xc5, 0x94, 0x35, 0xe1, 0x88, 0x30,
+	0xfb, 0x4b, 0xf9, 0xb2, 0xff, 0x17, 0x60, 0x8a, 0x49, 0x2c, 0xdc, 0x50, 0xa6, 0x6e, 0xa8, 0xd3,
+	0xc1, 0x8d, 0xac, 0xd8, 0x9f, 0x38, 0x86, 0xd8, 0x9f, 0x3c, 0x6c, 0xec, 0xdf, 0x86, 0x06, 0x13,
+	0x59, 0xba, 0xba, 0x92, 0xcf, 0xd5, 0x4c, 0x53, 0x19, 0xf7, 0xdf, 0x2b, 0x2a, 0x71, 0x4f, 0x54,
+	0xb4, 0x4d, 0xc7, 0xc9, 0xbb, 0x7c, 0xd7, 0x39, 0x36, 0x33, 0xfb, 0x22, 0x34, 0xf9, 0x3d, 0x82,
+	0xdf, 0xf5, 0xf0, 0xd0, 0x36, 0x7b, 0x98, 0x27, 0x41, 0xf6, 0x9d, 0xfd, 0xb4, 0x98, 0x61, 0xb0,
+	0x09, 0xe8, 0x12, 0x4c, 0x0b, 0x11, 0xa2, 0x39, 0xd1, 0xe0, 0xc3, 0xc2, 0x1d, 0x87, 0xde, 0x70,
+	0x7e, 0x1e, 0x90, 0x8d, 0xb7, 0xcc, 0xde, 0x1e, 0xbd, 0x1c, 0xed, 0xfa, 0x7b, 0x7e, 0x80, 0x07,
+	0xfc, 0xb6, 0xaf, 0xc9, 0x20, 0xa4, 0x75, 0xd8, 0xa4, 0xe3, 0x91, 0x0c, 0x9c, 0xc8, 0x93, 0x81,
+	0x1f, 0x40, 0x53, 0x28, 0x20, 0x5d, 0x33, 0x99, 0xb3, 0x28, 0xf1, 0x89, 0x32, 0x13, 0x3f, 0x2e,
+	0xc3, 0xec, 0xc2, 0x70, 0x68, 0xef, 0xc5, 0x52, 0xf1, 0x5b, 0x2f, 0x3f, 0x15, 0x13, 0xa1, 0x50,
+	0x3c, 0x48, 0x28, 0x1c, 0x38, 0x03, 0x53, 0xdc, 0x5e, 0x4e, 0x75, 0xfb, 0xd1, 0xb2, 0xf0, 0x18,
+	0x9d, 0xa3, 0x7f, 0xfb, 0xe8, 0x2b, 0x86, 0x52, 0xf8, 0x0b, 0xd1, 0xc2, 0x1f, 0x8b, 0xee, 0xe2,
+	0x11, 0xa3, 0xbb, 0x94, 0x11, 0xdd, 0xc7, 0xb1, 0x5c, 0xfc, 0x47, 0x09, 0x66, 0xd9, 0x25, 0x60,
+	0x74, 0x37, 0xf2, 0x37, 0x79, 0x9b, 0xee, 0x06, 0x14, 0xac, 0x3e, 0x7f, 0x78, 0x51, 0xb0, 0xfa,
+	0xc7, 0xdd, 0x8b, 0xa1, 0x2f, 0x43, 0x45, 0x2a, 0x58, 0xca, 0xa7, 0xa0, 0x9c, 0xa0, 0xff, 0xa9,
+	0x06, 0x4d, 0xa6, 0x1d, 0x96, 0x7d, 0xd8, 0xbe, 0xf7, 0xe7, 0xb9, 0xb2, 0xad, 0xec, 0xc7, 0x63,
+	0x20, 0xb6, 0xf8, 0x1f, 0x49, 0xee, 0x7f, 0x52, 0xf7, 0x65, 0x1f, 0x02, 0xb2, 0xb8, 0x0e, 0xca,
+	0xb9, 0x34, 0x6b, 0x44, 0xe7, 0x14, 0x9a, 0x29, 0x6e, 0xec, 0xc4, 0x95, 0x37, 0x66, 0xac, 0xd8,
+	0xc8, 0x11, 0xee, 0x1a, 0xd4, 0xea, 0x5a, 0xcc, 0x51, 0x5d, 0xdb, 0x7f, 0x52, 0x86, 0x99, 0xfb,
+	0xf1, 0x9b, 0x55, 0xfd, 0x87, 0x4a, 0x3d, 0xbc, 0x01, 0x67, 0x18, 0x28, 0xbc, 0xd9, 0x35, 0xfb,
+	0x7d, 0x0f, 0xfb, 0x3e, 0xf7, 0xd4, 0x29, 0x06, 0x16, 0x07, 0x03, 0x0b, 0x0c, 0x48, 0x6e, 0xd9,
+	0xf8, 0xbc, 0xd0, 0xb5, 0x2c, 0x26, 0x1b, 0xe1, 0x7e, 0x92, 0x3a, 0x78, 0x1e, 0x4e, 0x45, 0x8e,
+	0xd2, 0xe4, 0x6e, 0x84, 0xbe, 0x8d, 0x32, 0x66, 0xd5, 0x03, 0x13, 0xb1, 0x21, 0xb9, 0x01, 0xf5,
+	0xc8, 0x25, 0x71, 0x29, 0x7b, 0x6f, 0x5d, 0x53, 0x34, 0x23, 0x52, 0x05, 0xa6, 0x47, 0xee, 0xa9,
+	0x43, 0xa9, 0xd8, 0x25, 0x5d, 0x83, 0x8d, 0x4b, 0xa9, 0x2e, 0x42, 0x43, 0xea, 0xcd, 0xc2, 0x69,
+	0x82, 0x86, 0xd3, 0x94, 0x50, 0x57, 0xd4, 0xcf, 0x69, 0x8e, 0x16, 0x2b, 0x80, 0xa9, 0xb2, 0x34,
+	0xa2, 0x31, 0x86, 0x16, 0xe1, 0x5c, 0x6c, 0x76, 0xdc, 0x06, 0x15, 0x6a, 0x83, 0x57, 0xd3, 0xde,
+	0x39, 0x70, 0x5b, 0xe8, 0xff, 0xad, 0x86, 0xe6, 0x4d, 0xa8, 0x73, 0x05, 0x73, 0xd5, 0xce, 0x1a,
+	0x43, 0x3e, 0x62, 0xc3, 0x7d, 0x11, 0xb8, 0xf5, 0x62, 0x3d, 0xc6, 0x14, 0x1b, 0x15, 0xb6, 0xba,
+	0x03, 0xd3, 0x1c, 0xed, 0xa0, 0x79, 0xc8, 0xc9, 0xcb, 0x1a, 0xf9, 0x87, 0x45, 0x68, 0x90, 0x9d,
+	0x5c, 0xb8, 0x1b, 0xd7, 0x3f, 0x7d, 0x69, 0x67, 0x12, 0x89, 0x25, 0xb2, 0x78, 0x0c, 0x8d, 0x6a,
+	0xe9, 0xb0, 0x3b, 0xe1, 0x3f, 0xd2, 0x22, 0x37, 0x25, 0xe5, 0x5c, 0x6e, 0x2e, 0xfb, 0x47, 0x73,
+	0xf0, 0x81, 0xeb, 0xca, 0xf7, 0x35, 0x38, 0x29, 0x4e, 0xa4, 0x49, 0x90, 0xa6, 0xdd, 0xf9, 0xbc,
+	0x50, 0x14, 0xb9, 0x4e, 0x1a, 0x2b, 0x89, 0x9b, 0x7d, 0xeb, 0xa3, 0x62, 0x1d, 0xfe, 0x78, 0xeb,
+	0x0f, 0x34, 0x78, 0x45, 0x1c, 0xe8, 0x28, 0x22, 0x1e, 0xc3, 0x99, 0xe6, 0xb1, 0x9c, 0x53, 0x7c,
+	0xaa, 0xc1, 0x8c, 0x14, 0x4b, 0x1e, 0x56, 0xf8, 0x87, 0x17, 0x0b, 0xbd, 0x03, 0xd0, 0x73, 0x1d,
+	0x07, 0xd3, 0x53, 0xde, 0x7d, 0xdb, 0xd6, 0x10, 0x55, 0xff, 0x9a, 0xa2, 0xcf, 0x69, 0x98, 0x70,
+	0x47, 0xc1, 0x70, 0x24, 0x1e, 0xf1, 0xf2, 0xaf, 0xc3, 0xbb, 0xe1, 0x9b, 0x05, 0xa8, 0xaf, 0xe0,
+	0x40, 0x9e, 0xe4, 0xab, 0xc1, 0xf1, 0xa9, 0x1a, 0xe6, 0xf7, 0xd5, 0xbb, 0xa4, 0xe4, 0x32, 0xab,
+	0xd2, 0xc8, 0x73, 0x8d, 0x74, 0x58, 0x81, 0x5f, 0xc2, 0xb5, 0x43, 0xfb, 0x1f, 0x34, 0xa8, 0x2f,
+	0x9a, 0xb6, 0x2d, 0x60, 0xfa, 0xc3, 0xd0, 0xcd, 0x69, 0x0f, 0x5a, 0xde, 0x86, 0xaa, 0x78, 0xf7,
+	0x2c, 0x24, 0xcf, 0x74, 0x64, 0x88, 0xa9, 0x6f, 0x2b, 0xd6, 0x9c, 0x23, 0x2f, 0x3b, 0xfc, 0x91,
+	0x1d, 0xec, 0x1b, 0x3d, 0x0c, 0x0d, 0x75, 0xa0, 0x8c, 0xe9, 0x0b, 0xe3, 0x42, 0xe2, 0xc5, 0x78,
+	0xe4, 0x91, 0xb7, 0xc1, 0xd0, 0xda, 0x7f, 0xad, 0xc1, 0x79, 0x91, 0x5e, 0x89, 0x3b, 0x95, 0xcf,
+	0xc4, 0xb1, 0xe9, 0xbf, 0x15, 0xe1, 0xd4, 0xfa, 0x10, 0x3b, 0x09, 0xe9, 0x3f, 0x43, 0x47, 0xdf,
+	0xbf, 0x5f, 0x38, 0x06, 0x4b, 0x90, 0xdf, 0x27, 0x78, 0x98, 0xec, 0x69, 0xcc, 0x80, 0x2b, 0xa2,
+	0x77, 0xd8, 0x0f, 0x24, 0x3a, 0xe2, 0x07, 0x12, 0x9d, 0x87, 0xe2, 0x07, 0x12, 0x77, 0x4e, 0x18,
+	0x93, 0x14, 0x7b, 0x81, 0xbc, 0xd6, 0x57, 0x02, 0xad, 0x98, 0x2f, 0xd0, 0xce, 0x86, 0xbd, 0x3b,
+	0x59, 0x1f, 0xeb, 0x77, 0x34, 0xd9, 0xbd, 0x33, 0x7a, 0xe1, 0x2a, 0x54, 0xce, 0xb1, 0x0a, 0xdd,
+	0xaa, 0x41, 0xb5, 0x2b, 0xa4, 0x27, 0x4f, 0xea, 0x45, 0x7b, 0xd2, 0xfe, 0x01, 0x7d, 0x96, 0xea,
+	0xe0, 0xdd, 0xa4, 0x83, 0x1f, 0xe4, 0xf4, 0xef, 0xd9, 0xd8, 0x5e, 0x93, 0xe8, 0x1e, 0xca, 0xaa,
+	0x72, 0x23, 0xc7, 0xee, 0x3f, 0x63, 0x4f, 0x9c, 0x8d, 0x6d, 0x8a, 0xa2, 0x86, 0xcd, 0xb6, 0xd3,
+	0x8f, 0x34, 0x38, 0xbd, 0x68, 0xbb, 0x3e, 0xfe, 0xa9, 0xd8, 0xe9, 0x58, 0x52, 0xf7, 0x6f, 0x0b,
+	0xa0, 0xaf, 0xe0, 0x20, 0xfd, 0x69, 0x70, 0x64, 0x89, 0xf9, 0x9e, 0x9a, 0x20, 0x0e, 0x34, 0x63,
+	0x2d, 0xb8, 0x60, 0x1a, 0x7b, 0x4f, 0x90, 0x41, 0x38, 0x5c, 0x77, 0x62, 0x00, 0xfe, 0x9a, 0xc4,
+	0x8a, 0x8e, 0x1e, 0x7e, 0x0d, 0xc2, 0x70, 0x32, 0x8d, 0x43, 0xca, 0x4a, 0xf4, 0x4e, 0x74, 0x25,
+	0x7a, 0x7d, 0xdf, 0x87, 0xd3, 0xea, 0xba, 0xf4, 0x49, 0x01, 0xce, 0xc4, 0x2e, 0x45, 0x05, 0xb2,
+	0xfe, 0xe2, 0xc8, 0xf7, 0xa2, 0x37, 0xa0, 0x4e, 0xee, 0x45, 0xe5, 0x36, 0x60, 0xcc, 0xd5, 0x68,
+	0xcd, 0x33, 0xe5, 0xd9, 0x88, 0xfe, 0xbb, 0x6a, 0x26, 0xdd, 0x83, 0x19, 0x79, 0x3b, 0x2a, 0x29,
+	0x69, 0xf9, 0x36, 0x14, 0x4d, 0x31, 0x53, 0x8c, 0x1e, 0xbe, 0x8b, 0xf9, 0x71, 0x11, 0xea, 0xea,
+	0xcd, 0xbe, 0xfe, 0x83, 0x97, 0xb6, 0x44, 0xdc, 0x80, 0x33, 0x96, 0xd3, 0xb3, 0x47, 0x7d, 0xe5,
+	0x91, 0xb4, 0xfb, 0xf4, 0xeb, 0xb8, 0x27, 0x1e, 0x9e, 0x9f, 0xe2, 0x60, 0x21, 0xcb, 0x3a, 0x05,
+	0x92, 0x5f, 0xe3, 0xd8, 0xd6, 0xc0, 0x0a, 0xf8, 0x4f, 0x01, 0xd8, 0x87, 0xfe, 0x7f, 0x1a, 0x94,
+	0x97, 0x77, 0xb0, 0x13, 0x44, 0x4e, 0x46, 0xb4, 0x03, 0x9e, 0x8c, 0x90, 0xa7, 0xe1, 0x7d, 0xcb,
+	0x1f, 0xda, 0xe6, 0x9e, 0xba, 0xc9, 0xaf, 0xf1, 0x31, 0xaa, 0xe8, 0x32, 0x4c, 0xa7, 0xc9, 0x9b,
+	0xad, 0xf1, 0x9d, 0x13, 0x46, 0xc3, 0x8b, 0xe8, 0x40, 0xaa, 0xd8, 0xdb, 0x00, 0xa1, 0xe1, 0xc7,
+	0x9f, 0x50, 0x2b, 0x88, 0xb7, 0x10, 0x34, 0xe3, 0xe6, 0x6a, 0xff, 0x85, 0x72, 0xc1, 0xad, 0x3a,
+	0x8f, 0x77, 0x27, 0xff, 0xfc, 0xd2, 0x5c, 0xb8, 0x3e, 0xde, 0x85, 0x63, 0x28, 0x64, 0xf8, 0xf6,
+	0xaa, 0xea, 0xdb, 0x71, 0x5b, 0x40, 0xe6, 0xf4, 0xe3, 0xba, 0x10, 0x3f, 0x29, 0xac, 0xc7, 0x5e,
+	0x8e, 0x70, 0xbb, 0x7d, 0x2d, 0x34, 0xdb, 0x79, 0xa8, 0xf1, 0x37, 0x4b, 0xf2, 0x89, 0x72, 0xd5,
+	0x00, 0x36, 0x44, 0x0e, 0x59, 0x7e, 0x46, 0x9d, 0xdd, 0x8f, 0x0a, 0x00, 0xe4, 0x32, 0x8e, 0x89,
+	0xad, 0xff, 0x50, 0x7b, 0x89, 0x12, 0x1f, 0x7b, 0x4b, 0x17, 0x1c, 0x47, 0x1f, 0xa1, 0x76, 0x51,
+	0x85, 0x3c, 0x7b, 0xf9, 0xbf, 0x2f, 0x42, 0x7d, 0xd5, 0xd9, 0x71, 0xb7, 0xf1, 0x67, 0xd2, 0x68,
+	0x1f, 0x15, 0x44, 0x99, 0x5b, 0xa2, 0xef, 0x4a, 0xb7, 0xe4, 0xd9, 0x65, 0x6d, 0xfe, 0x0d, 0xf5,
+	0xb0, 0x56, 0x51, 0xb1, 0x43, 0x27, 0x74, 0x36, 0x38, 0xf6, 0x9d, 0x13, 0x86, 0x9c, 0x89, 0x56,
+	0xa0, 0x4a, 0x7e, 0xa7, 0x66, 0xe3, 0x40, 0x1a, 0xf0, 0xd2, 0x78, 0x32, 0x8b, 0x02, 0xfd, 0xce,
+	0x09, 0x23, 0x9c, 0xab, 0x7f, 0x0e, 0x2a, 0x82, 0x01, 0xfd, 0x0d, 0x31, 0xff, 0xb9, 0x03, 0x33,
+	0xa1, 0xf8, 0xd4, 0x97, 0xc8, 0x03, 0x0e, 0x3e, 0xe5, 0xd0, 0x4e, 0xbf, 0x35, 0xc1, 0x7e, 0x29,
+	0xf0, 0xe6, 0x45, 0x80, 0xf0, 0xa7, 0x05, 0xe4, 0x87, 0x5a, 0x1b, 0xf7, 0x16, 0x56, 0xc9, 0x1b,
+	0xfd, 0x3a, 0x54, 0xee, 0x2f, 0x18, 0x77, 0x97, 0xe8, 0xa3, 0xfc, 0xf9, 0xef, 0x9e, 0xa2, 0xb2,
+	0xb1, 0xf7, 0xb5, 0x6b, 0x91, 0x07, 0xf7, 0xe8, 0x5c, 0xe6, 0x73, 0x73, 0xd6, 0x56, 0x9d, 0xcf,
+	0x84, 0xf3, 0xc8, 0xfd, 0x25, 0xa8, 0xae, 0xe0, 0x80, 0xff, 0x3c, 0xe1, 0x73, 0xfb, 0x3c, 0x61,
+	0x64, 0x34, 0x2f, 0xe6, 0x7a, 0xe8, 0x88, 0x7e, 0x6d, 0x5c, 0xeb, 0x87, 0xae, 0xe6, 0x6d, 0xe4,
+	0x18, 0xcf, 0xce, 0xc1, 0xfa, 0x3e, 0x64, 0x67, 0xbc, 0x91, 0x43, 0x97, 0x15, 0x42, 0xa9, 0x18,
+	0x92, 0xe5, 0x95, 0x1c, 0x98, 0xa1, 0xaa, 0xd9, 0x0f, 0xb4, 0x22, 0xaa, 0x66, 0xa3, 0xa5, 0xaa,
+	0x3a, 0x16, 0x9d, 0x33, 0x1f, 0x65, 0xbf, 0x87, 0x42, 0x6f, 0xa5, 0xd0, 0x8a, 0x23, 0x49, 0xc6,
+	0x9f, 0xcf, 0x87, 0xcc, 0xd9, 0x5a, 0xe9, 0xef, 0xf4, 0x90, 0x9a, 0x7e, 0x69, 0x08, 0x92, 0xdd,
+	0xe5, 0xfd, 0x11, 0x39, 0x2b, 0x2f, 0xb3, 0xfb, 0x45, 0x6f, 0x66, 0x13, 0x11, 0x38, 0x92, 0xe1,
+	0x5b, 0xb9, 0x70, 0x39, 0xcf, 0x3b, 0xca, 0x53, 0x2d, 0xf4, 0x9a, 0x5a, 0xe8, 0xc4, 0xa8, 0xa4,
+	0x7b, 0x36, 0x03, 0xca, 0x29, 0x3d, 0x88, 0xbe, 0x73, 0x42, 0xd1, 0x6e, 0x2e, 0x04, 0x48, 0x7a,
+	0x17, 0xb2, 0x11, 0x38, 0xc9, 0x5e, 0xda, 0x1b, 0x16, 0xa4, 0xe6, 0x65, 0x12, 0x2c, 0xc9, 0xbf,
+	0xb1, 0x1f, 0x1a, 0x67, 0xf2, 0x2c, 0xf5, 0x7a, 0x1e, 0xa9, 0xd3, 0x53, 0xe0, 0x92, 0xcd, 0xa5,
+	0x7d, 0xf1, 0x42, 0x3e, 0x29, 0x57, 0x73, 0x11, 0x3e, 0x29, 0xf0, 0x54, 0x3e, 0xe9, 0x78, 0x9c,
+	0xcf, 0xaf, 0xa6, 0x5c, 0xae, 0x45, 0x2a, 0x5e, 0x02, 0x9a, 0x5a, 0xf1, 0xd2, 0xb0, 0x38, 0x87,
+	0x27, 0xf1, 0x7b, 0x10, 0xf4, 0x7a, 0xcc, 0x95, 0x21, 0x48, 0xd2, 0x6e, 0x8f, 0x43, 0xe1, 0x84,
+	0x3f, 0xda, 0xff, 0x0c, 0x0f, 0xcd, 0xa7, 0x64, 0x6f, 0x06, 0xae, 0xe4, 0x7d, 0xfd, 0x40, 0x73,
+	0xc2, 0xd2, 0x9a, 0x7a, 0x1a, 0x17, 0x29, 0xad, 0xa9, 0x18, 0xa9, 0xa5, 0x35, 0x0b, 0x93, 0x73,
+	0x73, 0xb3, 0xce, 0x86, 0xd0, 0x95, 0x88, 0xe1, 0xd2, 0x50, 0x24, 0xbf, 0x37, 0xf3, 0xa0, 0x86,
+	0x0c, 0xd3, 0x0f, 0x59, 0x22, 0x0c, 0xd3, 0x51, 0x52, 0x19, 0x66, 0xa2, 0x72, 0x86, 0x1b, 0xd1,
+	0x1d, 0x6b, 0xa4, 0x3e, 0xa8, 0x80, 0xd4, 0x7a, 0x13, 0x41, 0xa0, 0x0d, 0xce, 0x35, 0x4d, 0x5d,
+	0x8e, 0x92, 0xdb, 0xa9, 0xd4, 0xe5, 0x28, 0x89, 0x36, 0x76, 0x39, 0x4a, 0x45, 0x0f, 0xcb, 0x9d,
+	0x7a, 0x05, 0x80, 0xce, 0x67, 0xdf, 0x0d, 0x24, 0xcb, 0x5d, 0xea, 0xe5, 0x01, 0x7a, 0x10, 0x3d,
+	0x95, 0x8f, 0x90, 0x54, 0x01, 0xa9, 0x24, 0x63, 0x08, 0xe1, 0xea, 0x95, 0xb6, 0x67, 0x8a, 0xac,
+	0x5e, 0x69, 0x08, 0xa9, 0xab, 0x57, 0x06, 0x22, 0x67, 0x75, 0x57, 0xdd, 0xe3, 0xa0, 0xb3, 0xb1,
+	0xea, 0xbb, 0x10, 0x95, 0xfc, 0x5c, 0x16, 0x38, 0x0c, 0x16, 0xb5, 0xa7, 0x8d, 0x98, 0x42, 0x05,
+	0xa4, 0x06, 0x4b, 0xb2, 0x1b, 0xbe, 0xa6, 0xa1, 0x2f, 0xb1, 0x7f, 0x68, 0x82, 0x22, 0xff, 0x0a,
+	0x21, 0x70, 0x87, 0x92, 0x42, 0x2b, 0x09, 0x60, 0xc2, 0xcc, 0xff, 0x46, 0x11, 0x6a, 0xca, 0x8d,
+	0x1d, 0xfa, 0x50, 0xed, 0x25, 0x2f, 0xa5, 0x74, 0x89, 0xea, 0xe5, 0x63, 0xaa, 0x25, 0x33, 0x10,
+	0xb9, 0xf2, 0x2f, 0xc6, 0x5c, 0x14, 0xa2, 0xb4, 0xee, 0x25, 0x81, 0x25, 0x99, 0x5e, 0xcd, 0x89,
+	0xcd, 0x39, 0x3f, 0x4d, 0xb9, 0x03, 0x8c, 0xac, 0x1d, 0x09, 0x68, 0xea, 0xda, 0x91, 0x86, 0xc5,
+	0x38, 0x1c, 0xc9, 0x11, 0xb7, 0xae, 0xff, 0xca, 0x17, 0xb6, 0xac, 0xe0, 0xf9, 0xe8, 0x69, 0xa7,
+	0xe7, 0x0e, 0xe6, 0x9e, 0x9b, 0xfe, 0x73, 0xab, 0xe7, 0x7a, 0xc3, 0x39, 0xf9, 0x74, 0x7c, 0xce,
+	0x72, 0x02, 0xec, 0x39, 0xa6, 0x3d, 0x27, 0x49, 0x3c, 0x9d, 0xa0, 0x07, 0xd5, 0xd7, 0xff, 0x7f,
+	0x00, 0xed, 0xb1, 0x5a, 0x0d, 0x7f, 0x49, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2953,12 +6708,25 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ProviderClient interface {
-	//////// Information about what a provider supports/expects
+	// GetMetadata returns upfront information about server capabilities and
+	// supported resource types without requiring the server to instantiate all
+	// schema information, which may be memory intensive. This RPC is optional,
+	// where clients may receive an unimplemented RPC error. Clients should
+	// ignore the error and call the GetSchema RPC as a fallback.
+	GetMetadata(ctx context.Context, in *GetMetadata_Request, opts ...grpc.CallOption) (*GetMetadata_Response, error)
+	// GetSchema returns schema information for the provider, data resources,
+	// and managed resources.
 	GetSchema(ctx context.Context, in *GetProviderSchema_Request, opts ...grpc.CallOption) (*GetProviderSchema_Response, error)
+	// GetResourceIdentitySchemas returns the identity schemas for all managed
+	// resources.
+	GetResourceIdentitySchemas(ctx context.Context, in *GetResourceIdentitySchemas_Request, opts ...grpc.CallOption) (*GetResourceIdentitySchemas_Response, error)
 	PrepareProviderConfig(ctx context.Context, in *PrepareProviderConfig_Request, opts ...grpc.CallOption) (*PrepareProviderConfig_Response, error)
 	ValidateResourceTypeConfig(ctx context.Context, in *ValidateResourceTypeConfig_Request, opts ...grpc.CallOption) (*ValidateResourceTypeConfig_Response, error)
 	ValidateDataSourceConfig(ctx context.Context, in *ValidateDataSourceConfig_Request, opts ...grpc.CallOption) (*ValidateDataSourceConfig_Response, error)
 	UpgradeResourceState(ctx context.Context, in *UpgradeResourceState_Request, opts ...grpc.CallOption) (*UpgradeResourceState_Response, error)
+	// UpgradeResourceIdentityData should return the upgraded resource identity
+	// data for a managed resource type.
+	UpgradeResourceIdentity(ctx context.Context, in *UpgradeResourceIdentity_Request, opts ...grpc.CallOption) (*UpgradeResourceIdentity_Response, error)
 	//////// One-time initialization, called before other functions below
 	Configure(ctx context.Context, in *Configure_Request, opts ...grpc.CallOption) (*Configure_Response, error)
 	//////// Managed Resource Lifecycle
@@ -2966,7 +6734,25 @@ type ProviderClient interface {
 	PlanResourceChange(ctx context.Context, in *PlanResourceChange_Request, opts ...grpc.CallOption) (*PlanResourceChange_Response, error)
 	ApplyResourceChange(ctx context.Context, in *ApplyResourceChange_Request, opts ...grpc.CallOption) (*ApplyResourceChange_Response, error)
 	ImportResourceState(ctx context.Context, in *ImportResourceState_Request, opts ...grpc.CallOption) (*ImportResourceState_Response, error)
+	MoveResourceState(ctx context.Context, in *MoveResourceState_Request, opts ...grpc.CallOption) (*MoveResourceState_Response, error)
 	ReadDataSource(ctx context.Context, in *ReadDataSource_Request, opts ...grpc.CallOption) (*ReadDataSource_Response, error)
+	//////// Ephemeral Resource Lifecycle
+	ValidateEphemeralResourceConfig(ctx context.Context, in *ValidateEphemeralResourceConfig_Request, opts ...grpc.CallOption) (*ValidateEphemeralResourceConfig_Response, error)
+	OpenEphemeralResource(ctx context.Context, in *OpenEphemeralResource_Request, opts ...grpc.CallOption) (*OpenEphemeralResource_Response, error)
+	RenewEphemeralResource(ctx context.Context, in *RenewEphemeralResource_Request, opts ...grpc.CallOption) (*RenewEphemeralResource_Response, error)
+	CloseEphemeralResource(ctx context.Context, in *CloseEphemeralResource_Request, opts ...grpc.CallOption) (*CloseEphemeralResource_Response, error)
+	/////// List
+	ListResource(ctx context.Context, in *ListResource_Request, opts ...grpc.CallOption) (Provider_ListResourceClient, error)
+	ValidateListResourceConfig(ctx context.Context, in *ValidateListResourceConfig_Request, opts ...grpc.CallOption) (*ValidateListResourceConfig_Response, error)
+	// GetFunctions returns the definitions of all functions.
+	GetFunctions(ctx context.Context, in *GetFunctions_Request, opts ...grpc.CallOption) (*GetFunctions_Response, error)
+	// CallFunction runs the provider-defined function logic and returns
+	// the result with any diagnostics.
+	CallFunction(ctx context.Context, in *CallFunction_Request, opts ...grpc.CallOption) (*CallFunction_Response, error)
+	//////// Actions Lifecycle
+	ValidateActionConfig(ctx context.Context, in *ValidateActionConfig_Request, opts ...grpc.CallOption) (*ValidateActionConfig_Response, error)
+	PlanAction(ctx context.Context, in *PlanAction_Request, opts ...grpc.CallOption) (*PlanAction_Response, error)
+	InvokeAction(ctx context.Context, in *InvokeAction_Request, opts ...grpc.CallOption) (Provider_InvokeActionClient, error)
 	//////// Graceful Shutdown
 	Stop(ctx context.Context, in *Stop_Request, opts ...grpc.CallOption) (*Stop_Response, error)
 }
@@ -2979,6 +6765,15 @@ func NewProviderClient(cc *grpc.ClientConn) ProviderClient {
 	return &providerClient{cc}
 }
 
+func (c *providerClient) GetMetadata(ctx context.Context, in *GetMetadata_Request, opts ...grpc.CallOption) (*GetMetadata_Response, error) {
+	out := new(GetMetadata_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/GetMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerClient) GetSchema(ctx context.Context, in *GetProviderSchema_Request, opts ...grpc.CallOption) (*GetProviderSchema_Response, error) {
 	out := new(GetProviderSchema_Response)
 	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/GetSchema", in, out, opts...)
@@ -2988,6 +6783,15 @@ func (c *providerClient) GetSchema(ctx context.Context, in *GetProviderSchema_Re
 	return out, nil
 }
 
+func (c *providerClient) GetResourceIdentitySchemas(ctx context.Context, in *GetResourceIdentitySchemas_Request, opts ...grpc.CallOption) (*GetResourceIdentitySchemas_Response, error) {
+	out := new(GetResourceIdentitySchemas_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/GetResourceIdentitySchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerClient) PrepareProviderConfig(ctx context.Context, in *PrepareProviderConfig_Request, opts ...grpc.CallOption) (*PrepareProviderConfig_Response, error) {
 	out := new(PrepareProviderConfig_Response)
 	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/PrepareProviderConfig", in, out, opts...)
@@ -3024,6 +6828,15 @@ func (c *providerClient) UpgradeResourceState(ctx context.Context, in *UpgradeRe
 	return out, nil
 }
 
+func (c *providerClient) UpgradeResourceIdentity(ctx context.Context, in *UpgradeResourceIdentity_Request, opts ...grpc.CallOption) (*UpgradeResourceIdentity_Response, error) {
+	out := new(UpgradeResourceIdentity_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/UpgradeResourceIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *providerClient) Configure(ctx context.Context, in *Configure_Request, opts ...grpc.CallOption) (*Configure_Response, error) {
 	out := new(Configure_Response)
 	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/Configure", in, out, opts...)
@@ -3069,13 +6882,167 @@ func (c *providerClient) ImportResourceState(ctx context.Context, in *ImportReso
 	return out, nil
 }
 
-func (c *providerClient) ReadDataSource(ctx context.Context, in *ReadDataSource_Request, opts ...grpc.CallOption) (*ReadDataSource_Response, error) {
-	out := new(ReadDataSource_Response)
-	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/ReadDataSource", in, out, opts...)
-	if err != nil {
+func (c *providerClient) MoveResourceState(ctx context.Context, in *MoveResourceState_Request, opts ...grpc.CallOption) (*MoveResourceState_Response, error) {
+	out := new(MoveResourceState_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/MoveResourceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ReadDataSource(ctx context.Context, in *ReadDataSource_Request, opts ...grpc.CallOption) (*ReadDataSource_Response, error) {
+	out := new(ReadDataSource_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/ReadDataSource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ValidateEphemeralResourceConfig(ctx context.Context, in *ValidateEphemeralResourceConfig_Request, opts ...grpc.CallOption) (*ValidateEphemeralResourceConfig_Response, error) {
+	out := new(ValidateEphemeralResourceConfig_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/ValidateEphemeralResourceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) OpenEphemeralResource(ctx context.Context, in *OpenEphemeralResource_Request, opts ...grpc.CallOption) (*OpenEphemeralResource_Response, error) {
+	out := new(OpenEphemeralResource_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/OpenEphemeralResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) RenewEphemeralResource(ctx context.Context, in *RenewEphemeralResource_Request, opts ...grpc.CallOption) (*RenewEphemeralResource_Response, error) {
+	out := new(RenewEphemeralResource_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/RenewEphemeralResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) CloseEphemeralResource(ctx context.Context, in *CloseEphemeralResource_Request, opts ...grpc.CallOption) (*CloseEphemeralResource_Response, error) {
+	out := new(CloseEphemeralResource_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/CloseEphemeralResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ListResource(ctx context.Context, in *ListResource_Request, opts ...grpc.CallOption) (Provider_ListResourceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Provider_serviceDesc.Streams[0], "/tfplugin5.Provider/ListResource", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerListResourceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Provider_ListResourceClient interface {
+	Recv() (*ListResource_Event, error)
+	grpc.ClientStream
+}
+
+type providerListResourceClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerListResourceClient) Recv() (*ListResource_Event, error) {
+	m := new(ListResource_Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *providerClient) ValidateListResourceConfig(ctx context.Context, in *ValidateListResourceConfig_Request, opts ...grpc.CallOption) (*ValidateListResourceConfig_Response, error) {
+	out := new(ValidateListResourceConfig_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/ValidateListResourceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) GetFunctions(ctx context.Context, in *GetFunctions_Request, opts ...grpc.CallOption) (*GetFunctions_Response, error) {
+	out := new(GetFunctions_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/GetFunctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) CallFunction(ctx context.Context, in *CallFunction_Request, opts ...grpc.CallOption) (*CallFunction_Response, error) {
+	out := new(CallFunction_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/CallFunction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) ValidateActionConfig(ctx context.Context, in *ValidateActionConfig_Request, opts ...grpc.CallOption) (*ValidateActionConfig_Response, error) {
+	out := new(ValidateActionConfig_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/ValidateActionConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) PlanAction(ctx context.Context, in *PlanAction_Request, opts ...grpc.CallOption) (*PlanAction_Response, error) {
+	out := new(PlanAction_Response)
+	err := c.cc.Invoke(ctx, "/tfplugin5.Provider/PlanAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) InvokeAction(ctx context.Context, in *InvokeAction_Request, opts ...grpc.CallOption) (Provider_InvokeActionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Provider_serviceDesc.Streams[1], "/tfplugin5.Provider/InvokeAction", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerInvokeActionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Provider_InvokeActionClient interface {
+	Recv() (*InvokeAction_Event, error)
+	grpc.ClientStream
+}
+
+type providerInvokeActionClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerInvokeActionClient) Recv() (*InvokeAction_Event, error) {
+	m := new(InvokeAction_Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return m, nil
 }
 
 func (c *providerClient) Stop(ctx context.Context, in *Stop_Request, opts ...grpc.CallOption) (*Stop_Response, error) {
@@ -3089,12 +7056,25 @@ func (c *providerClient) Stop(ctx context.Context, in *Stop_Request, opts ...grp
 
 // ProviderServer is the server API for Provider service.
 type ProviderServer interface {
-	//////// Information about what a provider supports/expects
+	// GetMetadata returns upfront information about server capabilities and
+	// supported resource types without requiring the server to instantiate all
+	// schema information, which may be memory intensive. This RPC is optional,
+	// where clients may receive an unimplemented RPC error. Clients should
+	// ignore the error and call the GetSchema RPC as a fallback.
+	GetMetadata(context.Context, *GetMetadata_Request) (*GetMetadata_Response, error)
+	// GetSchema returns schema information for the provider, data resources,
+	// and managed resources.
 	GetSchema(context.Context, *GetProviderSchema_Request) (*GetProviderSchema_Response, error)
+	// GetResourceIdentitySchemas returns the identity schemas for all managed
+	// resources.
+	GetResourceIdentitySchemas(context.Context, *GetResourceIdentitySchemas_Request) (*GetResourceIdentitySchemas_Response, error)
 	PrepareProviderConfig(context.Context, *PrepareProviderConfig_Request) (*PrepareProviderConfig_Response, error)
 	ValidateResourceTypeConfig(context.Context, *ValidateResourceTypeConfig_Request) (*ValidateResourceTypeConfig_Response, error)
 	ValidateDataSourceConfig(context.Context, *ValidateDataSourceConfig_Request) (*ValidateDataSourceConfig_Response, error)
 	UpgradeResourceState(context.Context, *UpgradeResourceState_Request) (*UpgradeResourceState_Response, error)
+	// UpgradeResourceIdentityData should return the upgraded resource identity
+	// data for a managed resource type.
+	UpgradeResourceIdentity(context.Context, *UpgradeResourceIdentity_Request) (*UpgradeResourceIdentity_Response, error)
 	//////// One-time initialization, called before other functions below
 	Configure(context.Context, *Configure_Request) (*Configure_Response, error)
 	//////// Managed Resource Lifecycle
@@ -3102,7 +7082,25 @@ type ProviderServer interface {
 	PlanResourceChange(context.Context, *PlanResourceChange_Request) (*PlanResourceChange_Response, error)
 	ApplyResourceChange(context.Context, *ApplyResourceChange_Request) (*ApplyResourceChange_Response, error)
 	ImportResourceState(context.Context, *ImportResourceState_Request) (*ImportResourceState_Response, error)
+	MoveResourceState(context.Context, *MoveResourceState_Request) (*MoveResourceState_Response, error)
 	ReadDataSource(context.Context, *ReadDataSource_Request) (*ReadDataSource_Response, error)
+	//////// Ephemeral Resource Lifecycle
+	ValidateEphemeralResourceConfig(context.Context, *ValidateEphemeralResourceConfig_Request) (*ValidateEphemeralResourceConfig_Response, error)
+	OpenEphemeralResource(context.Context, *OpenEphemeralResource_Request) (*OpenEphemeralResource_Response, error)
+	RenewEphemeralResource(context.Context, *RenewEphemeralResource_Request) (*RenewEphemeralResource_Response, error)
+	CloseEphemeralResource(context.Context, *CloseEphemeralResource_Request) (*CloseEphemeralResource_Response, error)
+	/////// List
+	ListResource(*ListResource_Request, Provider_ListResourceServer) error
+	ValidateListResourceConfig(context.Context, *ValidateListResourceConfig_Request) (*ValidateListResourceConfig_Response, error)
+	// GetFunctions returns the definitions of all functions.
+	GetFunctions(context.Context, *GetFunctions_Request) (*GetFunctions_Response, error)
+	// CallFunction runs the provider-defined function logic and returns
+	// the result with any diagnostics.
+	CallFunction(context.Context, *CallFunction_Request) (*CallFunction_Response, error)
+	//////// Actions Lifecycle
+	ValidateActionConfig(context.Context, *ValidateActionConfig_Request) (*ValidateActionConfig_Response, error)
+	PlanAction(context.Context, *PlanAction_Request) (*PlanAction_Response, error)
+	InvokeAction(*InvokeAction_Request, Provider_InvokeActionServer) error
 	//////// Graceful Shutdown
 	Stop(context.Context, *Stop_Request) (*Stop_Response, error)
 }
@@ -3111,9 +7109,15 @@ type ProviderServer interface {
 type UnimplementedProviderServer struct {
 }
 
+func (*UnimplementedProviderServer) GetMetadata(ctx context.Context, req *GetMetadata_Request) (*GetMetadata_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetadata not implemented")
+}
 func (*UnimplementedProviderServer) GetSchema(ctx context.Context, req *GetProviderSchema_Request) (*GetProviderSchema_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
 }
+func (*UnimplementedProviderServer) GetResourceIdentitySchemas(ctx context.Context, req *GetResourceIdentitySchemas_Request) (*GetResourceIdentitySchemas_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceIdentitySchemas not implemented")
+}
 func (*UnimplementedProviderServer) PrepareProviderConfig(ctx context.Context, req *PrepareProviderConfig_Request) (*PrepareProviderConfig_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrepareProviderConfig not implemented")
 }
@@ -3126,6 +7130,9 @@ func (*UnimplementedProviderServer) ValidateDataSourceConfig(ctx context.Context
 func (*UnimplementedProviderServer) UpgradeResourceState(ctx context.Context, req *UpgradeResourceState_Request) (*UpgradeResourceState_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradeResourceState not implemented")
 }
+func (*UnimplementedProviderServer) UpgradeResourceIdentity(ctx context.Context, req *UpgradeResourceIdentity_Request) (*UpgradeResourceIdentity_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeResourceIdentity not implemented")
+}
 func (*UnimplementedProviderServer) Configure(ctx context.Context, req *Configure_Request) (*Configure_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
 }
@@ -3141,9 +7148,45 @@ func (*UnimplementedProviderServer) ApplyResourceChange(ctx context.Context, req
 func (*UnimplementedProviderServer) ImportResourceState(ctx context.Context, req *ImportResourceState_Request) (*ImportResourceState_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportResourceState not implemented")
 }
+func (*UnimplementedProviderServer) MoveResourceState(ctx context.Context, req *MoveResourceState_Request) (*MoveResourceState_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveResourceState not implemented")
+}
 func (*UnimplementedProviderServer) ReadDataSource(ctx context.Context, req *ReadDataSource_Request) (*ReadDataSource_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadDataSource not implemented")
 }
+func (*UnimplementedProviderServer) ValidateEphemeralResourceConfig(ctx context.Context, req *ValidateEphemeralResourceConfig_Request) (*ValidateEphemeralResourceConfig_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateEphemeralResourceConfig not implemented")
+}
+func (*UnimplementedProviderServer) OpenEphemeralResource(ctx context.Context, req *OpenEphemeralResource_Request) (*OpenEphemeralResource_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenEphemeralResource not implemented")
+}
+func (*UnimplementedProviderServer) RenewEphemeralResource(ctx context.Context, req *RenewEphemeralResource_Request) (*RenewEphemeralResource_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewEphemeralResource not implemented")
+}
+func (*UnimplementedProviderServer) CloseEphemeralResource(ctx context.Context, req *CloseEphemeralResource_Request) (*CloseEphemeralResource_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseEphemeralResource not implemented")
+}
+func (*UnimplementedProviderServer) ListResource(req *ListResource_Request, srv Provider_ListResourceServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListResource not implemented")
+}
+func (*UnimplementedProviderServer) ValidateListResourceConfig(ctx context.Context, req *ValidateListResourceConfig_Request) (*ValidateListResourceConfig_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateListResourceConfig not implemented")
+}
+func (*UnimplementedProviderServer) GetFunctions(ctx context.Context, req *GetFunctions_Request) (*GetFunctions_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFunctions not implemented")
+}
+func (*UnimplementedProviderServer) CallFunction(ctx context.Context, req *CallFunction_Request) (*CallFunction_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CallFunction not implemented")
+}
+func (*UnimplementedProviderServer) ValidateActionConfig(ctx context.Context, req *ValidateActionConfig_Request) (*ValidateActionConfig_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateActionConfig not implemented")
+}
+func (*UnimplementedProviderServer) PlanAction(ctx context.Context, req *PlanAction_Request) (*PlanAction_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanAction not implemented")
+}
+func (*UnimplementedProviderServer) InvokeAction(req *InvokeAction_Request, srv Provider_InvokeActionServer) error {
+	return status.Errorf(codes.Unimplemented, "method InvokeAction not implemented")
+}
 func (*UnimplementedProviderServer) Stop(ctx context.Context, req *Stop_Request) (*Stop_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
@@ -3152,6 +7195,24 @@ func RegisterProviderServer(s *grpc.Server, srv ProviderServer) {
 	s.RegisterService(&_Provider_serviceDesc, srv)
 }
 
+func _Provider_GetMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetadata_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/GetMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetMetadata(ctx, req.(*GetMetadata_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProviderSchema_Request)
 	if err := dec(in); err != nil {
@@ -3170,6 +7231,24 @@ func _Provider_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_GetResourceIdentitySchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceIdentitySchemas_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetResourceIdentitySchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/GetResourceIdentitySchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetResourceIdentitySchemas(ctx, req.(*GetResourceIdentitySchemas_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_PrepareProviderConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PrepareProviderConfig_Request)
 	if err := dec(in); err != nil {
@@ -3242,6 +7321,24 @@ func _Provider_UpgradeResourceState_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_UpgradeResourceIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeResourceIdentity_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).UpgradeResourceIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/UpgradeResourceIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).UpgradeResourceIdentity(ctx, req.(*UpgradeResourceIdentity_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Configure_Request)
 	if err := dec(in); err != nil {
@@ -3332,6 +7429,24 @@ func _Provider_ImportResourceState_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_MoveResourceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveResourceState_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).MoveResourceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/MoveResourceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).MoveResourceState(ctx, req.(*MoveResourceState_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Provider_ReadDataSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadDataSource_Request)
 	if err := dec(in); err != nil {
@@ -3350,6 +7465,210 @@ func _Provider_ReadDataSource_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_ValidateEphemeralResourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateEphemeralResourceConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ValidateEphemeralResourceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/ValidateEphemeralResourceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ValidateEphemeralResourceConfig(ctx, req.(*ValidateEphemeralResourceConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_OpenEphemeralResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenEphemeralResource_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).OpenEphemeralResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/OpenEphemeralResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).OpenEphemeralResource(ctx, req.(*OpenEphemeralResource_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_RenewEphemeralResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewEphemeralResource_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).RenewEphemeralResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/RenewEphemeralResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).RenewEphemeralResource(ctx, req.(*RenewEphemeralResource_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_CloseEphemeralResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseEphemeralResource_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CloseEphemeralResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/CloseEphemeralResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CloseEphemeralResource(ctx, req.(*CloseEphemeralResource_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ListResource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListResource_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).ListResource(m, &providerListResourceServer{stream})
+}
+
+type Provider_ListResourceServer interface {
+	Send(*ListResource_Event) error
+	grpc.ServerStream
+}
+
+type providerListResourceServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerListResourceServer) Send(m *ListResource_Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Provider_ValidateListResourceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateListResourceConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ValidateListResourceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/ValidateListResourceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ValidateListResourceConfig(ctx, req.(*ValidateListResourceConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_GetFunctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFunctions_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetFunctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/GetFunctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetFunctions(ctx, req.(*GetFunctions_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_CallFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallFunction_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CallFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/CallFunction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CallFunction(ctx, req.(*CallFunction_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_ValidateActionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateActionConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).ValidateActionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/ValidateActionConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).ValidateActionConfig(ctx, req.(*ValidateActionConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_PlanAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanAction_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).PlanAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tfplugin5.Provider/PlanAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).PlanAction(ctx, req.(*PlanAction_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_InvokeAction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InvokeAction_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).InvokeAction(m, &providerInvokeActionServer{stream})
+}
+
+type Provider_InvokeActionServer interface {
+	Send(*InvokeAction_Event) error
+	grpc.ServerStream
+}
+
+type providerInvokeActionServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerInvokeActionServer) Send(m *InvokeAction_Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Provider_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Stop_Request)
 	if err := dec(in); err != nil {
@@ -3372,10 +7691,18 @@ var _Provider_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tfplugin5.Provider",
 	HandlerType: (*ProviderServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMetadata",
+			Handler:    _Provider_GetMetadata_Handler,
+		},
 		{
 			MethodName: "GetSchema",
 			Handler:    _Provider_GetSchema_Handler,
 		},
+		{
+			MethodName: "GetResourceIdentitySchemas",
+			Handler:    _Provider_GetResourceIdentitySchemas_Handler,
+		},
 		{
 			MethodName: "PrepareProviderConfig",
 			Handler:    _Provider_PrepareProviderConfig_Handler,
@@ -3392,6 +7719,10 @@ var _Provider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpgradeResourceState",
 			Handler:    _Provider_UpgradeResourceState_Handler,
 		},
+		{
+			MethodName: "UpgradeResourceIdentity",
+			Handler:    _Provider_UpgradeResourceIdentity_Handler,
+		},
 		{
 			MethodName: "Configure",
 			Handler:    _Provider_Configure_Handler,
@@ -3412,16 +7743,67 @@ var _Provider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportResourceState",
 			Handler:    _Provider_ImportResourceState_Handler,
 		},
+		{
+			MethodName: "MoveResourceState",
+			Handler:    _Provider_MoveResourceState_Handler,
+		},
 		{
 			MethodName: "ReadDataSource",
 			Handler:    _Provider_ReadDataSource_Handler,
 		},
+		{
+			MethodName: "ValidateEphemeralResourceConfig",
+			Handler:    _Provider_ValidateEphemeralResourceConfig_Handler,
+		},
+		{
+			MethodName: "OpenEphemeralResource",
+			Handler:    _Provider_OpenEphemeralResource_Handler,
+		},
+		{
+			MethodName: "RenewEphemeralResource",
+			Handler:    _Provider_RenewEphemeralResource_Handler,
+		},
+		{
+			MethodName: "CloseEphemeralResource",
+			Handler:    _Provider_CloseEphemeralResource_Handler,
+		},
+		{
+			MethodName: "ValidateListResourceConfig",
+			Handler:    _Provider_ValidateListResourceConfig_Handler,
+		},
+		{
+			MethodName: "GetFunctions",
+			Handler:    _Provider_GetFunctions_Handler,
+		},
+		{
+			MethodName: "CallFunction",
+			Handler:    _Provider_CallFunction_Handler,
+		},
+		{
+			MethodName: "ValidateActionConfig",
+			Handler:    _Provider_ValidateActionConfig_Handler,
+		},
+		{
+			MethodName: "PlanAction",
+			Handler:    _Provider_PlanAction_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _Provider_Stop_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListResource",
+			Handler:       _Provider_ListResource_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InvokeAction",
+			Handler:       _Provider_InvokeAction_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "tfplugin5.proto",
 }
 
@@ -0,0 +1,298 @@
+// Package audit records an append-only log of the operations on providers
+// that can change remote objects, using a hook from package tfprovider.
+//
+// The log is in JSON Lines format, with one record per operation. Each record
+// includes the hash of the record before it, so that any later modification,
+// removal or reordering of records can be detected using Verify.
+//
+// The chain alone can't detect records removed from the end of the log,
+// because what remains is still an unbroken chain. Nor can it detect a log
+// that was rewritten entirely, because anyone can compute the SHA-256 hashes
+// of new records. To detect those too, keep checkpoints of Logger.LastHash
+// somewhere that whoever can write the log can't modify, and check that the
+// hash returned by Verify matches the latest checkpoint; and use
+// NewKeyedLogger and VerifyKeyed with a secret key, so that a rewritten log
+// can't have valid hashes without the key.
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// Record is a single entry in the audit log.
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+
+	// Actor is the caller-supplied metadata describing who or what
+	// requested the operation, from ContextWithActor.
+	Actor map[string]string `json:"actor,omitempty"`
+
+	// Provider is the provider name given when creating the hook.
+	Provider  string `json:"provider"`
+	Operation string `json:"operation"`
+	TypeName  string `json:"type_name,omitempty"`
+
+	// ImportID is the ID given in an import request.
+	ImportID string `json:"import_id,omitempty"`
+
+	// Before and After are JSON representations of the object before and
+	// after the operation, with the values of all sensitive attributes
+	// replaced by a placeholder string.
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+
+	// Error is the error message returned by the operation, for operations
+	// that report errors using Go errors.
+	Error string `json:"error,omitempty"`
+
+	// PrevHash is the hash of the previous record in the log, or the empty
+	// string for the first record.
+	PrevHash string `json:"prev_hash"`
+}
+
+// Diagnostic is the representation of a diagnostic in an audit record.
+type Diagnostic struct {
+	Severity  string `json:"severity"`
+	Summary   string `json:"summary"`
+	Detail    string `json:"detail,omitempty"`
+	Attribute string `json:"attribute,omitempty"`
+}
+
+// SensitivePlaceholder is the string that replaces the values of sensitive
+// attributes in audit records.
+const SensitivePlaceholder = "(sensitive value)"
+
+// auditedOperations are the operations that the audit log records, which
+// are those that can cause changes outside of the provider process.
+var auditedOperations = map[tfprovider.OperationName]bool{
+	tfprovider.OpConfigure:                true,
+	tfprovider.OpApplyManagedResource:     true,
+	tfprovider.OpImportManagedResource:    true,
+	tfprovider.OpMoveManagedResourceState: true,
+	tfprovider.OpOpenEphemeralResource:    true,
+	tfprovider.OpInvokeAction:             true,
+	tfprovider.OpStop:                     true,
+}
+
+type actorKey struct{}
+
+// ContextWithActor returns a context derived from the given context that
+// carries metadata about the actor responsible for any operations called
+// with it, such as a user name or a job identifier. The metadata is included
+// in the audit record for each operation.
+func ContextWithActor(ctx context.Context, actor map[string]string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Logger writes audit records to an underlying writer.
+//
+// A Logger is safe for concurrent use, and can be shared between the hooks
+// for several providers so that all of their operations are recorded in a
+// single chain.
+type Logger struct {
+	mu       sync.Mutex
+	w        io.Writer
+	key      []byte
+	prevHash string
+}
+
+// NewLogger returns a logger that writes records to the given writer.
+//
+// prevHash is the hash of the last record already in the log, which is
+// returned by Verify, or the empty string if the log is empty.
+func NewLogger(w io.Writer, prevHash string) *Logger {
+	return &Logger{
+		w:        w,
+		prevHash: prevHash,
+	}
+}
+
+// NewKeyedLogger is like NewLogger, but the hash of each record is an
+// HMAC-SHA256 using the given secret key, so that only holders of the key
+// can write records that VerifyKeyed accepts.
+func NewKeyedLogger(w io.Writer, prevHash string, key []byte) *Logger {
+	return &Logger{
+		w:        w,
+		key:      key,
+		prevHash: prevHash,
+	}
+}
+
+// LastHash returns the hash of the last record in the log, or the empty
+// string if the log is empty. It is suitable for use as a checkpoint for
+// detecting removal of records from the end of the log.
+func (l *Logger) LastHash() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.prevHash
+}
+
+// Write appends the given record to the log, populating its PrevHash field.
+func (l *Logger) Write(rec *Record) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rec.PrevHash = l.prevHash
+	raw, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit record: %s", err)
+	}
+	hash := recordHash(l.key, raw)
+
+	// The hash is appended as the final property of the record object, so
+	// that Verify can recover the exact bytes that were hashed.
+	var buf bytes.Buffer
+	buf.Write(raw[:len(raw)-1])
+	fmt.Fprintf(&buf, `,"hash":%q}`, hash)
+	buf.WriteByte('\n')
+	if _, err := l.w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write audit record: %s", err)
+	}
+	l.prevHash = hash
+	return nil
+}
+
+// recordHash returns the hash of the given encoded record, which is an
+// HMAC-SHA256 if key is not nil and a plain SHA-256 otherwise.
+func recordHash(key []byte, raw []byte) string {
+	if key != nil {
+		mac := hmac.New(sha256.New, key)
+		mac.Write(raw)
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// Hook returns a hook that writes an audit record for each operation that
+// can change remote objects, which are Configure, Apply, Import, MoveState,
+// opening an ephemeral resource, invoking an action, and Stop. The records
+// are attributed to the given provider name, which is usually the
+// provider's source address.
+//
+// For Configure, opening an ephemeral resource and invoking an action, the
+// record's After is the configuration. The result of opening an ephemeral
+// resource is never recorded, because it usually consists of short-lived
+// credentials. For MoveState, the record's Before describes only the
+// source type, because the source object is in a form that only the
+// provider can decode.
+//
+// If a record cannot be written, the hook adds an error diagnostic to the
+// operation's result. The operation itself has already completed by then.
+func (l *Logger) Hook(provider string) tfprovider.Hook {
+	return func(ctx context.Context, op *tfprovider.Operation, next func(ctx context.Context)) {
+		next(ctx)
+		if !auditedOperations[op.Name] {
+			return
+		}
+
+		rec := &Record{
+			Timestamp: op.StartTime.UTC(),
+			Provider:  provider,
+			Operation: string(op.Name),
+			TypeName:  op.TypeName,
+		}
+		if actor, ok := ctx.Value(actorKey{}).(map[string]string); ok {
+			rec.Actor = actor
+		}
+		if op.Err != nil {
+			rec.Error = op.Err.Error()
+		}
+		for _, diag := range op.Diagnostics {
+			rec.Diagnostics = append(rec.Diagnostics, encodeDiagnostic(diag))
+		}
+
+		switch op.Name {
+		case tfprovider.OpConfigure:
+			if req, ok := op.Request.(tfprovider.Config); ok {
+				rec.After = redactedValue(req.Value, op.Schema)
+			}
+		case tfprovider.OpApplyManagedResource:
+			if req, ok := op.Request.(tfprovider.ManagedResourceApplyRequest); ok {
+				rec.Before = redactedValue(req.PriorValue, op.Schema)
+			}
+			if resp, ok := op.Response.(tfprovider.ManagedResourceApplyResponse); ok {
+				rec.After = redactedValue(resp.NewValue, op.Schema)
+			}
+		case tfprovider.OpMoveManagedResourceState:
+			if req, ok := op.Request.(tfprovider.ManagedResourceMoveStateRequest); ok {
+				rec.Before = map[string]interface{}{
+					"provider":  req.SourceProviderAddr,
+					"type_name": req.SourceTypeName,
+				}
+			}
+			if resp, ok := op.Response.(tfprovider.ManagedResourceMoveStateResponse); ok {
+				rec.After = redactedValue(resp.NewValue, op.Schema)
+			}
+		case tfprovider.OpOpenEphemeralResource:
+			if req, ok := op.Request.(tfprovider.EphemeralResourceOpenRequest); ok {
+				rec.After = redactedValue(req.Config, op.Schema)
+			}
+		case tfprovider.OpInvokeAction:
+			if req, ok := op.Request.(tfprovider.ActionInvokeRequest); ok {
+				rec.After = redactedValue(req.Config, op.Schema)
+			}
+		case tfprovider.OpImportManagedResource:
+			if req, ok := op.Request.(tfprovider.ManagedResourceImportRequest); ok {
+				rec.ImportID = req.ID
+			}
+			if resp, ok := op.Response.(tfprovider.ManagedResourceImportResponse); ok {
+				imported := make([]interface{}, 0, len(resp.Imported))
+				for _, obj := range resp.Imported {
+					// We only have the schema for the requested type, so
+					// objects of any other type are redacted entirely.
+					var schema *tfschema.Block
+					if obj.TypeName == op.TypeName {
+						schema = op.Schema
+					}
+					imported = append(imported, map[string]interface{}{
+						"type_name": obj.TypeName,
+						"value":     redactedValue(obj.Value, schema),
+					})
+				}
+				rec.After = imported
+			}
+		}
+
+		if err := l.Write(rec); err != nil {
+			op.Diagnostics = append(op.Diagnostics, tfprovider.Diagnostic{
+				Severity: tfprovider.Error,
+				Summary:  "Failed to write audit record",
+				Detail:   fmt.Sprintf("The %s operation completed, but it could not be recorded in the audit log: %s.", op.Name, err),
+			})
+		}
+	}
+}
+
+func encodeDiagnostic(diag tfprovider.Diagnostic) Diagnostic {
+	ret := Diagnostic{
+		Summary: diag.Summary,
+		Detail:  diag.Detail,
+	}
+	switch diag.Severity {
+	case tfprovider.Error:
+		ret.Severity = "error"
+	case tfprovider.Warning:
+		ret.Severity = "warning"
+	}
+	if len(diag.Attribute) != 0 {
+		ret.Attribute = common.FormatCtyPath(diag.Attribute)
+	}
+	return ret
+}
@@ -0,0 +1,174 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+var testSchema = &tfschema.Block{
+	Attributes: map[string]*tfschema.Attribute{
+		"name":     {Type: cty.String, Required: true},
+		"password": {Type: cty.String, Optional: true, Sensitive: true},
+	},
+}
+
+// runOperation runs the given operation through the given hook, with a
+// next function that sets the given response.
+func runOperation(ctx context.Context, hook tfprovider.Hook, op *tfprovider.Operation, resp interface{}) {
+	hook(ctx, op, func(ctx context.Context) {
+		op.StartTime = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		op.Response = resp
+	})
+}
+
+// readRecords decodes each of the records in the given log.
+func readRecords(t *testing.T, log string) []map[string]interface{} {
+	t.Helper()
+	var ret []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(log), "\n") {
+		if line == "" {
+			continue
+		}
+		var rec map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("invalid record %q: %s", line, err)
+		}
+		ret = append(ret, rec)
+	}
+	return ret
+}
+
+func TestHookAuditedOperations(t *testing.T) {
+	var buf bytes.Buffer
+	hook := NewLogger(&buf, "").Hook("example.com/test/test")
+	ctx := ContextWithActor(context.Background(), map[string]string{"user": "alice"})
+	obj := cty.ObjectVal(map[string]cty.Value{
+		"name":     cty.StringVal("a"),
+		"password": cty.StringVal("hunter2"),
+	})
+
+	runOperation(ctx, hook, &tfprovider.Operation{
+		Name:     tfprovider.OpReadManagedResource,
+		TypeName: "test_thing",
+		Schema:   testSchema,
+		Request:  tfprovider.ManagedResourceReadRequest{},
+	}, tfprovider.ManagedResourceReadResponse{})
+	runOperation(ctx, hook, &tfprovider.Operation{
+		Name:     tfprovider.OpApplyManagedResource,
+		TypeName: "test_thing",
+		Schema:   testSchema,
+		Request: tfprovider.ManagedResourceApplyRequest{
+			PriorValue: cty.NullVal(obj.Type()),
+		},
+	}, tfprovider.ManagedResourceApplyResponse{NewValue: obj})
+	runOperation(ctx, hook, &tfprovider.Operation{
+		Name:     tfprovider.OpImportManagedResource,
+		TypeName: "test_thing",
+		Schema:   testSchema,
+		Request:  tfprovider.ManagedResourceImportRequest{ID: "a"},
+	}, tfprovider.ManagedResourceImportResponse{
+		Imported: []tfprovider.ImportedManagedResource{
+			{TypeName: "test_thing", Value: obj},
+			{TypeName: "test_other", Value: obj},
+		},
+	})
+	runOperation(ctx, hook, &tfprovider.Operation{
+		Name:     tfprovider.OpMoveManagedResourceState,
+		TypeName: "test_thing",
+		Schema:   testSchema,
+		Request: tfprovider.ManagedResourceMoveStateRequest{
+			SourceProviderAddr: "example.com/test/old",
+			SourceTypeName:     "old_thing",
+			SourceState:        []byte(`{"password":"hunter2"}`),
+		},
+	}, tfprovider.ManagedResourceMoveStateResponse{NewValue: obj})
+	runOperation(ctx, hook, &tfprovider.Operation{
+		Name:     tfprovider.OpOpenEphemeralResource,
+		TypeName: "test_secret",
+		Schema:   testSchema,
+		Request:  tfprovider.EphemeralResourceOpenRequest{Config: obj},
+	}, tfprovider.EphemeralResourceOpenResponse{Result: obj})
+	runOperation(ctx, hook, &tfprovider.Operation{
+		Name:     tfprovider.OpInvokeAction,
+		TypeName: "test_action",
+		Schema:   testSchema,
+		Request:  tfprovider.ActionInvokeRequest{Config: obj},
+	}, nil)
+
+	log := buf.String()
+	if strings.Contains(log, "hunter2") {
+		t.Errorf("sensitive value recorded in audit log:\n%s", log)
+	}
+	recs := readRecords(t, log)
+	var ops []string
+	for _, rec := range recs {
+		ops = append(ops, rec["operation"].(string))
+	}
+	wantOps := []string{"ApplyManagedResource", "ImportManagedResource", "MoveManagedResourceState", "OpenEphemeralResource", "InvokeAction"}
+	if strings.Join(ops, ",") != strings.Join(wantOps, ",") {
+		t.Fatalf("wrong operations recorded %q; want %q", ops, wantOps)
+	}
+
+	apply := recs[0]
+	if apply["before"] != nil {
+		t.Errorf("apply has before value %#v for a new object", apply["before"])
+	}
+	after := apply["after"].(map[string]interface{})
+	if after["name"] != "a" || after["password"] != SensitivePlaceholder {
+		t.Errorf("wrong after value %#v", after)
+	}
+	if actor := apply["actor"].(map[string]interface{}); actor["user"] != "alice" {
+		t.Errorf("wrong actor %#v", actor)
+	}
+	if got, want := apply["timestamp"], "2020-01-02T03:04:05Z"; got != want {
+		t.Errorf("wrong timestamp %v; want %v", got, want)
+	}
+
+	imp := recs[1]
+	if got, want := imp["import_id"], "a"; got != want {
+		t.Errorf("wrong import ID %v; want %v", got, want)
+	}
+	imported := imp["after"].([]interface{})
+	if len(imported) != 2 {
+		t.Fatalf("wrong number of imported objects %d; want 2", len(imported))
+	}
+	if other := imported[1].(map[string]interface{}); other["value"] != SensitivePlaceholder {
+		t.Errorf("object of another type not redacted: %#v", other)
+	}
+
+	move := recs[2]
+	before := move["before"].(map[string]interface{})
+	if before["type_name"] != "old_thing" || before["provider"] != "example.com/test/old" {
+		t.Errorf("wrong before value for move %#v", before)
+	}
+}
+
+func TestHookWriteError(t *testing.T) {
+	hook := NewLogger(failingWriter{}, "").Hook("example.com/test/test")
+	op := &tfprovider.Operation{
+		Name: tfprovider.OpStop,
+	}
+	runOperation(context.Background(), hook, op, nil)
+	if !op.Diagnostics.HasErrors() {
+		t.Fatal("no error for failed write")
+	}
+	if got, want := op.Diagnostics[0].Summary, "Failed to write audit record"; got != want {
+		t.Errorf("wrong summary %q; want %q", got, want)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
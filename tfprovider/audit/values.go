@@ -0,0 +1,135 @@
+package audit
+
+import (
+	"encoding/json"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// unknownPlaceholder is the string that represents unknown values in audit
+// records, which can appear in planned values.
+const unknownPlaceholder = "(unknown)"
+
+// redactedValue returns a JSON-friendly representation of the given object
+// value, with the values of any attributes marked as sensitive in the given
+// schema replaced by SensitivePlaceholder.
+//
+// If schema is nil then the entire value is treated as sensitive, since we
+// can't tell which parts of it are safe to record.
+func redactedValue(val cty.Value, schema *tfschema.Block) interface{} {
+	if val.IsNull() {
+		return nil
+	}
+	if schema == nil {
+		return SensitivePlaceholder
+	}
+	return redactedObject(val, schema)
+}
+
+func redactedObject(val cty.Value, schema *tfschema.Block) interface{} {
+	if val.IsNull() {
+		return nil
+	}
+	if !val.IsKnown() {
+		return unknownPlaceholder
+	}
+	if !val.Type().IsObjectType() {
+		// Should never happen for a value conforming to the schema, but
+		// we'll be conservative if it does.
+		return SensitivePlaceholder
+	}
+
+	ret := make(map[string]interface{})
+	for name, attrS := range schema.Attributes {
+		if !val.Type().HasAttribute(name) {
+			continue
+		}
+		av := val.GetAttr(name)
+		if attrS.Sensitive && !av.IsNull() {
+			ret[name] = SensitivePlaceholder
+			continue
+		}
+		ret[name] = jsonValue(av)
+	}
+	for name, blockS := range schema.BlockTypes {
+		if !val.Type().HasAttribute(name) {
+			continue
+		}
+		bv := val.GetAttr(name)
+		if bv.IsNull() {
+			ret[name] = nil
+			continue
+		}
+		if !bv.IsKnown() {
+			ret[name] = unknownPlaceholder
+			continue
+		}
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			ret[name] = redactedObject(bv, &blockS.Block)
+		case tfschema.NestingList, tfschema.NestingSet:
+			elems := make([]interface{}, 0, bv.LengthInt())
+			for it := bv.ElementIterator(); it.Next(); {
+				_, ev := it.Element()
+				elems = append(elems, redactedObject(ev, &blockS.Block))
+			}
+			ret[name] = elems
+		case tfschema.NestingMap:
+			elems := make(map[string]interface{}, bv.LengthInt())
+			for it := bv.ElementIterator(); it.Next(); {
+				kv, ev := it.Element()
+				elems[kv.AsString()] = redactedObject(ev, &blockS.Block)
+			}
+			ret[name] = elems
+		default:
+			ret[name] = SensitivePlaceholder
+		}
+	}
+	return ret
+}
+
+// jsonValue returns a representation of the given value that encoding/json
+// can marshal.
+func jsonValue(val cty.Value) interface{} {
+	if val.IsNull() {
+		return nil
+	}
+	if !val.IsKnown() {
+		return unknownPlaceholder
+	}
+
+	ty := val.Type()
+	switch {
+	case ty == cty.String:
+		return val.AsString()
+	case ty == cty.Number:
+		return json.Number(val.AsBigFloat().Text('f', -1))
+	case ty == cty.Bool:
+		return val.True()
+	case ty.IsListType() || ty.IsSetType() || ty.IsTupleType():
+		ret := make([]interface{}, 0, val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			_, ev := it.Element()
+			ret = append(ret, jsonValue(ev))
+		}
+		return ret
+	case ty.IsMapType():
+		ret := make(map[string]interface{}, val.LengthInt())
+		for it := val.ElementIterator(); it.Next(); {
+			kv, ev := it.Element()
+			ret[kv.AsString()] = jsonValue(ev)
+		}
+		return ret
+	case ty.IsObjectType():
+		ret := make(map[string]interface{})
+		for name := range ty.AttributeTypes() {
+			ret[name] = jsonValue(val.GetAttr(name))
+		}
+		return ret
+	default:
+		// Dynamic values are always either null or unknown, which we've
+		// already handled above, so this should never happen.
+		return unknownPlaceholder
+	}
+}
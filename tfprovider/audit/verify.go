@@ -0,0 +1,72 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// hashSuffixLen is the length of the `,"hash":"..."}` suffix that Logger.Write
+// appends to each record: the property name and punctuation plus 64 hex
+// digits of SHA-256 hash.
+const hashSuffixLen = len(`,"hash":""}`) + 64
+
+// Verify reads an audit log from the given reader and checks that each record
+// has not been modified and that the records form an unbroken chain, starting
+// from a record whose PrevHash is empty.
+//
+// If the log is intact, Verify returns the hash of its last record, which
+// can be passed to NewLogger to continue the log. Otherwise it returns an
+// error describing the first problem found.
+//
+// Verify can't detect records removed from the end of the log, or a log that
+// was rewritten entirely. Compare the returned hash to a checkpoint kept
+// elsewhere to detect the former, and use VerifyKeyed to detect the latter.
+func Verify(r io.Reader) (string, error) {
+	return verify(r, nil)
+}
+
+// VerifyKeyed is like Verify, but for a log written by a logger from
+// NewKeyedLogger with the given key.
+func VerifyKeyed(r io.Reader, key []byte) (string, error) {
+	return verify(r, key)
+}
+
+func verify(r io.Reader, key []byte) (string, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 64*1024*1024)
+
+	prevHash := ""
+	lineNum := 0
+	for sc.Scan() {
+		lineNum++
+		line := sc.Bytes()
+		if len(line) < hashSuffixLen+1 || !bytes.HasPrefix(line[len(line)-hashSuffixLen:], []byte(`,"hash":"`)) {
+			return "", fmt.Errorf("line %d: record has no hash", lineNum)
+		}
+		suffix := line[len(line)-hashSuffixLen:]
+		wantHash := string(suffix[len(`,"hash":"`) : len(suffix)-2])
+
+		raw := make([]byte, 0, len(line)-hashSuffixLen+1)
+		raw = append(raw, line[:len(line)-hashSuffixLen]...)
+		raw = append(raw, '}')
+		if gotHash := recordHash(key, raw); gotHash != wantHash {
+			return "", fmt.Errorf("line %d: record does not match its hash", lineNum)
+		}
+
+		var rec Record
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return "", fmt.Errorf("line %d: invalid record: %s", lineNum, err)
+		}
+		if rec.PrevHash != prevHash {
+			return "", fmt.Errorf("line %d: record does not follow the previous record", lineNum)
+		}
+		prevHash = wantHash
+	}
+	if err := sc.Err(); err != nil {
+		return "", fmt.Errorf("failed to read audit log: %s", err)
+	}
+	return prevHash, nil
+}
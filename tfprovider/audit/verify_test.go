@@ -0,0 +1,157 @@
+package audit
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testLog returns a log of the given number of records written by the
+// given logger constructor.
+func testLog(t *testing.T, n int, newLogger func(*bytes.Buffer) *Logger) (string, *Logger) {
+	t.Helper()
+	var buf bytes.Buffer
+	l := newLogger(&buf)
+	for i := 0; i < n; i++ {
+		err := l.Write(&Record{
+			Timestamp: time.Date(2020, 1, 2, 3, 4, i, 0, time.UTC),
+			Provider:  "example.com/test/test",
+			Operation: "ApplyManagedResource",
+			TypeName:  "test_thing",
+		})
+		if err != nil {
+			t.Fatalf("failed to write record %d: %s", i, err)
+		}
+	}
+	return buf.String(), l
+}
+
+func unkeyedLogger(buf *bytes.Buffer) *Logger {
+	return NewLogger(buf, "")
+}
+
+func TestVerify(t *testing.T) {
+	log, l := testLog(t, 3, unkeyedLogger)
+	lines := strings.SplitAfter(log, "\n")[:3]
+
+	t.Run("intact", func(t *testing.T) {
+		hash, err := Verify(strings.NewReader(log))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if hash != l.LastHash() {
+			t.Errorf("wrong hash %q; want %q", hash, l.LastHash())
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		hash, err := Verify(strings.NewReader(""))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if hash != "" {
+			t.Errorf("wrong hash %q for empty log", hash)
+		}
+	})
+
+	tests := map[string]struct {
+		log     string
+		wantErr string
+	}{
+		"modified record": {
+			strings.Replace(log, "test_thing", "test_other", 1),
+			"line 1: record does not match its hash",
+		},
+		"removed record": {
+			lines[0] + lines[2],
+			"line 2: record does not follow the previous record",
+		},
+		"reordered records": {
+			lines[1] + lines[0] + lines[2],
+			"line 1: record does not follow the previous record",
+		},
+		"removed first record": {
+			lines[1] + lines[2],
+			"line 1: record does not follow the previous record",
+		},
+		"missing hash": {
+			lines[0] + "{\"operation\":\"Stop\"}\n",
+			"line 2: record has no hash",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Verify(strings.NewReader(test.log))
+			if err == nil {
+				t.Fatal("unexpected success")
+			}
+			if err.Error() != test.wantErr {
+				t.Errorf("wrong error\ngot:  %s\nwant: %s", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyTruncated(t *testing.T) {
+	log, l := testLog(t, 3, unkeyedLogger)
+	lines := strings.SplitAfter(log, "\n")[:3]
+
+	// Removing records from the end leaves an unbroken chain, so it's
+	// detectable only by comparing with a checkpoint.
+	hash, err := Verify(strings.NewReader(lines[0] + lines[1]))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hash == l.LastHash() {
+		t.Error("truncated log has the same final hash as the full log")
+	}
+}
+
+func TestVerifyContinued(t *testing.T) {
+	log, l := testLog(t, 2, unkeyedLogger)
+
+	var buf bytes.Buffer
+	buf.WriteString(log)
+	l2 := NewLogger(&buf, l.LastHash())
+	if err := l2.Write(&Record{Operation: "Stop"}); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err := Verify(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hash != l2.LastHash() {
+		t.Errorf("wrong hash %q; want %q", hash, l2.LastHash())
+	}
+}
+
+func TestVerifyKeyed(t *testing.T) {
+	key := []byte("correct horse battery staple")
+	log, l := testLog(t, 3, func(buf *bytes.Buffer) *Logger {
+		return NewKeyedLogger(buf, "", key)
+	})
+
+	hash, err := VerifyKeyed(strings.NewReader(log), key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if hash != l.LastHash() {
+		t.Errorf("wrong hash %q; want %q", hash, l.LastHash())
+	}
+
+	if _, err := VerifyKeyed(strings.NewReader(log), []byte("wrong")); err == nil {
+		t.Error("log verified with the wrong key")
+	}
+
+	// A log rewritten without the key has a valid unkeyed chain, but
+	// doesn't verify with the key.
+	forged, _ := testLog(t, 3, unkeyedLogger)
+	if _, err := Verify(strings.NewReader(forged)); err != nil {
+		t.Fatalf("unexpected error verifying forged log without key: %s", err)
+	}
+	if _, err := VerifyKeyed(strings.NewReader(forged), key); err == nil {
+		t.Error("forged log verified with the key")
+	}
+}
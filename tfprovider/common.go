@@ -11,9 +11,19 @@ import (
 
 type Schema = common.Schema
 
-type ManagedResourceTypeSchema = common.Schema
+type ManagedResourceTypeSchema = common.ManagedResourceTypeSchema
 
-type DataResourceTypeSchema = common.Schema
+type DataResourceTypeSchema = common.DataResourceTypeSchema
+
+type EphemeralResourceTypeSchema = common.EphemeralResourceTypeSchema
+
+type ListResourceTypeSchema = common.ListResourceTypeSchema
+
+type ActionTypeSchema = common.ActionTypeSchema
+
+// WriteOnlyAttributes describes which attributes of a managed resource type
+// are write-only, as found in ManagedResourceTypeSchema.
+type WriteOnlyAttributes = common.WriteOnlyAttributes
 
 type Diagnostics = common.Diagnostics
 
@@ -21,6 +31,24 @@ type Diagnostic = common.Diagnostic
 
 type DiagnosticSeverity = common.DiagnosticSeverity
 
+// DiagnosticsError is the error returned from Diagnostics.Err.
+type DiagnosticsError = common.DiagnosticsError
+
+// RPCError is the cause of a diagnostic describing a failed call to a
+// provider plugin, preserving the gRPC status of the failure.
+type RPCError = common.RPCError
+
+// The following are sentinel errors for use with errors.Is, to test for
+// particular kinds of failure in the error returned from Diagnostics.Err.
+var (
+	ErrProviderCrashed = common.ErrProviderCrashed
+	ErrUnimplemented   = common.ErrUnimplemented
+	ErrNotConfigured   = common.ErrNotConfigured
+	ErrUnknownType     = common.ErrUnknownType
+	ErrUnknownFunction = common.ErrUnknownFunction
+	ErrInvalidResponse = common.ErrInvalidResponse
+)
+
 const (
 	Error   DiagnosticSeverity = common.Error
 	Warning DiagnosticSeverity = common.Warning
@@ -34,6 +62,136 @@ type ManagedResourceType = common.ManagedResourceType
 
 type DataResourceType = common.DataResourceType
 
+type EphemeralResourceType = common.EphemeralResourceType
+
+type ListResourceType = common.ListResourceType
+
+// ListResourceResults is a stream of results from ListResourceType.List.
+type ListResourceResults = common.ListResourceResults
+
+type ActionType = common.ActionType
+
+// ActionInvocation is an action in progress, as returned from
+// ActionType.Invoke.
+type ActionInvocation = common.ActionInvocation
+
 type ManagedResourceReadRequest = common.ManagedResourceReadRequest
 
 type ManagedResourceReadResponse = common.ManagedResourceReadResponse
+
+type ManagedResourcePlanRequest = common.ManagedResourcePlanRequest
+
+type ManagedResourcePlanResponse = common.ManagedResourcePlanResponse
+
+type ManagedResourceApplyRequest = common.ManagedResourceApplyRequest
+
+type ManagedResourceApplyResponse = common.ManagedResourceApplyResponse
+
+type ManagedResourceImportRequest = common.ManagedResourceImportRequest
+
+type ManagedResourceImportResponse = common.ManagedResourceImportResponse
+
+type ImportedManagedResource = common.ImportedManagedResource
+
+type ManagedResourceMoveStateRequest = common.ManagedResourceMoveStateRequest
+
+type ManagedResourceMoveStateResponse = common.ManagedResourceMoveStateResponse
+
+type ManagedResourceUpgradeIdentityRequest = common.ManagedResourceUpgradeIdentityRequest
+
+type ListResourceValidateRequest = common.ListResourceValidateRequest
+
+type ListResourceRequest = common.ListResourceRequest
+
+type ListResourceResult = common.ListResourceResult
+
+type ActionPlanRequest = common.ActionPlanRequest
+
+type ActionPlanResponse = common.ActionPlanResponse
+
+type ActionInvokeRequest = common.ActionInvokeRequest
+
+type ManagedResourceUpgradeIdentityResponse = common.ManagedResourceUpgradeIdentityResponse
+
+type DataResourceReadRequest = common.DataResourceReadRequest
+
+type DataResourceReadResponse = common.DataResourceReadResponse
+
+type EphemeralResourceOpenRequest = common.EphemeralResourceOpenRequest
+
+type EphemeralResourceOpenResponse = common.EphemeralResourceOpenResponse
+
+type EphemeralResourceRenewRequest = common.EphemeralResourceRenewRequest
+
+type EphemeralResourceRenewResponse = common.EphemeralResourceRenewResponse
+
+type EphemeralResourceCloseRequest = common.EphemeralResourceCloseRequest
+
+// FunctionSchema describes a provider-defined function, as found in
+// Schema.Functions.
+type FunctionSchema = common.FunctionSchema
+
+// ResourceIdentitySchema describes the identities of a managed resource
+// type, as found in ManagedResourceTypeSchema.Identity.
+type ResourceIdentitySchema = common.ResourceIdentitySchema
+
+type IdentityAttribute = common.IdentityAttribute
+
+type FunctionParameter = common.FunctionParameter
+
+// FunctionError is the cause of a diagnostic describing an error returned
+// by a provider-defined function.
+type FunctionError = common.FunctionError
+
+// ClientCapabilities describes the optional protocol behaviors that the
+// caller can handle, for use in request types and in Config.
+type ClientCapabilities = common.ClientCapabilities
+
+// Deferred describes a provider's decision to defer an action, as found in
+// the Deferred field of response types.
+type Deferred = common.Deferred
+
+type DeferralReason = common.DeferralReason
+
+const (
+	DeferralReasonUnknown               DeferralReason = common.DeferralReasonUnknown
+	DeferralReasonResourceConfigUnknown DeferralReason = common.DeferralReasonResourceConfigUnknown
+	DeferralReasonProviderConfigUnknown DeferralReason = common.DeferralReasonProviderConfigUnknown
+	DeferralReasonAbsentPrerequisite    DeferralReason = common.DeferralReasonAbsentPrerequisite
+)
+
+// Capabilities describes the plugin protocol version and optional protocol
+// behaviors of a provider, as returned by Provider.Capabilities.
+type Capabilities = common.Capabilities
+
+// Feature identifies an optional capability of a provider, for use with
+// Provider.Supports.
+type Feature = common.Feature
+
+const (
+	FeatureManagedResourceImport    Feature = common.FeatureManagedResourceImport
+	FeatureManagedResourceMoveState Feature = common.FeatureManagedResourceMoveState
+	FeatureResourceIdentity         Feature = common.FeatureResourceIdentity
+	FeatureDataResourceRead         Feature = common.FeatureDataResourceRead
+	FeatureEphemeralResources       Feature = common.FeatureEphemeralResources
+	FeatureListResources            Feature = common.FeatureListResources
+	FeatureActions                  Feature = common.FeatureActions
+	FeatureStop                     Feature = common.FeatureStop
+	FeatureFunctions                Feature = common.FeatureFunctions
+)
+
+// CallPolicy describes timeouts, retries and concurrency limits for the
+// calls made to a provider plugin. Use it with [StartWithOptions].
+type CallPolicy = common.CallPolicy
+
+// ConnectionPolicy describes message size limits and requirements for the
+// connection to a provider plugin. Use it with [StartWithOptions].
+type ConnectionPolicy = common.ConnectionPolicy
+
+type Transport = common.Transport
+
+const (
+	TransportAny  Transport = common.TransportAny
+	TransportUnix Transport = common.TransportUnix
+	TransportTCP  Transport = common.TransportTCP
+)
@@ -0,0 +1,79 @@
+package tfprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// DiagnosticsWriter writes diagnostics as a stream of JSON objects, one per
+// line, using the same message envelope as Terraform's machine-readable UI
+// output, so that tools which already consume Terraform's "-json" output
+// can consume it too.
+//
+// A DiagnosticsWriter is safe for concurrent use.
+type DiagnosticsWriter struct {
+	// Module is the value of the "@module" property of each message. The
+	// default is "tfprovider".
+	Module string
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewDiagnosticsWriter returns a writer that writes to the given writer.
+func NewDiagnosticsWriter(w io.Writer) *DiagnosticsWriter {
+	return &DiagnosticsWriter{
+		Module: "tfprovider",
+		w:      w,
+	}
+}
+
+// diagnosticMessage is a single line of output from a DiagnosticsWriter.
+type diagnosticMessage struct {
+	Level      string     `json:"@level"`
+	Message    string     `json:"@message"`
+	Module     string     `json:"@module"`
+	Timestamp  string     `json:"@timestamp"`
+	Diagnostic Diagnostic `json:"diagnostic"`
+	Type       string     `json:"type"`
+}
+
+// diagnosticTimestampFormat is the timestamp format Terraform uses in its
+// machine-readable output.
+const diagnosticTimestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// WriteDiagnostics writes one line for each of the given diagnostics.
+func (w *DiagnosticsWriter) WriteDiagnostics(diags Diagnostics) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now().Format(diagnosticTimestampFormat)
+	for _, diag := range diags {
+		msg := diagnosticMessage{
+			Module:     w.Module,
+			Timestamp:  now,
+			Diagnostic: diag,
+			Type:       "diagnostic",
+		}
+		switch diag.Severity {
+		case Error:
+			msg.Level = "error"
+			msg.Message = "Error: " + diag.Summary
+		case Warning:
+			msg.Level = "warn"
+			msg.Message = "Warning: " + diag.Summary
+		}
+		raw, err := json.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to encode diagnostic: %s", err)
+		}
+		raw = append(raw, '\n')
+		if _, err := w.w.Write(raw); err != nil {
+			return fmt.Errorf("failed to write diagnostic: %s", err)
+		}
+	}
+	return nil
+}
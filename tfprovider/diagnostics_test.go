@@ -0,0 +1,58 @@
+package tfprovider
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDiagnosticsWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewDiagnosticsWriter(&buf)
+	err := w.WriteDiagnostics(Diagnostics{
+		{Severity: Warning, Summary: "Deprecated attribute"},
+		{Severity: Error, Summary: "Invalid value", Attribute: cty.GetAttrPath("name")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("wrote %d lines; want 2:\n%s", len(lines), buf.String())
+	}
+	var msgs []map[string]interface{}
+	for _, line := range lines {
+		var msg map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &msg); err != nil {
+			t.Fatalf("invalid line %q: %s", line, err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if got, want := msgs[0]["@level"], "warn"; got != want {
+		t.Errorf("wrong level %v; want %v", got, want)
+	}
+	if got, want := msgs[0]["@message"], "Warning: Deprecated attribute"; got != want {
+		t.Errorf("wrong message %v; want %v", got, want)
+	}
+	if got, want := msgs[1]["@message"], "Error: Invalid value"; got != want {
+		t.Errorf("wrong message %v; want %v", got, want)
+	}
+	for i, msg := range msgs {
+		if msg["@module"] != "tfprovider" || msg["type"] != "diagnostic" {
+			t.Errorf("wrong envelope for line %d: %#v", i, msg)
+		}
+		if _, err := time.Parse(diagnosticTimestampFormat, msg["@timestamp"].(string)); err != nil {
+			t.Errorf("invalid timestamp for line %d: %s", i, err)
+		}
+	}
+	diag := msgs[1]["diagnostic"].(map[string]interface{})
+	if diag["severity"] != "error" || diag["attribute"] != ".name" {
+		t.Errorf("wrong diagnostic %#v", diag)
+	}
+}
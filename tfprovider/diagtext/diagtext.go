@@ -0,0 +1,228 @@
+// Package diagtext renders diagnostics from package tfprovider as text for
+// display to humans, in the style Terraform uses.
+//
+// If the configuration values passed to a provider came from source files,
+// such as HCL configuration files, a Renderer can use a Locator to map the
+// attribute path of each diagnostic back to the source code that defined
+// the attribute, and include a snippet of that source code in the output.
+// Package hcldiag builds such a Locator from HCL configuration bodies.
+package diagtext
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// Renderer writes diagnostics as text.
+//
+// The zero value of Renderer renders diagnostics without source snippets,
+// colors or wrapping.
+type Renderer struct {
+	// Files are the contents of the source files that Locator refers to,
+	// keyed by filename. If a diagnostic refers to a file that isn't in
+	// this map, the output includes its location but no snippet.
+	Files map[string][]byte
+
+	// Locator finds the source range for the attribute path of each
+	// diagnostic. If nil, diagnostics have no source location.
+	Locator Locator
+
+	// Width is the number of columns to wrap the detail text to. Zero
+	// means no wrapping.
+	Width int
+
+	// Color enables terminal formatting using ANSI escape sequences.
+	Color bool
+}
+
+const (
+	ansiReset     = "\x1b[0m"
+	ansiBold      = "\x1b[1m"
+	ansiUnderline = "\x1b[4m"
+	ansiRed       = "\x1b[31m"
+	ansiYellow    = "\x1b[33m"
+)
+
+// Render writes all of the given diagnostics to the given writer.
+func (r *Renderer) Render(w io.Writer, diags tfprovider.Diagnostics) error {
+	bw := bufio.NewWriter(w)
+	for _, diag := range diags {
+		r.render(bw, diag)
+	}
+	return bw.Flush()
+}
+
+// RenderDiagnostic returns the text for a single diagnostic.
+func (r *Renderer) RenderDiagnostic(diag tfprovider.Diagnostic) string {
+	var buf bytes.Buffer
+	bw := bufio.NewWriter(&buf)
+	r.render(bw, diag)
+	bw.Flush()
+	return buf.String()
+}
+
+func (r *Renderer) render(w *bufio.Writer, diag tfprovider.Diagnostic) {
+	var label, color string
+	switch diag.Severity {
+	case tfprovider.Error:
+		label, color = "Error", ansiRed
+	case tfprovider.Warning:
+		label, color = "Warning", ansiYellow
+	default:
+		label = "Diagnostic"
+	}
+
+	w.WriteByte('\n')
+	if r.Color {
+		fmt.Fprintf(w, "%s%s%s: %s%s\n", ansiBold, color, label, diag.Summary, ansiReset)
+	} else {
+		fmt.Fprintf(w, "%s: %s\n", label, diag.Summary)
+	}
+
+	if len(diag.Attribute) != 0 {
+		var rng Range
+		var ok bool
+		if r.Locator != nil {
+			rng, ok = r.Locator.SourceRange(diag.Attribute)
+		}
+		if ok {
+			w.WriteByte('\n')
+			r.renderSnippet(w, rng)
+		} else {
+			fmt.Fprintf(w, "\n  with attribute %s\n", common.FormatCtyPath(diag.Attribute))
+		}
+	}
+
+	if diag.Detail != "" {
+		w.WriteByte('\n')
+		w.WriteString(wrap(diag.Detail, r.Width))
+		w.WriteByte('\n')
+	}
+}
+
+// renderSnippet writes the location of the given range and, if the file
+// is available, the lines of source code it covers with the range itself
+// highlighted.
+func (r *Renderer) renderSnippet(w *bufio.Writer, rng Range) {
+	fmt.Fprintf(w, "  on %s line %d:\n", rng.Filename, rng.Start.Line)
+
+	src, ok := r.Files[rng.Filename]
+	if !ok {
+		return
+	}
+
+	end := rng.End.Byte
+	if end <= rng.Start.Byte {
+		// Make sure an empty range still highlights something.
+		end = rng.Start.Byte + 1
+	}
+
+	lineNum := rng.Start.Line
+	lineStart := lineOffset(src, lineNum)
+	for lineStart >= 0 && lineStart < len(src) && lineStart < end {
+		lineEnd := bytes.IndexByte(src[lineStart:], '\n')
+		if lineEnd < 0 {
+			lineEnd = len(src)
+		} else {
+			lineEnd += lineStart
+		}
+		line := bytes.TrimSuffix(src[lineStart:lineEnd], []byte{'\r'})
+
+		// hlStart and hlEnd are the part of the line to highlight,
+		// relative to the start of the line.
+		hlStart := clamp(rng.Start.Byte-lineStart, 0, len(line))
+		hlEnd := clamp(end-lineStart, hlStart, len(line))
+
+		if r.Color {
+			fmt.Fprintf(w, "  %4d: %s%s%s%s%s\n", lineNum, line[:hlStart], ansiUnderline, line[hlStart:hlEnd], ansiReset, line[hlEnd:])
+		} else {
+			fmt.Fprintf(w, "  %4d: %s\n", lineNum, line)
+			if hlEnd > hlStart {
+				w.WriteString("        ")
+				w.WriteString(caretPrefix(line[:hlStart]))
+				w.WriteString(strings.Repeat("^", utf8.RuneCount(line[hlStart:hlEnd])))
+				w.WriteByte('\n')
+			}
+		}
+
+		lineNum++
+		lineStart = lineEnd + 1
+	}
+}
+
+// lineOffset returns the byte offset of the start of the given one-based
+// line number in src, or -1 if there is no such line.
+func lineOffset(src []byte, line int) int {
+	offset := 0
+	for i := 1; i < line; i++ {
+		next := bytes.IndexByte(src[offset:], '\n')
+		if next < 0 {
+			return -1
+		}
+		offset += next + 1
+	}
+	return offset
+}
+
+// caretPrefix returns whitespace that occupies the same columns as the
+// given text, preserving tabs so that it aligns regardless of tab width.
+func caretPrefix(text []byte) string {
+	var buf strings.Builder
+	for _, r := range string(text) {
+		if r == '\t' {
+			buf.WriteByte('\t')
+		} else {
+			buf.WriteByte(' ')
+		}
+	}
+	return buf.String()
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// wrap word-wraps the given text to the given width. Lines that begin with
+// a space are assumed to be preformatted, and are left unchanged.
+func wrap(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, " ") {
+			continue
+		}
+		var buf strings.Builder
+		col := 0
+		for _, word := range strings.Fields(line) {
+			wordLen := utf8.RuneCountInString(word)
+			switch {
+			case col == 0:
+			case col+1+wordLen > width:
+				buf.WriteByte('\n')
+				col = 0
+			default:
+				buf.WriteByte(' ')
+				col++
+			}
+			buf.WriteString(word)
+			col += wordLen
+		}
+		lines[i] = buf.String()
+	}
+	return strings.Join(lines, "\n")
+}
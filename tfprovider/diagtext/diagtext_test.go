@@ -0,0 +1,152 @@
+package diagtext
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+const testSource = `resource "test_thing" "a" {
+  name = "hello"
+	tags = {
+    env = "prod"
+  }
+}
+`
+
+// testLocator finds the ranges of the attributes in testSource.
+var testLocator = PathRanges{
+	{
+		Path: cty.GetAttrPath("name"),
+		Range: Range{
+			Filename: "main.tf",
+			Start:    Pos{Line: 2, Column: 10, Byte: 37},
+			End:      Pos{Line: 2, Column: 17, Byte: 44},
+		},
+	},
+	{
+		Path: cty.GetAttrPath("tags"),
+		Range: Range{
+			Filename: "main.tf",
+			Start:    Pos{Line: 3, Column: 9, Byte: 53},
+			End:      Pos{Line: 5, Column: 4, Byte: 75},
+		},
+	},
+}
+
+func TestRenderDiagnostic(t *testing.T) {
+	tests := map[string]struct {
+		renderer *Renderer
+		diag     tfprovider.Diagnostic
+		want     string
+	}{
+		"summary only": {
+			&Renderer{},
+			tfprovider.Diagnostic{Severity: tfprovider.Warning, Summary: "Deprecated"},
+			"\nWarning: Deprecated\n",
+		},
+		"attribute without locator": {
+			&Renderer{},
+			tfprovider.Diagnostic{
+				Severity:  tfprovider.Error,
+				Summary:   "Invalid name",
+				Detail:    "Names must be lowercase.",
+				Attribute: cty.GetAttrPath("name"),
+			},
+			"\nError: Invalid name\n\n  with attribute .name\n\nNames must be lowercase.\n",
+		},
+		"snippet": {
+			&Renderer{
+				Files:   map[string][]byte{"main.tf": []byte(testSource)},
+				Locator: testLocator,
+			},
+			tfprovider.Diagnostic{
+				Severity:  tfprovider.Error,
+				Summary:   "Invalid name",
+				Attribute: cty.GetAttrPath("name"),
+			},
+			"\nError: Invalid name\n\n" +
+				"  on main.tf line 2:\n" +
+				"     2:   name = \"hello\"\n" +
+				"                 ^^^^^^^\n",
+		},
+		"multi-line snippet": {
+			&Renderer{
+				Files:   map[string][]byte{"main.tf": []byte(testSource)},
+				Locator: testLocator,
+			},
+			tfprovider.Diagnostic{
+				Severity:  tfprovider.Error,
+				Summary:   "Invalid tag",
+				Attribute: cty.GetAttrPath("tags").Index(cty.StringVal("env")),
+			},
+			"\nError: Invalid tag\n\n" +
+				"  on main.tf line 3:\n" +
+				"     3: \ttags = {\n" +
+				"        \t       ^\n" +
+				"     4:     env = \"prod\"\n" +
+				"        ^^^^^^^^^^^^^^^^\n" +
+				"     5:   }\n" +
+				"        ^^^\n",
+		},
+		"file not available": {
+			&Renderer{Locator: testLocator},
+			tfprovider.Diagnostic{
+				Severity:  tfprovider.Error,
+				Summary:   "Invalid name",
+				Attribute: cty.GetAttrPath("name"),
+			},
+			"\nError: Invalid name\n\n  on main.tf line 2:\n",
+		},
+		"color": {
+			&Renderer{
+				Files:   map[string][]byte{"main.tf": []byte(testSource)},
+				Locator: testLocator,
+				Color:   true,
+			},
+			tfprovider.Diagnostic{
+				Severity:  tfprovider.Error,
+				Summary:   "Invalid name",
+				Attribute: cty.GetAttrPath("name"),
+			},
+			"\n\x1b[1m\x1b[31mError: Invalid name\x1b[0m\n\n" +
+				"  on main.tf line 2:\n" +
+				"     2:   name = \x1b[4m\"hello\"\x1b[0m\n",
+		},
+		"wrapped detail": {
+			&Renderer{Width: 20},
+			tfprovider.Diagnostic{
+				Severity: tfprovider.Error,
+				Summary:  "Failed",
+				Detail:   "The remote API rejected the request.\n  {\"code\": 400, \"reason\": \"bad\"}",
+			},
+			"\nError: Failed\n\nThe remote API\nrejected the\nrequest.\n  {\"code\": 400, \"reason\": \"bad\"}\n",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := test.renderer.RenderDiagnostic(test.diag)
+			if got != test.want {
+				t.Errorf("wrong output\ngot:\n%q\nwant:\n%q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	var buf bytes.Buffer
+	r := &Renderer{}
+	err := r.Render(&buf, tfprovider.Diagnostics{
+		{Severity: tfprovider.Warning, Summary: "First"},
+		{Severity: tfprovider.Error, Summary: "Second"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got, want := buf.String(), "\nWarning: First\n\nError: Second\n"; got != want {
+		t.Errorf("wrong output\ngot:  %q\nwant: %q", got, want)
+	}
+}
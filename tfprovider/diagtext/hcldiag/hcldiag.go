@@ -0,0 +1,131 @@
+// Package hcldiag builds diagtext locators from HCL configuration bodies,
+// so that diagnostics about configuration decoded from HCL files can
+// include snippets of those files.
+//
+// This is a separate package from diagtext so that only callers that use
+// HCL depend on it.
+package hcldiag
+
+import (
+	"sort"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/hashicorp/hcl2/hcl"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider/diagtext"
+)
+
+// PathRanges returns the source ranges of the attributes and nested blocks
+// in body that schema describes, keyed by the attribute paths that the
+// corresponding parts of a value decoded from body would have.
+//
+// The range of an attribute is the range of its value expression, and the
+// range of a block is the range of its header. Nested blocks of a list or
+// map nesting mode also have an entry for the block type as a whole, using
+// the first block, and nested blocks of a set nesting mode have only that
+// entry because set elements have no stable index.
+//
+// Parts of body that don't conform to schema are skipped rather than
+// reported, since decoding body reports them already.
+func PathRanges(body hcl.Body, schema *tfschema.Block) diagtext.PathRanges {
+	var ret diagtext.PathRanges
+	appendPathRanges(&ret, nil, body, schema)
+	return ret
+}
+
+// Range converts a HCL source range to a diagtext source range.
+func Range(rng hcl.Range) diagtext.Range {
+	return diagtext.Range{
+		Filename: rng.Filename,
+		Start:    pos(rng.Start),
+		End:      pos(rng.End),
+	}
+}
+
+func pos(p hcl.Pos) diagtext.Pos {
+	return diagtext.Pos{Line: p.Line, Column: p.Column, Byte: p.Byte}
+}
+
+func appendPathRanges(ranges *diagtext.PathRanges, path cty.Path, body hcl.Body, schema *tfschema.Block) {
+	if body == nil || schema == nil {
+		return
+	}
+	content, _, _ := body.PartialContent(bodySchema(schema))
+
+	names := make([]string, 0, len(content.Attributes))
+	for name := range content.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		*ranges = append(*ranges, diagtext.PathRange{
+			Path:  appendPath(path, cty.GetAttrStep{Name: name}),
+			Range: Range(content.Attributes[name].Expr.Range()),
+		})
+	}
+
+	seen := make(map[string]int)
+	for _, block := range content.Blocks {
+		blockS := schema.BlockTypes[block.Type]
+		if blockS == nil {
+			continue
+		}
+		typePath := appendPath(path, cty.GetAttrStep{Name: block.Type})
+		if seen[block.Type] == 0 {
+			*ranges = append(*ranges, diagtext.PathRange{
+				Path:  typePath,
+				Range: Range(block.DefRange),
+			})
+		}
+		idx := seen[block.Type]
+		seen[block.Type]++
+
+		var blockPath cty.Path
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			blockPath = typePath
+		case tfschema.NestingList:
+			blockPath = appendPath(typePath, cty.IndexStep{Key: cty.NumberIntVal(int64(idx))})
+		case tfschema.NestingMap:
+			if len(block.Labels) != 1 {
+				continue
+			}
+			blockPath = appendPath(typePath, cty.IndexStep{Key: cty.StringVal(block.Labels[0])})
+		default:
+			continue
+		}
+		if len(blockPath) != len(typePath) {
+			*ranges = append(*ranges, diagtext.PathRange{
+				Path:  blockPath,
+				Range: Range(block.DefRange),
+			})
+		}
+		appendPathRanges(ranges, blockPath, block.Body, &blockS.Block)
+	}
+}
+
+// bodySchema returns the HCL body schema for a block, matching the one
+// that the block's DecoderSpec uses.
+func bodySchema(schema *tfschema.Block) *hcl.BodySchema {
+	ret := &hcl.BodySchema{}
+	for name := range schema.Attributes {
+		ret.Attributes = append(ret.Attributes, hcl.AttributeSchema{Name: name})
+	}
+	for name, blockS := range schema.BlockTypes {
+		header := hcl.BlockHeaderSchema{Type: name}
+		if blockS.Nesting == tfschema.NestingMap {
+			header.LabelNames = []string{"key"}
+		}
+		ret.Blocks = append(ret.Blocks, header)
+	}
+	return ret
+}
+
+// appendPath returns a new path with the given step appended, without
+// sharing the backing array of path.
+func appendPath(path cty.Path, step cty.PathStep) cty.Path {
+	ret := make(cty.Path, len(path), len(path)+1)
+	copy(ret, path)
+	return append(ret, step)
+}
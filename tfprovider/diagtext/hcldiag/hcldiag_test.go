@@ -0,0 +1,136 @@
+package hcldiag
+
+import (
+	"testing"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/hashicorp/hcl2/hcl"
+	"github.com/hashicorp/hcl2/hcl/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const testSource = `name = "hello"
+tags = {
+  env = "prod"
+}
+
+rule {
+  port = 80
+}
+rule {
+  port     = 443
+  protocol = "tcp"
+}
+
+timeouts {
+  create = "5m"
+}
+
+header "accept" {
+  value = "text/plain"
+}
+
+unknown = true
+`
+
+var testSchema = &tfschema.Block{
+	Attributes: map[string]*tfschema.Attribute{
+		"name": {Type: cty.String, Optional: true},
+		"tags": {Type: cty.Map(cty.String), Optional: true},
+	},
+	BlockTypes: map[string]*tfschema.NestedBlock{
+		"rule": {
+			Nesting: tfschema.NestingList,
+			Block: tfschema.Block{
+				Attributes: map[string]*tfschema.Attribute{
+					"port":     {Type: cty.Number, Required: true},
+					"protocol": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		"timeouts": {
+			Nesting: tfschema.NestingSingle,
+			Block: tfschema.Block{
+				Attributes: map[string]*tfschema.Attribute{
+					"create": {Type: cty.String, Optional: true},
+				},
+			},
+		},
+		"header": {
+			Nesting: tfschema.NestingMap,
+			Block: tfschema.Block{
+				Attributes: map[string]*tfschema.Attribute{
+					"value": {Type: cty.String, Required: true},
+				},
+			},
+		},
+	},
+}
+
+func TestPathRanges(t *testing.T) {
+	f, diags := hclsyntax.ParseConfig([]byte(testSource), "main.tf", hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Error())
+	}
+	rs := PathRanges(f.Body, testSchema)
+
+	tests := map[string]struct {
+		path      cty.Path
+		wantLine  int
+		wantStart int
+	}{
+		"attribute": {
+			cty.GetAttrPath("name"),
+			1, 8,
+		},
+		"inside attribute": {
+			cty.GetAttrPath("tags").Index(cty.StringVal("env")),
+			2, 8,
+		},
+		"list block attribute": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(1)).GetAttr("protocol"),
+			11, 14,
+		},
+		"list block": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(1)),
+			9, 1,
+		},
+		"list block type": {
+			cty.GetAttrPath("rule"),
+			6, 1,
+		},
+		"missing list block attribute": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("protocol"),
+			6, 1,
+		},
+		"single block attribute": {
+			cty.GetAttrPath("timeouts").GetAttr("create"),
+			15, 12,
+		},
+		"map block attribute": {
+			cty.GetAttrPath("header").Index(cty.StringVal("accept")).GetAttr("value"),
+			19, 11,
+		},
+		"not in schema": {
+			cty.GetAttrPath("unknown"),
+			0, 0,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := rs.SourceRange(test.path)
+			if test.wantLine == 0 {
+				if ok {
+					t.Errorf("unexpected range %#v", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("no range found")
+			}
+			if got.Filename != "main.tf" || got.Start.Line != test.wantLine || got.Start.Column != test.wantStart {
+				t.Errorf("wrong range %s:%d,%d; want main.tf:%d,%d", got.Filename, got.Start.Line, got.Start.Column, test.wantLine, test.wantStart)
+			}
+		})
+	}
+}
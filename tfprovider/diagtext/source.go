@@ -0,0 +1,93 @@
+package diagtext
+
+import (
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Pos is a position in a source file.
+//
+// Pos has the same fields as hcl.Pos from HCL, so callers using HCL can
+// convert between them field by field.
+type Pos struct {
+	// Line and Column are one-based. Column counts characters rather than
+	// bytes.
+	Line, Column int
+
+	// Byte is the zero-based byte offset into the file.
+	Byte int
+}
+
+// Range is a range of bytes in a source file, from Start up to but not
+// including End.
+//
+// Range has the same fields as hcl.Range from HCL.
+type Range struct {
+	Filename   string
+	Start, End Pos
+}
+
+// Locator finds the source range that a value at a given attribute path
+// came from.
+type Locator interface {
+	// SourceRange returns the range of source code that defines the value
+	// at the given path, or false if it isn't known.
+	SourceRange(path cty.Path) (Range, bool)
+}
+
+// LocatorFunc is an adapter to allow the use of an ordinary function as a
+// Locator.
+type LocatorFunc func(path cty.Path) (Range, bool)
+
+func (f LocatorFunc) SourceRange(path cty.Path) (Range, bool) {
+	return f(path)
+}
+
+// PathRange associates an attribute path with the source range it came from.
+type PathRange struct {
+	Path  cty.Path
+	Range Range
+}
+
+// PathRanges is a Locator that looks up paths in a list, which a caller
+// typically builds while decoding a configuration file by recording the
+// range of each attribute and block it decodes.
+//
+// If there is no entry for a path, the locator uses the entry for the
+// longest prefix of it that has one, so that a diagnostic about a value
+// nested inside an attribute or block can still refer to the attribute or
+// block as a whole.
+type PathRanges []PathRange
+
+var _ Locator = PathRanges(nil)
+
+func (rs PathRanges) SourceRange(path cty.Path) (Range, bool) {
+	var ret Range
+	best := -1
+	for _, r := range rs {
+		if len(r.Path) > best && len(r.Path) <= len(path) && pathHasPrefix(path, r.Path) {
+			ret = r.Range
+			best = len(r.Path)
+		}
+	}
+	return ret, best >= 0
+}
+
+func pathHasPrefix(path, prefix cty.Path) bool {
+	for i, step := range prefix {
+		switch step := step.(type) {
+		case cty.GetAttrStep:
+			other, ok := path[i].(cty.GetAttrStep)
+			if !ok || other.Name != step.Name {
+				return false
+			}
+		case cty.IndexStep:
+			other, ok := path[i].(cty.IndexStep)
+			if !ok || !other.Key.RawEquals(step.Key) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package diagtext
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestPathRanges(t *testing.T) {
+	rng := func(line int) Range {
+		return Range{Filename: "main.tf", Start: Pos{Line: line}}
+	}
+	rs := PathRanges{
+		{Path: cty.GetAttrPath("rule"), Range: rng(1)},
+		{Path: cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)), Range: rng(2)},
+		{Path: cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("port"), Range: rng(3)},
+		{Path: cty.GetAttrPath("tags").Index(cty.StringVal("env")), Range: rng(4)},
+	}
+
+	tests := map[string]struct {
+		path     cty.Path
+		wantLine int
+	}{
+		"exact": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("port"),
+			3,
+		},
+		"longest prefix": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("protocol"),
+			2,
+		},
+		"other index": {
+			cty.GetAttrPath("rule").Index(cty.NumberIntVal(1)).GetAttr("port"),
+			1,
+		},
+		"string key": {
+			cty.GetAttrPath("tags").Index(cty.StringVal("env")),
+			4,
+		},
+		"other string key": {
+			cty.GetAttrPath("tags").Index(cty.StringVal("team")),
+			0,
+		},
+		"shorter than every entry": {
+			cty.Path{},
+			0,
+		},
+		"attribute where index expected": {
+			cty.GetAttrPath("rule").GetAttr("port"),
+			1,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, ok := rs.SourceRange(test.path)
+			if test.wantLine == 0 {
+				if ok {
+					t.Errorf("unexpected range %#v", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("no range found")
+			}
+			if got.Start.Line != test.wantLine {
+				t.Errorf("wrong range for line %d; want line %d", got.Start.Line, test.wantLine)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// maxRenewLead is the longest time before an ephemeral resource's renewal
+// deadline that an EphemeralResource renews it. Shorter-lived resources
+// are renewed when nine tenths of the remaining time has passed.
+const maxRenewLead = 30 * time.Second
+
+// EphemeralResource is an open ephemeral resource that is renewed
+// automatically in the background until it is closed.
+//
+// Use [OpenEphemeralResource] to open one. The resource is closed when the
+// caller calls Close, when the context given to OpenEphemeralResource is
+// cancelled, or when the provider it belongs to is closed, whichever
+// happens first.
+type EphemeralResource struct {
+	rt     EphemeralResourceType
+	result cty.Value
+
+	mu            sync.Mutex
+	private       []byte
+	renewDiags    Diagnostics
+	cancelOnClose func()
+
+	// running is set once the goroutine that renews the resource has
+	// started, after which closing must wait for it to finish.
+	running bool
+
+	stop       chan struct{}
+	done       chan struct{}
+	closeOnce  sync.Once
+	closeDiags Diagnostics
+}
+
+// OpenEphemeralResource opens an ephemeral resource of the given type using
+// the given configuration, and starts renewing it in the background.
+//
+// The provider must be configured first. The context applies to the whole
+// lifetime of the resource rather than just the call to open it: cancelling
+// it closes the resource. The call to close the resource in that case uses
+// a context with the same values but without the cancellation.
+func OpenEphemeralResource(ctx context.Context, provider Provider, typeName string, config cty.Value) (*EphemeralResource, Diagnostics) {
+	rt := provider.EphemeralResourceType(typeName)
+	if rt == nil {
+		// The type might be missing only because the provider's schema
+		// couldn't be retrieved, in which case we report why.
+		if _, diags := ephemeralResourceTypeSchema(ctx, provider, typeName); diags.HasErrors() {
+			return nil, diags
+		}
+		return nil, Diagnostics{
+			{
+				Severity: Error,
+				Summary:  "Unsupported ephemeral resource type",
+				Detail:   fmt.Sprintf("This provider does not support ephemeral resource type %q, or has not been configured yet.", typeName),
+				Cause:    ErrUnknownType,
+			},
+		}
+	}
+
+	resp, diags := rt.Open(ctx, EphemeralResourceOpenRequest{
+		Config: config,
+	})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	r := &EphemeralResource{
+		rt:      rt,
+		result:  resp.Result,
+		private: resp.OpaquePrivate,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	// We register to be closed along with the provider before starting
+	// the goroutine that renews the resource, because that goroutine
+	// closes the resource if ctx is cancelled, and closing must find the
+	// registration to remove. If the provider is closed already then the
+	// registered function runs immediately, before the goroutine starts.
+	cancel := onClose(provider, func() {
+		r.close(context.Background())
+	})
+	r.mu.Lock()
+	r.cancelOnClose = cancel
+	r.running = true
+	r.mu.Unlock()
+	go r.run(ctx, resp.RenewAt)
+	return r, diags
+}
+
+// Value returns the result of opening the ephemeral resource.
+func (r *EphemeralResource) Value() cty.Value {
+	return r.result
+}
+
+// RenewDiagnostics returns the diagnostics from the most recent renewal of
+// the ephemeral resource, if any.
+//
+// If a renewal returns error diagnostics then the resource is no longer
+// renewed, and its value may no longer be valid. It must still be closed.
+func (r *EphemeralResource) RenewDiagnostics() Diagnostics {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.renewDiags
+}
+
+// Close stops renewing the ephemeral resource and asks the provider to
+// close it. Close may be called more than once, in which case later calls
+// return the diagnostics from the first.
+func (r *EphemeralResource) Close(ctx context.Context) Diagnostics {
+	return r.close(ctx)
+}
+
+func (r *EphemeralResource) close(ctx context.Context) Diagnostics {
+	r.closeOnce.Do(func() {
+		close(r.stop)
+		r.mu.Lock()
+		running := r.running
+		r.mu.Unlock()
+		if running {
+			<-r.done
+		}
+
+		r.mu.Lock()
+		private := r.private
+		cancel := r.cancelOnClose
+		r.mu.Unlock()
+		if cancel != nil {
+			cancel()
+		}
+		r.closeDiags = r.rt.Close(ctx, EphemeralResourceCloseRequest{
+			OpaquePrivate: private,
+		})
+	})
+	return r.closeDiags
+}
+
+// run renews the resource in the background until it is closed, and closes
+// it if the given context is cancelled first.
+func (r *EphemeralResource) run(ctx context.Context, renewAt time.Time) {
+	cancelled := r.renewLoop(ctx, renewAt)
+	close(r.done)
+	if cancelled {
+		r.close(detachedContext{ctx})
+	}
+}
+
+// renewLoop renews the resource before each renewal deadline, returning
+// true if it stopped because the given context was cancelled.
+func (r *EphemeralResource) renewLoop(ctx context.Context, renewAt time.Time) bool {
+	for {
+		// Closing takes priority over a renewal that is already due, so
+		// that a resource closed before this goroutine started is never
+		// renewed.
+		select {
+		case <-r.stop:
+			return false
+		default:
+		}
+
+		var timer *time.Timer
+		var renew <-chan time.Time
+		if !renewAt.IsZero() {
+			timer = time.NewTimer(renewDelay(time.Until(renewAt)))
+			renew = timer.C
+		}
+
+		select {
+		case <-r.stop:
+			stopTimer(timer)
+			return false
+		case <-ctx.Done():
+			stopTimer(timer)
+			return true
+		case <-renew:
+		}
+
+		r.mu.Lock()
+		private := r.private
+		r.mu.Unlock()
+		resp, diags := r.rt.Renew(ctx, EphemeralResourceRenewRequest{
+			OpaquePrivate: private,
+		})
+		r.mu.Lock()
+		r.renewDiags = diags
+		if !diags.HasErrors() {
+			r.private = resp.OpaquePrivate
+		}
+		r.mu.Unlock()
+
+		if diags.HasErrors() {
+			renewAt = time.Time{}
+		} else {
+			renewAt = resp.RenewAt
+		}
+	}
+}
+
+// renewDelay returns how long to wait before renewing a resource whose
+// renewal deadline is the given duration away.
+func renewDelay(remain time.Duration) time.Duration {
+	if remain <= 0 {
+		return 0
+	}
+	lead := remain / 10
+	if lead > maxRenewLead {
+		lead = maxRenewLead
+	}
+	return remain - lead
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// onClose arranges for the given function to be called when the given
+// provider is closed, if the provider supports that, returning a function
+// that cancels the arrangement.
+func onClose(provider Provider, fn func()) (cancel func()) {
+	if p, ok := provider.(interface {
+		OnClose(fn func()) (cancel func())
+	}); ok {
+		return p.OnClose(fn)
+	}
+	return func() {}
+}
+
+// detachedContext is a context that has the values of another context but
+// is never cancelled, so that cleanup can still run once the other context
+// is cancelled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (ctx detachedContext) Deadline() (time.Time, bool)       { return time.Time{}, false }
+func (ctx detachedContext) Done() <-chan struct{}             { return nil }
+func (ctx detachedContext) Err() error                        { return nil }
+func (ctx detachedContext) Value(key interface{}) interface{} { return ctx.parent.Value(key) }
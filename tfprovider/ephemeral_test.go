@@ -0,0 +1,325 @@
+package tfprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// fakeEphemeralResourceType is an EphemeralResourceType that renews its
+// objects every few milliseconds, recording the private data it's given.
+type fakeEphemeralResourceType struct {
+	EphemeralResourceType
+
+	// renewEvery is the time between renewals, or zero if the objects
+	// don't need renewing.
+	renewEvery time.Duration
+
+	// failAfter is the number of renewals that succeed before the rest
+	// return errors.
+	failAfter int
+
+	mu       sync.Mutex
+	renewals []string
+	closes   []string
+	closeCtx context.Context
+}
+
+func (rt *fakeEphemeralResourceType) renewAt() time.Time {
+	if rt.renewEvery == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(rt.renewEvery)
+}
+
+func (rt *fakeEphemeralResourceType) Open(ctx context.Context, req EphemeralResourceOpenRequest) (EphemeralResourceOpenResponse, Diagnostics) {
+	return EphemeralResourceOpenResponse{
+		Result:        cty.ObjectVal(map[string]cty.Value{"value": cty.StringVal("secret")}),
+		OpaquePrivate: []byte("open"),
+		RenewAt:       rt.renewAt(),
+	}, nil
+}
+
+func (rt *fakeEphemeralResourceType) Renew(ctx context.Context, req EphemeralResourceRenewRequest) (EphemeralResourceRenewResponse, Diagnostics) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.renewals = append(rt.renewals, string(req.OpaquePrivate))
+	n := len(rt.renewals)
+	if n > rt.failAfter {
+		return EphemeralResourceRenewResponse{}, Diagnostics{
+			{Severity: Error, Summary: "Lease expired"},
+		}
+	}
+	return EphemeralResourceRenewResponse{
+		OpaquePrivate: []byte(fmt.Sprintf("renew%d", n)),
+		RenewAt:       rt.renewAt(),
+	}, nil
+}
+
+func (rt *fakeEphemeralResourceType) Close(ctx context.Context, req EphemeralResourceCloseRequest) Diagnostics {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	rt.closes = append(rt.closes, string(req.OpaquePrivate))
+	rt.closeCtx = ctx
+	return nil
+}
+
+func (rt *fakeEphemeralResourceType) calls() (renewals, closes []string) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+	return append([]string(nil), rt.renewals...), append([]string(nil), rt.closes...)
+}
+
+// onCloseProvider is a fakeProvider that supports OnClose, like the
+// providers returned from Start.
+type onCloseProvider struct {
+	fakeProvider
+
+	mu     sync.Mutex
+	fns    map[int]func()
+	n      int
+	closed bool
+}
+
+func (p *onCloseProvider) OnClose(fn func()) (cancel func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		fn()
+		return func() {}
+	}
+	if p.fns == nil {
+		p.fns = make(map[int]func())
+	}
+	id := p.n
+	p.n++
+	p.fns[id] = fn
+	return func() {
+		p.mu.Lock()
+		delete(p.fns, id)
+		p.mu.Unlock()
+	}
+}
+
+func (p *onCloseProvider) Close() error {
+	p.mu.Lock()
+	fns := p.fns
+	p.fns = nil
+	p.closed = true
+	p.mu.Unlock()
+	for _, fn := range fns {
+		fn()
+	}
+	return p.fakeProvider.Close()
+}
+
+// waitFor polls until the given condition is true, failing the test if it
+// doesn't become true within a few seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestEphemeralResourceRenew(t *testing.T) {
+	rt := &fakeEphemeralResourceType{renewEvery: 10 * time.Millisecond, failAfter: 2}
+	p := &fakeProvider{
+		schema:    testProviderSchema(),
+		ephemeral: map[string]EphemeralResourceType{"test_secret": rt},
+	}
+
+	r, diags := OpenEphemeralResource(context.Background(), p, "test_secret", cty.EmptyObjectVal)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if got := r.Value().GetAttr("value"); !got.RawEquals(cty.StringVal("secret")) {
+		t.Errorf("wrong value %#v", got)
+	}
+
+	waitFor(t, "renewal to fail", func() bool {
+		return r.RenewDiagnostics().HasErrors()
+	})
+	if got, want := r.RenewDiagnostics()[0].Summary, "Lease expired"; got != want {
+		t.Errorf("wrong summary %q; want %q", got, want)
+	}
+
+	// After a failed renewal there are no more attempts, and closing uses
+	// the private data from the last successful renewal.
+	time.Sleep(50 * time.Millisecond)
+	if diags := r.Close(context.Background()); diags.HasErrors() {
+		t.Fatalf("unexpected errors from Close: %s", diags.Err())
+	}
+	renewals, closes := rt.calls()
+	if got, want := strings.Join(renewals, ","), "open,renew1,renew2"; got != want {
+		t.Errorf("wrong renewals %q; want %q", got, want)
+	}
+	if got, want := strings.Join(closes, ","), "renew2"; got != want {
+		t.Errorf("wrong closes %q; want %q", got, want)
+	}
+
+	// Closing again doesn't call the provider again.
+	r.Close(context.Background())
+	if _, closes := rt.calls(); len(closes) != 1 {
+		t.Errorf("closed %d times; want 1", len(closes))
+	}
+}
+
+func TestEphemeralResourceContextCancel(t *testing.T) {
+	rt := &fakeEphemeralResourceType{}
+	p := &fakeProvider{
+		schema:    testProviderSchema(),
+		ephemeral: map[string]EphemeralResourceType{"test_secret": rt},
+	}
+
+	type ctxKey struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "v"))
+	r, diags := OpenEphemeralResource(ctx, p, "test_secret", cty.EmptyObjectVal)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	cancel()
+
+	waitFor(t, "resource to close", func() bool {
+		_, closes := rt.calls()
+		return len(closes) == 1
+	})
+	rt.mu.Lock()
+	closeCtx := rt.closeCtx
+	rt.mu.Unlock()
+	if closeCtx.Err() != nil {
+		t.Errorf("resource closed with a cancelled context: %s", closeCtx.Err())
+	}
+	if closeCtx.Value(ctxKey{}) != "v" {
+		t.Error("resource closed with a context without the original values")
+	}
+
+	r.Close(context.Background())
+	if renewals, closes := rt.calls(); len(renewals) != 0 || len(closes) != 1 {
+		t.Errorf("wrong calls: %d renewals and %d closes", len(renewals), len(closes))
+	}
+}
+
+func TestEphemeralResourceProviderClose(t *testing.T) {
+	rt := &fakeEphemeralResourceType{}
+	p := &onCloseProvider{
+		fakeProvider: fakeProvider{
+			schema:    testProviderSchema(),
+			ephemeral: map[string]EphemeralResourceType{"test_secret": rt},
+		},
+	}
+
+	closed, diags := OpenEphemeralResource(context.Background(), p, "test_secret", cty.EmptyObjectVal)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if _, diags := OpenEphemeralResource(context.Background(), p, "test_secret", cty.EmptyObjectVal); diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	// A resource closed explicitly is no longer closed with the provider.
+	closed.Close(context.Background())
+	if err := p.Close(); err != nil {
+		t.Fatalf("unexpected error from Close: %s", err)
+	}
+	if _, closes := rt.calls(); len(closes) != 2 {
+		t.Errorf("closed %d times; want 2", len(closes))
+	}
+}
+
+func TestEphemeralResourceProviderClosed(t *testing.T) {
+	rt := &fakeEphemeralResourceType{renewEvery: time.Nanosecond, failAfter: 10}
+	p := &onCloseProvider{
+		fakeProvider: fakeProvider{
+			schema:    testProviderSchema(),
+			ephemeral: map[string]EphemeralResourceType{"test_secret": rt},
+		},
+		closed: true,
+	}
+
+	// Opening a resource from a provider that is already closed closes
+	// the resource again straight away, without renewing it.
+	r, diags := OpenEphemeralResource(context.Background(), p, "test_secret", cty.EmptyObjectVal)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	r.Close(context.Background())
+	if renewals, closes := rt.calls(); len(renewals) != 0 || len(closes) != 1 {
+		t.Errorf("wrong calls: %d renewals and %d closes", len(renewals), len(closes))
+	}
+}
+
+func TestEphemeralResourceContextCancelled(t *testing.T) {
+	rt := &fakeEphemeralResourceType{}
+	p := &onCloseProvider{
+		fakeProvider: fakeProvider{
+			schema:    testProviderSchema(),
+			ephemeral: map[string]EphemeralResourceType{"test_secret": rt},
+		},
+	}
+
+	// A resource closed because its context is cancelled is no longer
+	// closed with the provider, even if that happens before
+	// OpenEphemeralResource returns.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, diags := OpenEphemeralResource(ctx, p, "test_secret", cty.EmptyObjectVal); diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	waitFor(t, "resource to close", func() bool {
+		_, closes := rt.calls()
+		return len(closes) == 1
+	})
+	p.mu.Lock()
+	registered := len(p.fns)
+	p.mu.Unlock()
+	if registered != 0 {
+		t.Errorf("%d functions still registered with the provider", registered)
+	}
+}
+
+func TestOpenEphemeralResourceUnknownType(t *testing.T) {
+	p := &lazyFakeProvider{fakeProvider: fakeProvider{schema: testProviderSchema()}}
+	_, diags := OpenEphemeralResource(context.Background(), p, "test_nonexist", cty.EmptyObjectVal)
+	if !errors.Is(diags.Err(), ErrUnknownType) {
+		t.Errorf("wrong error %v; want ErrUnknownType", diags.Err())
+	}
+
+	p.schemaDiags = Diagnostics{
+		{Severity: Error, Summary: "Failed to retrieve provider schema"},
+	}
+	_, diags = OpenEphemeralResource(context.Background(), p, "test_secret", cty.EmptyObjectVal)
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success")
+	}
+	if errors.Is(diags.Err(), ErrUnknownType) {
+		t.Error("schema failure reported as an unknown type")
+	}
+}
+
+func TestRenewDelay(t *testing.T) {
+	tests := []struct {
+		remain, want time.Duration
+	}{
+		{-time.Second, 0},
+		{0, 0},
+		{10 * time.Second, 9 * time.Second},
+		{5 * time.Minute, 4*time.Minute + 30*time.Second},
+		{time.Hour, time.Hour - maxRenewLead},
+	}
+	for _, test := range tests {
+		if got := renewDelay(test.remain); got != test.want {
+			t.Errorf("renewDelay(%s) = %s; want %s", test.remain, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package tfprovider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// CtyFunctions returns a cty function for each of the provider-defined
+// functions in the given provider's schema, keyed by function name.
+//
+// The returned functions are suitable for use in an HCL evaluation context,
+// or anywhere else that accepts cty functions. Each call to one of them
+// calls the provider's function using the given context.
+func CtyFunctions(ctx context.Context, provider Provider) (map[string]function.Function, Diagnostics) {
+	schema, diags := provider.Schema(ctx)
+	if schema == nil {
+		return nil, diags
+	}
+	ret := make(map[string]function.Function, len(schema.Functions))
+	for name, fs := range schema.Functions {
+		ret[name] = CtyFunction(ctx, provider, name, fs)
+	}
+	return ret, diags
+}
+
+// CtyFunction returns a cty function that calls the provider-defined
+// function with the given name and schema.
+//
+// If the provider returns an error relating to a particular argument, the
+// function returns a function.ArgError for that argument, so that callers
+// such as HCL can report the problem against the argument's expression.
+func CtyFunction(ctx context.Context, provider Provider, name string, schema *FunctionSchema) function.Function {
+	spec := &function.Spec{
+		Type: function.StaticReturnType(schema.ReturnType),
+		Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+			ret, diags := provider.CallFunction(ctx, name, args)
+			if diags.HasErrors() {
+				return cty.UnknownVal(retType), functionCallError(diags)
+			}
+			return ret, nil
+		},
+	}
+	for _, param := range schema.Parameters {
+		spec.Params = append(spec.Params, ctyFunctionParameter(param))
+	}
+	if schema.VariadicParameter != nil {
+		param := ctyFunctionParameter(schema.VariadicParameter)
+		spec.VarParam = &param
+	}
+	return function.New(spec)
+}
+
+func ctyFunctionParameter(param *FunctionParameter) function.Parameter {
+	return function.Parameter{
+		Name:             param.Name,
+		Type:             param.Type,
+		AllowNull:        param.AllowNullValue,
+		AllowUnknown:     param.AllowUnknownValues,
+		AllowDynamicType: true,
+	}
+}
+
+// functionCallError returns the error to return from a cty function for
+// the given diagnostics, which must include at least one error.
+func functionCallError(diags Diagnostics) error {
+	for _, diag := range diags {
+		if diag.Severity != Error {
+			continue
+		}
+		var fe *FunctionError
+		if errors.As(diag.Cause, &fe) && fe.Argument != nil {
+			return function.NewArgError(*fe.Argument, errors.New(fe.Text))
+		}
+	}
+	return diags.Err()
+}
@@ -0,0 +1,116 @@
+package tfprovider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+)
+
+// functionProvider is a fakeProvider with a "join" function, which joins
+// its variadic arguments using its first argument as the separator.
+type functionProvider struct {
+	fakeProvider
+	funcCalls int
+}
+
+func (p *functionProvider) CallFunction(ctx context.Context, name string, args []cty.Value) (cty.Value, Diagnostics) {
+	p.funcCalls++
+	if name != "join" {
+		return cty.NilVal, Diagnostics{{Severity: Error, Summary: "Unknown function"}}
+	}
+	sep := args[0].AsString()
+	if sep == "" {
+		arg := 0
+		return cty.NilVal, Diagnostics{
+			{
+				Severity: Error,
+				Summary:  "Invalid function argument",
+				Cause:    &FunctionError{Text: "separator must not be empty", Argument: &arg},
+			},
+		}
+	}
+	var parts []string
+	for _, arg := range args[1:] {
+		parts = append(parts, arg.AsString())
+	}
+	return cty.StringVal(strings.Join(parts, sep)), nil
+}
+
+func testFunctionProvider() *functionProvider {
+	return &functionProvider{
+		fakeProvider: fakeProvider{
+			schema: &Schema{
+				Functions: map[string]*FunctionSchema{
+					"join": {
+						Parameters: []*FunctionParameter{
+							{Name: "sep", Type: cty.String},
+						},
+						VariadicParameter: &FunctionParameter{Name: "parts", Type: cty.String},
+						ReturnType:        cty.String,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCtyFunctions(t *testing.T) {
+	p := testFunctionProvider()
+	funcs, diags := CtyFunctions(context.Background(), p)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	join, ok := funcs["join"]
+	if !ok {
+		t.Fatalf("no join function in %#v", funcs)
+	}
+
+	got, err := join.Call([]cty.Value{cty.StringVal("-"), cty.StringVal("a"), cty.StringVal("b")})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := cty.StringVal("a-b"); !got.RawEquals(want) {
+		t.Errorf("wrong result %#v; want %#v", got, want)
+	}
+
+	// Unknown arguments produce an unknown result without calling the
+	// provider, because the parameters don't allow unknown values.
+	calls := p.funcCalls
+	got, err = join.Call([]cty.Value{cty.StringVal("-"), cty.UnknownVal(cty.String)})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got.IsKnown() {
+		t.Errorf("known result %#v for unknown argument", got)
+	}
+	if p.funcCalls != calls {
+		t.Error("provider called with an unknown argument")
+	}
+}
+
+func TestCtyFunctionArgError(t *testing.T) {
+	p := testFunctionProvider()
+	join := CtyFunction(context.Background(), p, "join", p.schema.Functions["join"])
+
+	_, err := join.Call([]cty.Value{cty.StringVal(""), cty.StringVal("a")})
+	argErr, ok := err.(function.ArgError)
+	if !ok {
+		t.Fatalf("wrong error %#v; want function.ArgError", err)
+	}
+	if argErr.Index != 0 {
+		t.Errorf("wrong argument index %d; want 0", argErr.Index)
+	}
+	if got, want := argErr.Error(), "separator must not be empty"; got != want {
+		t.Errorf("wrong message %q; want %q", got, want)
+	}
+
+	// Errors unrelated to an argument are returned as they are.
+	other := CtyFunction(context.Background(), p, "nonexist", p.schema.Functions["join"])
+	_, err = other.Call([]cty.Value{cty.StringVal("-")})
+	if _, ok := err.(function.ArgError); ok || err == nil {
+		t.Errorf("wrong error %#v", err)
+	}
+}
@@ -0,0 +1,768 @@
+package tfprovider
+
+import (
+	"context"
+	"time"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// OperationName identifies one of the logical operations that a [Hook] can
+// observe.
+//
+// Each operation corresponds to one method of [Provider] or of one of the
+// objects it returns, regardless of which plugin protocol version the
+// provider uses. The documentation for each constant describes the dynamic
+// types of the Request and Response fields of an [Operation] with that name.
+type OperationName string
+
+const (
+	// OpStart is the launch of the provider plugin's child process. The
+	// request is a StartRequest and the response is a Provider, which
+	// doesn't yet pass its own calls through the hook chain.
+	OpStart OperationName = "Start"
+
+	// OpSchema is a call to Provider.Schema. The request is nil and the
+	// response is a *Schema. The operation's RPC retrieves the schema, but
+	// the provider calls it at most once and so the operation often
+	// doesn't call it at all.
+	OpSchema OperationName = "Schema"
+
+	// OpPrepareConfig is a call to Provider.PrepareConfig. The request is
+	// a cty.Value and the response is a Config.
+	OpPrepareConfig OperationName = "PrepareConfig"
+
+	// OpConfigure is a call to Provider.Configure. The request is a Config
+	// and the response is nil.
+	OpConfigure OperationName = "Configure"
+
+	// OpValidateManagedResourceConfig is a call to
+	// Provider.ValidateManagedResourceConfig. The request is a cty.Value and
+	// the response is nil.
+	OpValidateManagedResourceConfig OperationName = "ValidateManagedResourceConfig"
+
+	// OpValidateDataResourceConfig is a call to
+	// Provider.ValidateDataResourceConfig. The request is a cty.Value and
+	// the response is nil.
+	OpValidateDataResourceConfig OperationName = "ValidateDataResourceConfig"
+
+	// OpValidateListResourceConfig is a call to
+	// Provider.ValidateListResourceConfig. The request is a
+	// ListResourceValidateRequest and the response is nil.
+	OpValidateListResourceConfig OperationName = "ValidateListResourceConfig"
+
+	// OpValidateActionConfig is a call to Provider.ValidateActionConfig.
+	// The request is a cty.Value and the response is nil.
+	OpValidateActionConfig OperationName = "ValidateActionConfig"
+
+	// OpReadManagedResource is a call to ManagedResourceType.Read. The
+	// request is a ManagedResourceReadRequest and the response is a
+	// ManagedResourceReadResponse.
+	OpReadManagedResource OperationName = "ReadManagedResource"
+
+	// OpPlanManagedResource is a call to ManagedResourceType.Plan. The
+	// request is a ManagedResourcePlanRequest and the response is a
+	// ManagedResourcePlanResponse.
+	OpPlanManagedResource OperationName = "PlanManagedResource"
+
+	// OpApplyManagedResource is a call to ManagedResourceType.Apply. The
+	// request is a ManagedResourceApplyRequest and the response is a
+	// ManagedResourceApplyResponse.
+	OpApplyManagedResource OperationName = "ApplyManagedResource"
+
+	// OpImportManagedResource is a call to ManagedResourceType.Import. The
+	// request is a ManagedResourceImportRequest and the response is a
+	// ManagedResourceImportResponse.
+	OpImportManagedResource OperationName = "ImportManagedResource"
+
+	// OpMoveManagedResourceState is a call to ManagedResourceType.MoveState.
+	// The request is a ManagedResourceMoveStateRequest and the response is
+	// a ManagedResourceMoveStateResponse. The schema is that of the target
+	// resource type.
+	OpMoveManagedResourceState OperationName = "MoveManagedResourceState"
+
+	// OpUpgradeManagedResourceIdentity is a call to
+	// ManagedResourceType.UpgradeIdentity. The request is a
+	// ManagedResourceUpgradeIdentityRequest and the response is a
+	// ManagedResourceUpgradeIdentityResponse.
+	OpUpgradeManagedResourceIdentity OperationName = "UpgradeManagedResourceIdentity"
+
+	// OpReadDataResource is a call to DataResourceType.Read. The request
+	// is a DataResourceReadRequest and the response is a
+	// DataResourceReadResponse.
+	OpReadDataResource OperationName = "ReadDataResource"
+
+	// OpOpenEphemeralResource is a call to EphemeralResourceType.Open. The
+	// request is an EphemeralResourceOpenRequest and the response is an
+	// EphemeralResourceOpenResponse.
+	OpOpenEphemeralResource OperationName = "OpenEphemeralResource"
+
+	// OpRenewEphemeralResource is a call to EphemeralResourceType.Renew.
+	// The request is an EphemeralResourceRenewRequest and the response is
+	// an EphemeralResourceRenewResponse.
+	OpRenewEphemeralResource OperationName = "RenewEphemeralResource"
+
+	// OpCloseEphemeralResource is a call to EphemeralResourceType.Close.
+	// The request is an EphemeralResourceCloseRequest and the response is
+	// nil.
+	OpCloseEphemeralResource OperationName = "CloseEphemeralResource"
+
+	// OpListResource is a call to ListResourceType.List. The request is a
+	// ListResourceRequest and the response is the ListResourceResults, so
+	// the operation ends once the stream of results has started rather
+	// than when it finishes. The stream keeps using the context that the
+	// hooks pass on, so hooks must not cancel it when the operation ends.
+	OpListResource OperationName = "ListResource"
+
+	// OpPlanAction is a call to ActionType.Plan. The request is an
+	// ActionPlanRequest and the response is an ActionPlanResponse.
+	OpPlanAction OperationName = "PlanAction"
+
+	// OpInvokeAction is a call to ActionType.Invoke. The request is an
+	// ActionInvokeRequest and the response is the ActionInvocation, so, as
+	// with OpListResource, the operation ends once the action has started
+	// and hooks must not cancel the context they pass on.
+	OpInvokeAction OperationName = "InvokeAction"
+
+	// OpCallFunction is a call to Provider.CallFunction. The TypeName of
+	// the operation is the function name, the request is a []cty.Value of
+	// arguments and the response is a cty.Value.
+	OpCallFunction OperationName = "CallFunction"
+
+	// OpStop is a call to Provider.Stop. The request and response are both
+	// nil.
+	OpStop OperationName = "Stop"
+
+	// OpClose is a call to Provider.Close. The request and response are
+	// both nil.
+	OpClose OperationName = "Close"
+)
+
+// StartRequest is the request value for an [OpStart] operation.
+type StartRequest struct {
+	Executable string
+	Args       []string
+}
+
+// Operation describes a single logical operation on a provider, as seen by
+// a [Hook].
+type Operation struct {
+	// Name identifies which operation this is, which also decides the
+	// dynamic types of Request and Response.
+	Name OperationName
+
+	// TypeName is the name of the resource type that the operation relates
+	// to, or the empty string for operations on the provider as a whole.
+	// For OpCallFunction, it is the name of the function.
+	TypeName string
+
+	// ProtocolVersion is the major version of the plugin protocol that the
+	// provider uses. For OpStart, it is populated once the next function
+	// returns.
+	ProtocolVersion int
+
+	// RPC is the name of the plugin protocol RPC that implements the
+	// operation, or the empty string if the operation doesn't call an RPC
+	// with the provider's protocol version.
+	RPC string
+
+	// Schema describes the configuration or state objects in Request and
+	// Response, if any. It is nil for operations that don't include such
+	// objects.
+	Schema *tfschema.Block
+
+	// Request is the operation's request. A hook may replace it before
+	// calling the next function in order to change the request that will
+	// be sent to the provider, but the replacement must have the same
+	// dynamic type.
+	Request interface{}
+
+	// Response is the operation's response, which is populated once the
+	// next function returns. A hook may replace it after next returns in
+	// order to change the result returned to the caller, but the
+	// replacement must have the same dynamic type.
+	Response interface{}
+
+	// Diagnostics are the diagnostics returned by the operation, which are
+	// populated once the next function returns. A hook may modify them.
+	Diagnostics Diagnostics
+
+	// Err is the error returned by the operations that report problems
+	// using Go errors instead of diagnostics, which are OpStart and OpClose.
+	Err error
+
+	// StartTime and Duration describe when the operation itself began and
+	// how long it took to run. They are populated once the next function
+	// returns, and don't include time spent in hooks.
+	StartTime time.Time
+	Duration  time.Duration
+}
+
+// Hook is the signature of a function that observes or modifies each of the
+// operations on a provider. Use [StartOptions] to register hooks.
+//
+// A hook should call next to run the remainder of the hook chain and then
+// the operation itself, passing either the given context or a context
+// derived from it. Once next returns, the Response, Diagnostics and timing
+// fields of op are populated.
+//
+// A hook may instead return without calling next, in which case the
+// operation doesn't run at all and the hook must itself populate the
+// Response and Diagnostics, or Err, to return to the caller.
+type Hook func(ctx context.Context, op *Operation, next func(ctx context.Context))
+
+// hookChain is an ordered sequence of hooks, where the first hook in the
+// sequence is the outermost.
+type hookChain []Hook
+
+func (hooks hookChain) run(ctx context.Context, op *Operation, call func(ctx context.Context, op *Operation)) {
+	var step func(i int) func(ctx context.Context)
+	step = func(i int) func(ctx context.Context) {
+		return func(ctx context.Context) {
+			if i < len(hooks) {
+				hooks[i](ctx, op, step(i+1))
+				return
+			}
+			op.StartTime = time.Now()
+			call(ctx, op)
+			op.Duration = time.Since(op.StartTime)
+		}
+	}
+	step(0)(ctx)
+}
+
+// operationRPCs maps each operation name to the name of the RPC that
+// implements it, for each supported protocol version.
+var operationRPCs = map[int]map[OperationName]string{
+	5: {
+		OpSchema:                         "GetSchema",
+		OpPrepareConfig:                  "PrepareProviderConfig",
+		OpConfigure:                      "Configure",
+		OpValidateManagedResourceConfig:  "ValidateResourceTypeConfig",
+		OpValidateDataResourceConfig:     "ValidateDataSourceConfig",
+		OpReadManagedResource:            "ReadResource",
+		OpPlanManagedResource:            "PlanResourceChange",
+		OpApplyManagedResource:           "ApplyResourceChange",
+		OpImportManagedResource:          "ImportResourceState",
+		OpMoveManagedResourceState:       "MoveResourceState",
+		OpUpgradeManagedResourceIdentity: "UpgradeResourceIdentity",
+		OpReadDataResource:               "ReadDataSource",
+		OpOpenEphemeralResource:          "OpenEphemeralResource",
+		OpRenewEphemeralResource:         "RenewEphemeralResource",
+		OpCloseEphemeralResource:         "CloseEphemeralResource",
+		OpValidateListResourceConfig:     "ValidateListResourceConfig",
+		OpListResource:                   "ListResource",
+		OpValidateActionConfig:           "ValidateActionConfig",
+		OpPlanAction:                     "PlanAction",
+		OpInvokeAction:                   "InvokeAction",
+		OpCallFunction:                   "CallFunction",
+		OpStop:                           "Stop",
+	},
+	6: {
+		OpSchema:                         "GetProviderSchema",
+		OpConfigure:                      "ConfigureProvider",
+		OpValidateManagedResourceConfig:  "ValidateResourceConfig",
+		OpValidateDataResourceConfig:     "ValidateDataResourceConfig",
+		OpReadManagedResource:            "ReadResource",
+		OpPlanManagedResource:            "PlanResourceChange",
+		OpApplyManagedResource:           "ApplyResourceChange",
+		OpImportManagedResource:          "ImportResourceState",
+		OpMoveManagedResourceState:       "MoveResourceState",
+		OpUpgradeManagedResourceIdentity: "UpgradeResourceIdentity",
+		OpReadDataResource:               "ReadDataSource",
+		OpOpenEphemeralResource:          "OpenEphemeralResource",
+		OpRenewEphemeralResource:         "RenewEphemeralResource",
+		OpCloseEphemeralResource:         "CloseEphemeralResource",
+		OpValidateListResourceConfig:     "ValidateListResourceConfig",
+		OpListResource:                   "ListResource",
+		OpValidateActionConfig:           "ValidateActionConfig",
+		OpPlanAction:                     "PlanAction",
+		OpInvokeAction:                   "InvokeAction",
+		OpCallFunction:                   "CallFunction",
+		OpStop:                           "StopProvider",
+	},
+}
+
+// hookedProvider is a wrapper around another Provider that passes each of
+// its operations through a hook chain.
+type hookedProvider struct {
+	provider        Provider
+	protocolVersion int
+	hooks           hookChain
+}
+
+var _ Provider = (*hookedProvider)(nil)
+var _ typeSchemaProvider = (*hookedProvider)(nil)
+
+func (p *hookedProvider) operation(name OperationName, typeName string) *Operation {
+	return &Operation{
+		Name:            name,
+		TypeName:        typeName,
+		ProtocolVersion: p.protocolVersion,
+		RPC:             operationRPCs[p.protocolVersion][name],
+	}
+}
+
+func (p *hookedProvider) Schema(ctx context.Context) (*Schema, Diagnostics) {
+	op := p.operation(OpSchema, "")
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = p.provider.Schema(ctx)
+	})
+	schema, _ := op.Response.(*Schema)
+	return schema, op.Diagnostics
+}
+
+func (p *hookedProvider) PrepareConfig(ctx context.Context, config cty.Value) (Config, Diagnostics) {
+	op := p.operation(OpPrepareConfig, "")
+	op.Schema = p.providerConfigSchema(ctx)
+	op.Request = config
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = p.provider.PrepareConfig(ctx, op.Request.(cty.Value))
+	})
+	prepared, _ := op.Response.(Config)
+	return prepared, op.Diagnostics
+}
+
+func (p *hookedProvider) Configure(ctx context.Context, config Config) Diagnostics {
+	op := p.operation(OpConfigure, "")
+	op.Schema = p.providerConfigSchema(ctx)
+	op.Request = config
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Diagnostics = p.provider.Configure(ctx, op.Request.(Config))
+	})
+	return op.Diagnostics
+}
+
+func (p *hookedProvider) ValidateManagedResourceConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics {
+	op := p.operation(OpValidateManagedResourceConfig, typeName)
+	op.Schema = p.managedResourceTypeSchema(ctx, typeName)
+	op.Request = config
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Diagnostics = p.provider.ValidateManagedResourceConfig(ctx, typeName, op.Request.(cty.Value))
+	})
+	return op.Diagnostics
+}
+
+func (p *hookedProvider) ValidateDataResourceConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics {
+	op := p.operation(OpValidateDataResourceConfig, typeName)
+	op.Schema = p.dataResourceTypeSchema(ctx, typeName)
+	op.Request = config
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Diagnostics = p.provider.ValidateDataResourceConfig(ctx, typeName, op.Request.(cty.Value))
+	})
+	return op.Diagnostics
+}
+
+func (p *hookedProvider) ValidateListResourceConfig(ctx context.Context, typeName string, req ListResourceValidateRequest) Diagnostics {
+	op := p.operation(OpValidateListResourceConfig, typeName)
+	op.Schema = p.listResourceTypeSchema(ctx, typeName)
+	op.Request = req
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Diagnostics = p.provider.ValidateListResourceConfig(ctx, typeName, op.Request.(ListResourceValidateRequest))
+	})
+	return op.Diagnostics
+}
+
+func (p *hookedProvider) ValidateActionConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics {
+	op := p.operation(OpValidateActionConfig, typeName)
+	op.Schema = p.actionTypeSchema(ctx, typeName)
+	op.Request = config
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Diagnostics = p.provider.ValidateActionConfig(ctx, typeName, op.Request.(cty.Value))
+	})
+	return op.Diagnostics
+}
+
+func (p *hookedProvider) ManagedResourceType(typeName string) ManagedResourceType {
+	rt := p.provider.ManagedResourceType(typeName)
+	if rt == nil {
+		return nil
+	}
+	return &hookedManagedResourceType{
+		rt:       rt,
+		provider: p,
+		typeName: typeName,
+	}
+}
+
+func (p *hookedProvider) DataResourceType(typeName string) DataResourceType {
+	rt := p.provider.DataResourceType(typeName)
+	if rt == nil {
+		return nil
+	}
+	return &hookedDataResourceType{
+		rt:       rt,
+		provider: p,
+		typeName: typeName,
+	}
+}
+
+func (p *hookedProvider) EphemeralResourceType(typeName string) EphemeralResourceType {
+	rt := p.provider.EphemeralResourceType(typeName)
+	if rt == nil {
+		return nil
+	}
+	return &hookedEphemeralResourceType{
+		rt:       rt,
+		provider: p,
+		typeName: typeName,
+	}
+}
+
+func (p *hookedProvider) ListResourceType(typeName string) ListResourceType {
+	rt := p.provider.ListResourceType(typeName)
+	if rt == nil {
+		return nil
+	}
+	return &hookedListResourceType{
+		rt:       rt,
+		provider: p,
+		typeName: typeName,
+	}
+}
+
+func (p *hookedProvider) ActionType(typeName string) ActionType {
+	at := p.provider.ActionType(typeName)
+	if at == nil {
+		return nil
+	}
+	return &hookedActionType{
+		at:       at,
+		provider: p,
+		typeName: typeName,
+	}
+}
+
+func (p *hookedProvider) OnClose(fn func()) (cancel func()) {
+	return onClose(p.provider, fn)
+}
+
+func (p *hookedProvider) CallFunction(ctx context.Context, name string, args []cty.Value) (cty.Value, Diagnostics) {
+	op := p.operation(OpCallFunction, name)
+	op.Request = args
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = p.provider.CallFunction(ctx, name, op.Request.([]cty.Value))
+	})
+	ret, ok := op.Response.(cty.Value)
+	if !ok {
+		ret = cty.DynamicVal
+	}
+	return ret, op.Diagnostics
+}
+
+func (p *hookedProvider) Capabilities() Capabilities {
+	return p.provider.Capabilities()
+}
+
+func (p *hookedProvider) Supports(feature Feature) bool {
+	return p.provider.Supports(feature)
+}
+
+func (p *hookedProvider) Stop(ctx context.Context) Diagnostics {
+	op := p.operation(OpStop, "")
+	p.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Diagnostics = p.provider.Stop(ctx)
+	})
+	return op.Diagnostics
+}
+
+func (p *hookedProvider) Close() error {
+	op := p.operation(OpClose, "")
+	p.hooks.run(context.Background(), op, func(ctx context.Context, op *Operation) {
+		op.Err = p.provider.Close()
+	})
+	return op.Err
+}
+
+func (p *hookedProvider) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// The following methods implement typeSchemaProvider without running any
+// hooks, just like the helpers below that the hooked operations use to find
+// the schema to put in each Operation.
+
+func (p *hookedProvider) ProviderConfigSchema(ctx context.Context) (*tfschema.Block, Diagnostics) {
+	return providerConfigSchema(ctx, p.provider)
+}
+
+func (p *hookedProvider) ManagedResourceTypeSchema(ctx context.Context, typeName string) (*ManagedResourceTypeSchema, Diagnostics) {
+	return managedResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *hookedProvider) DataResourceTypeSchema(ctx context.Context, typeName string) (*DataResourceTypeSchema, Diagnostics) {
+	return dataResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *hookedProvider) EphemeralResourceTypeSchema(ctx context.Context, typeName string) (*EphemeralResourceTypeSchema, Diagnostics) {
+	return ephemeralResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *hookedProvider) ListResourceTypeSchema(ctx context.Context, typeName string) (*ListResourceTypeSchema, Diagnostics) {
+	return listResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *hookedProvider) ActionTypeSchema(ctx context.Context, typeName string) (*ActionTypeSchema, Diagnostics) {
+	return actionTypeSchema(ctx, p.provider, typeName)
+}
+
+// providerConfigSchema returns the content schema to put in an Operation
+// for the provider configuration, or nil if it isn't available. The other
+// helpers below do the same for each kind of type, looking up only the one
+// type so that hooks don't defeat the provider's lazy schema decoding.
+func (p *hookedProvider) providerConfigSchema(ctx context.Context) *tfschema.Block {
+	schema, _ := providerConfigSchema(ctx, p.provider)
+	return schema
+}
+
+func (p *hookedProvider) managedResourceTypeSchema(ctx context.Context, typeName string) *tfschema.Block {
+	if rts, _ := managedResourceTypeSchema(ctx, p.provider, typeName); rts != nil {
+		return rts.Content
+	}
+	return nil
+}
+
+func (p *hookedProvider) dataResourceTypeSchema(ctx context.Context, typeName string) *tfschema.Block {
+	if rts, _ := dataResourceTypeSchema(ctx, p.provider, typeName); rts != nil {
+		return rts.Content
+	}
+	return nil
+}
+
+func (p *hookedProvider) ephemeralResourceTypeSchema(ctx context.Context, typeName string) *tfschema.Block {
+	if rts, _ := ephemeralResourceTypeSchema(ctx, p.provider, typeName); rts != nil {
+		return rts.Content
+	}
+	return nil
+}
+
+func (p *hookedProvider) listResourceTypeSchema(ctx context.Context, typeName string) *tfschema.Block {
+	if rts, _ := listResourceTypeSchema(ctx, p.provider, typeName); rts != nil {
+		return rts.Content
+	}
+	return nil
+}
+
+func (p *hookedProvider) actionTypeSchema(ctx context.Context, typeName string) *tfschema.Block {
+	if ats, _ := actionTypeSchema(ctx, p.provider, typeName); ats != nil {
+		return ats.Content
+	}
+	return nil
+}
+
+// hookedManagedResourceType is a wrapper around another ManagedResourceType
+// that passes each of its operations through the hook chain of the provider
+// it belongs to.
+type hookedManagedResourceType struct {
+	rt       ManagedResourceType
+	provider *hookedProvider
+	typeName string
+}
+
+var _ ManagedResourceType = (*hookedManagedResourceType)(nil)
+
+func (rt *hookedManagedResourceType) Read(ctx context.Context, req ManagedResourceReadRequest) (ManagedResourceReadResponse, Diagnostics) {
+	op := rt.provider.operation(OpReadManagedResource, rt.typeName)
+	op.Schema = rt.provider.managedResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.Read(ctx, op.Request.(ManagedResourceReadRequest))
+	})
+	resp, _ := op.Response.(ManagedResourceReadResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedManagedResourceType) Plan(ctx context.Context, req ManagedResourcePlanRequest) (ManagedResourcePlanResponse, Diagnostics) {
+	op := rt.provider.operation(OpPlanManagedResource, rt.typeName)
+	op.Schema = rt.provider.managedResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.Plan(ctx, op.Request.(ManagedResourcePlanRequest))
+	})
+	resp, _ := op.Response.(ManagedResourcePlanResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedManagedResourceType) Apply(ctx context.Context, req ManagedResourceApplyRequest) (ManagedResourceApplyResponse, Diagnostics) {
+	op := rt.provider.operation(OpApplyManagedResource, rt.typeName)
+	op.Schema = rt.provider.managedResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.Apply(ctx, op.Request.(ManagedResourceApplyRequest))
+	})
+	resp, _ := op.Response.(ManagedResourceApplyResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedManagedResourceType) Import(ctx context.Context, req ManagedResourceImportRequest) (ManagedResourceImportResponse, Diagnostics) {
+	op := rt.provider.operation(OpImportManagedResource, rt.typeName)
+	op.Schema = rt.provider.managedResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.Import(ctx, op.Request.(ManagedResourceImportRequest))
+	})
+	resp, _ := op.Response.(ManagedResourceImportResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedManagedResourceType) MoveState(ctx context.Context, req ManagedResourceMoveStateRequest) (ManagedResourceMoveStateResponse, Diagnostics) {
+	op := rt.provider.operation(OpMoveManagedResourceState, rt.typeName)
+	op.Schema = rt.provider.managedResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.MoveState(ctx, op.Request.(ManagedResourceMoveStateRequest))
+	})
+	resp, _ := op.Response.(ManagedResourceMoveStateResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedManagedResourceType) UpgradeIdentity(ctx context.Context, req ManagedResourceUpgradeIdentityRequest) (ManagedResourceUpgradeIdentityResponse, Diagnostics) {
+	op := rt.provider.operation(OpUpgradeManagedResourceIdentity, rt.typeName)
+	op.Schema = rt.provider.managedResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.UpgradeIdentity(ctx, op.Request.(ManagedResourceUpgradeIdentityRequest))
+	})
+	resp, _ := op.Response.(ManagedResourceUpgradeIdentityResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedManagedResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// hookedDataResourceType is a wrapper around another DataResourceType that
+// passes each of its operations through the hook chain of the provider it
+// belongs to.
+type hookedDataResourceType struct {
+	rt       DataResourceType
+	provider *hookedProvider
+	typeName string
+}
+
+var _ DataResourceType = (*hookedDataResourceType)(nil)
+
+func (rt *hookedDataResourceType) Read(ctx context.Context, req DataResourceReadRequest) (DataResourceReadResponse, Diagnostics) {
+	op := rt.provider.operation(OpReadDataResource, rt.typeName)
+	op.Schema = rt.provider.dataResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.Read(ctx, op.Request.(DataResourceReadRequest))
+	})
+	resp, _ := op.Response.(DataResourceReadResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedDataResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// hookedEphemeralResourceType is a wrapper around another
+// EphemeralResourceType that passes each of its operations through the
+// hook chain of the provider it belongs to.
+type hookedEphemeralResourceType struct {
+	rt       EphemeralResourceType
+	provider *hookedProvider
+	typeName string
+}
+
+var _ EphemeralResourceType = (*hookedEphemeralResourceType)(nil)
+
+func (rt *hookedEphemeralResourceType) Open(ctx context.Context, req EphemeralResourceOpenRequest) (EphemeralResourceOpenResponse, Diagnostics) {
+	op := rt.provider.operation(OpOpenEphemeralResource, rt.typeName)
+	op.Schema = rt.provider.ephemeralResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.Open(ctx, op.Request.(EphemeralResourceOpenRequest))
+	})
+	resp, _ := op.Response.(EphemeralResourceOpenResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedEphemeralResourceType) Renew(ctx context.Context, req EphemeralResourceRenewRequest) (EphemeralResourceRenewResponse, Diagnostics) {
+	op := rt.provider.operation(OpRenewEphemeralResource, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.Renew(ctx, op.Request.(EphemeralResourceRenewRequest))
+	})
+	resp, _ := op.Response.(EphemeralResourceRenewResponse)
+	return resp, op.Diagnostics
+}
+
+func (rt *hookedEphemeralResourceType) Close(ctx context.Context, req EphemeralResourceCloseRequest) Diagnostics {
+	op := rt.provider.operation(OpCloseEphemeralResource, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Diagnostics = rt.rt.Close(ctx, op.Request.(EphemeralResourceCloseRequest))
+	})
+	return op.Diagnostics
+}
+
+func (rt *hookedEphemeralResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// hookedListResourceType is a wrapper around another ListResourceType that
+// passes each of its operations through the hook chain of the provider it
+// belongs to.
+type hookedListResourceType struct {
+	rt       ListResourceType
+	provider *hookedProvider
+	typeName string
+}
+
+var _ ListResourceType = (*hookedListResourceType)(nil)
+
+func (rt *hookedListResourceType) List(ctx context.Context, req ListResourceRequest) (ListResourceResults, Diagnostics) {
+	op := rt.provider.operation(OpListResource, rt.typeName)
+	op.Schema = rt.provider.listResourceTypeSchema(ctx, rt.typeName)
+	op.Request = req
+	rt.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = rt.rt.List(ctx, op.Request.(ListResourceRequest))
+	})
+	results, _ := op.Response.(ListResourceResults)
+	return results, op.Diagnostics
+}
+
+func (rt *hookedListResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// hookedActionType is a wrapper around another ActionType that passes each
+// of its operations through the hook chain of the provider it belongs to.
+type hookedActionType struct {
+	at       ActionType
+	provider *hookedProvider
+	typeName string
+}
+
+var _ ActionType = (*hookedActionType)(nil)
+
+func (at *hookedActionType) Plan(ctx context.Context, req ActionPlanRequest) (ActionPlanResponse, Diagnostics) {
+	op := at.provider.operation(OpPlanAction, at.typeName)
+	op.Schema = at.provider.actionTypeSchema(ctx, at.typeName)
+	op.Request = req
+	at.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = at.at.Plan(ctx, op.Request.(ActionPlanRequest))
+	})
+	resp, _ := op.Response.(ActionPlanResponse)
+	return resp, op.Diagnostics
+}
+
+func (at *hookedActionType) Invoke(ctx context.Context, req ActionInvokeRequest) (ActionInvocation, Diagnostics) {
+	op := at.provider.operation(OpInvokeAction, at.typeName)
+	op.Schema = at.provider.actionTypeSchema(ctx, at.typeName)
+	op.Request = req
+	at.provider.hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		op.Response, op.Diagnostics = at.at.Invoke(ctx, op.Request.(ActionInvokeRequest))
+	})
+	inv, _ := op.Response.(ActionInvocation)
+	return inv, op.Diagnostics
+}
+
+func (at *hookedActionType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
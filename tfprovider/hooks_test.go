@@ -0,0 +1,158 @@
+package tfprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestHookedProviderSchemaLookups(t *testing.T) {
+	schema := testProviderSchema()
+
+	t.Run("lazy provider", func(t *testing.T) {
+		inner := &lazyFakeProvider{fakeProvider: fakeProvider{schema: schema}}
+		var ops []*Operation
+		p := &hookedProvider{
+			provider:        inner,
+			protocolVersion: 6,
+			hooks: hookChain{
+				func(ctx context.Context, op *Operation, next func(ctx context.Context)) {
+					ops = append(ops, op)
+					next(ctx)
+				},
+			},
+		}
+
+		ctx := context.Background()
+		p.PrepareConfig(ctx, cty.EmptyObjectVal)
+		p.ValidateManagedResourceConfig(ctx, "test_thing", cty.EmptyObjectVal)
+
+		if inner.schemaCalls != 0 {
+			t.Errorf("whole schema requested %d times; want 0", inner.schemaCalls)
+		}
+		if inner.typeSchemaCalls != 2 {
+			t.Errorf("single types requested %d times; want 2", inner.typeSchemaCalls)
+		}
+		if len(ops) != 2 {
+			t.Fatalf("hook saw %d operations; want 2", len(ops))
+		}
+		if got, want := ops[0].Schema, schema.ProviderConfig; got != want {
+			t.Errorf("wrong schema for %s", ops[0].Name)
+		}
+		if got, want := ops[1].Schema, schema.ManagedResourceTypes["test_thing"].Content; got != want {
+			t.Errorf("wrong schema for %s", ops[1].Name)
+		}
+		if got, want := ops[1].RPC, "ValidateResourceConfig"; got != want {
+			t.Errorf("wrong RPC %q; want %q", got, want)
+		}
+	})
+
+	t.Run("nested wrappers", func(t *testing.T) {
+		inner := &lazyFakeProvider{fakeProvider: fakeProvider{schema: schema}}
+		p := &hookedProvider{
+			provider: ReadOnly(&hookedProvider{provider: inner}),
+		}
+
+		got := p.managedResourceTypeSchema(context.Background(), "test_thing")
+		if want := schema.ManagedResourceTypes["test_thing"].Content; got != want {
+			t.Error("wrong schema for test_thing")
+		}
+		if inner.schemaCalls != 0 {
+			t.Errorf("whole schema requested %d times; want 0", inner.schemaCalls)
+		}
+	})
+
+	t.Run("other provider", func(t *testing.T) {
+		inner := &fakeProvider{schema: schema}
+		p := &hookedProvider{provider: inner}
+
+		got := p.managedResourceTypeSchema(context.Background(), "test_thing")
+		if want := schema.ManagedResourceTypes["test_thing"].Content; got != want {
+			t.Error("wrong schema for test_thing")
+		}
+		if got := p.managedResourceTypeSchema(context.Background(), "test_nonexist"); got != nil {
+			t.Error("unexpected schema for nonexistent type")
+		}
+		if inner.schemaCalls != 2 {
+			t.Errorf("whole schema requested %d times; want 2", inner.schemaCalls)
+		}
+	})
+}
+
+func TestHookChainOrder(t *testing.T) {
+	var calls []string
+	hook := func(name string) Hook {
+		return func(ctx context.Context, op *Operation, next func(ctx context.Context)) {
+			calls = append(calls, name+" before")
+			next(ctx)
+			calls = append(calls, name+" after")
+		}
+	}
+	hooks := hookChain{hook("outer"), hook("inner")}
+
+	op := &Operation{Name: OpStop}
+	hooks.run(context.Background(), op, func(ctx context.Context, op *Operation) {
+		calls = append(calls, "call")
+	})
+
+	want := []string{"outer before", "inner before", "call", "inner after", "outer after"}
+	if len(calls) != len(want) {
+		t.Fatalf("wrong calls %q; want %q", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("wrong calls %q; want %q", calls, want)
+		}
+	}
+	if op.StartTime.IsZero() {
+		t.Error("StartTime not set")
+	}
+}
+
+func TestHookShortCircuit(t *testing.T) {
+	inner := &fakeProvider{schema: testProviderSchema()}
+	p := &hookedProvider{
+		provider: inner,
+		hooks: hookChain{
+			func(ctx context.Context, op *Operation, next func(ctx context.Context)) {
+				op.Diagnostics = Diagnostics{
+					{Severity: Error, Summary: "Refused"},
+				}
+			},
+		},
+	}
+
+	diags := p.Configure(context.Background(), Config{Value: cty.EmptyObjectVal})
+	if !diags.HasErrors() || diags[0].Summary != "Refused" {
+		t.Errorf("wrong diagnostics %#v", diags)
+	}
+	if len(inner.calls) != 0 {
+		t.Errorf("provider called despite hook returning early: %q", inner.calls)
+	}
+}
+
+func TestOperationRPCs(t *testing.T) {
+	tests := []struct {
+		version int
+		op      OperationName
+		want    string
+	}{
+		{5, OpSchema, "GetSchema"},
+		{6, OpSchema, "GetProviderSchema"},
+		{5, OpConfigure, "Configure"},
+		{6, OpConfigure, "ConfigureProvider"},
+		{5, OpStop, "Stop"},
+		{6, OpStop, "StopProvider"},
+		// Protocol 6 has no RPC for preparing the configuration.
+		{6, OpPrepareConfig, ""},
+		// Closing the provider kills its process without any RPC.
+		{5, OpClose, ""},
+	}
+	for _, test := range tests {
+		p := &hookedProvider{protocolVersion: test.version}
+		if got := p.operation(test.op, "").RPC; got != test.want {
+			t.Errorf("wrong RPC for %s in protocol %d: got %q, want %q", test.op, test.version, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ProviderConfigAddr identifies a single configuration of a provider, in the
+// same way as a provider block in the Terraform language: a provider source
+// address along with an optional alias.
+type ProviderConfigAddr struct {
+	// Source is the fully-qualified source address of the provider, such
+	// as "registry.terraform.io/hashicorp/aws".
+	Source string
+
+	// Alias is the configuration alias, or the empty string to represent
+	// the provider's default configuration.
+	Alias string
+}
+
+func (a ProviderConfigAddr) String() string {
+	if a.Alias == "" {
+		return fmt.Sprintf("provider[%q]", a.Source)
+	}
+	return fmt.Sprintf("provider[%q].%s", a.Source, a.Alias)
+}
+
+// StartFunc is the signature of a function that starts a new instance of a
+// particular provider plugin, such as a closure that calls [Start] with
+// a fixed executable path.
+type StartFunc func(ctx context.Context) (Provider, error)
+
+// Host manages a set of provider instances on behalf of a caller that needs
+// to work with more than one provider, or more than one configuration of the
+// same provider, at once.
+//
+// Each distinct ProviderConfigAddr gets its own provider child process,
+// because each provider instance can be configured only once. The host
+// starts and configures each instance lazily on first use, using the
+// start function registered for its source address and the configuration
+// registered for its full address.
+//
+// A Host is safe for concurrent use. Call Close once the host is no longer
+// needed, to kill all of the child processes it started.
+type Host struct {
+	mu        sync.Mutex
+	starters  map[string]StartFunc
+	configs   map[ProviderConfigAddr]cty.Value
+	instances map[ProviderConfigAddr]*hostInstance
+	closed    bool
+}
+
+type hostInstance struct {
+	mu       sync.Mutex
+	provider Provider
+}
+
+// NewHost returns a new host with no providers registered.
+func NewHost() *Host {
+	return &Host{
+		starters:  make(map[string]StartFunc),
+		configs:   make(map[ProviderConfigAddr]cty.Value),
+		instances: make(map[ProviderConfigAddr]*hostInstance),
+	}
+}
+
+// AddProvider registers the function the host will use to start instances
+// of the provider with the given source address.
+//
+// Registering a new function for a source address that already has one
+// affects only instances started after the call.
+func (h *Host) AddProvider(source string, start StartFunc) {
+	h.mu.Lock()
+	h.starters[source] = start
+	h.mu.Unlock()
+}
+
+// AddConfig registers the configuration object that the host will use to
+// configure the provider instance with the given address.
+//
+// The configuration is used only when the host first starts the instance,
+// so it must be registered before any call that would use the instance.
+func (h *Host) AddConfig(addr ProviderConfigAddr, config cty.Value) {
+	h.mu.Lock()
+	h.configs[addr] = config
+	h.mu.Unlock()
+}
+
+// Provider returns the configured provider instance for the given address,
+// starting and configuring it first if the host hasn't already done so.
+//
+// The host retains ownership of the returned provider, so callers must not
+// call Close on it directly.
+func (h *Host) Provider(ctx context.Context, addr ProviderConfigAddr) (Provider, Diagnostics) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return nil, Diagnostics{
+			{
+				Severity: Error,
+				Summary:  "Provider host is closed",
+				Detail:   fmt.Sprintf("Cannot use %s because its provider host has already been closed.", addr),
+			},
+		}
+	}
+	start, ok := h.starters[addr.Source]
+	if !ok {
+		h.mu.Unlock()
+		return nil, Diagnostics{
+			{
+				Severity: Error,
+				Summary:  "Unknown provider",
+				Detail:   fmt.Sprintf("There is no provider registered with source address %q.", addr.Source),
+			},
+		}
+	}
+	config, ok := h.configs[addr]
+	if !ok {
+		h.mu.Unlock()
+		return nil, Diagnostics{
+			{
+				Severity: Error,
+				Summary:  "Missing provider configuration",
+				Detail:   fmt.Sprintf("There is no configuration registered for %s.", addr),
+			},
+		}
+	}
+	inst, ok := h.instances[addr]
+	if !ok {
+		inst = &hostInstance{}
+		h.instances[addr] = inst
+	}
+	h.mu.Unlock()
+
+	// We hold only the instance's own lock while starting, so that
+	// instances with different addresses can start concurrently.
+	inst.mu.Lock()
+	defer inst.mu.Unlock()
+	if inst.provider != nil {
+		return inst.provider, nil
+	}
+
+	provider, err := start(ctx)
+	if err != nil {
+		return nil, Diagnostics{
+			{
+				Severity: Error,
+				Summary:  "Failed to start provider",
+				Detail:   fmt.Sprintf("Cannot start the plugin for %s: %s.", addr, err),
+			},
+		}
+	}
+
+	prepared, diags := provider.PrepareConfig(ctx, config)
+	if diags.HasErrors() {
+		provider.Close()
+		return nil, diags
+	}
+	diags = append(diags, provider.Configure(ctx, prepared)...)
+	if diags.HasErrors() {
+		provider.Close()
+		return nil, diags
+	}
+
+	// The host might've been closed while we were starting, in which case
+	// Close will not have seen our new provider and so we must clean it
+	// up ourselves.
+	h.mu.Lock()
+	closed := h.closed
+	h.mu.Unlock()
+	if closed {
+		provider.Close()
+		return nil, append(diags, Diagnostic{
+			Severity: Error,
+			Summary:  "Provider host is closed",
+			Detail:   fmt.Sprintf("The provider host was closed while starting %s.", addr),
+		})
+	}
+
+	inst.provider = provider
+	return provider, diags
+}
+
+// ManagedResourceType returns an object representing the given managed
+// resource type in the provider instance with the given address, starting
+// and configuring the provider first if necessary.
+func (h *Host) ManagedResourceType(ctx context.Context, addr ProviderConfigAddr, typeName string) (ManagedResourceType, Diagnostics) {
+	provider, diags := h.Provider(ctx, addr)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	rt := provider.ManagedResourceType(typeName)
+	if rt == nil {
+		// The type might be missing only because the provider's schema
+		// couldn't be retrieved, in which case we report why.
+		if _, moreDiags := managedResourceTypeSchema(ctx, provider, typeName); moreDiags.HasErrors() {
+			return nil, append(diags, moreDiags...)
+		}
+		diags = append(diags, Diagnostic{
+			Severity: Error,
+			Summary:  "Unsupported resource type",
+			Detail:   fmt.Sprintf("The provider for %s does not support managed resource type %q.", addr, typeName),
+			Cause:    ErrUnknownType,
+		})
+		return nil, diags
+	}
+	return rt, diags
+}
+
+// DataResourceType returns an object representing the given data resource
+// type in the provider instance with the given address, starting and
+// configuring the provider first if necessary.
+func (h *Host) DataResourceType(ctx context.Context, addr ProviderConfigAddr, typeName string) (DataResourceType, Diagnostics) {
+	provider, diags := h.Provider(ctx, addr)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	rt := provider.DataResourceType(typeName)
+	if rt == nil {
+		// The type might be missing only because the provider's schema
+		// couldn't be retrieved, in which case we report why.
+		if _, moreDiags := dataResourceTypeSchema(ctx, provider, typeName); moreDiags.HasErrors() {
+			return nil, append(diags, moreDiags...)
+		}
+		diags = append(diags, Diagnostic{
+			Severity: Error,
+			Summary:  "Unsupported data source",
+			Detail:   fmt.Sprintf("The provider for %s does not support data source %q.", addr, typeName),
+			Cause:    ErrUnknownType,
+		})
+		return nil, diags
+	}
+	return rt, diags
+}
+
+// Close kills the child processes of all of the provider instances the host
+// has started, rendering the host and all of the objects it has returned
+// unusable.
+//
+// Close closes the providers in order of their addresses' string forms. If
+// more than one provider fails to close, Close returns only the error from
+// the first of them in that order.
+func (h *Host) Close() error {
+	h.mu.Lock()
+	h.closed = true
+	instances := h.instances
+	h.instances = make(map[ProviderConfigAddr]*hostInstance)
+	h.mu.Unlock()
+
+	addrs := make([]ProviderConfigAddr, 0, len(instances))
+	for addr := range instances {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].String() < addrs[j].String()
+	})
+
+	var firstErr error
+	for _, addr := range addrs {
+		inst := instances[addr]
+		inst.mu.Lock()
+		if inst.provider != nil {
+			if err := inst.provider.Close(); err != nil && firstErr == nil {
+				firstErr = fmt.Errorf("failed to close %s: %s", addr, err)
+			}
+			inst.provider = nil
+		}
+		inst.mu.Unlock()
+	}
+	return firstErr
+}
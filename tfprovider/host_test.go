@@ -0,0 +1,169 @@
+package tfprovider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestHostProvider(t *testing.T) {
+	h := NewHost()
+	addr := ProviderConfigAddr{Source: "example.com/test/test"}
+
+	var mu sync.Mutex
+	var started []*fakeProvider
+	h.AddProvider(addr.Source, func(ctx context.Context) (Provider, error) {
+		p := &fakeProvider{schema: testProviderSchema()}
+		mu.Lock()
+		started = append(started, p)
+		mu.Unlock()
+		return p, nil
+	})
+	h.AddConfig(addr, cty.EmptyObjectVal)
+
+	// Concurrent callers must share a single instance.
+	var wg sync.WaitGroup
+	providers := make([]Provider, 8)
+	for i := range providers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			p, diags := h.Provider(context.Background(), addr)
+			if diags.HasErrors() {
+				t.Errorf("unexpected errors: %s", diags.Err())
+			}
+			providers[i] = p
+		}(i)
+	}
+	wg.Wait()
+
+	if len(started) != 1 {
+		t.Fatalf("started %d instances; want 1", len(started))
+	}
+	for i, p := range providers {
+		if p != started[0] {
+			t.Errorf("caller %d got a different provider", i)
+		}
+	}
+	if got, want := strings.Join(started[0].calls, ","), "PrepareConfig,Configure"; got != want {
+		t.Errorf("wrong calls %q; want %q", got, want)
+	}
+
+	// A different alias gets its own instance.
+	aliased := ProviderConfigAddr{Source: addr.Source, Alias: "other"}
+	h.AddConfig(aliased, cty.EmptyObjectVal)
+	if _, diags := h.Provider(context.Background(), aliased); diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if len(started) != 2 {
+		t.Errorf("started %d instances; want 2", len(started))
+	}
+
+	if err := h.Close(); err != nil {
+		t.Fatalf("unexpected error from Close: %s", err)
+	}
+	for i, p := range started {
+		if calls := p.calls; calls[len(calls)-1] != "Close" {
+			t.Errorf("instance %d not closed", i)
+		}
+	}
+	if _, diags := h.Provider(context.Background(), addr); !diags.HasErrors() {
+		t.Error("closed host returned a provider")
+	}
+}
+
+func TestHostProviderErrors(t *testing.T) {
+	h := NewHost()
+	h.AddProvider("example.com/test/broken", func(ctx context.Context) (Provider, error) {
+		return nil, errors.New("no such file")
+	})
+	h.AddConfig(ProviderConfigAddr{Source: "example.com/test/broken"}, cty.EmptyObjectVal)
+	h.AddConfig(ProviderConfigAddr{Source: "example.com/test/unknown"}, cty.EmptyObjectVal)
+	h.AddProvider("example.com/test/unconfigured", func(ctx context.Context) (Provider, error) {
+		t.Error("unconfigured provider started")
+		return nil, nil
+	})
+
+	tests := map[string]string{
+		"example.com/test/broken":       "Failed to start provider",
+		"example.com/test/unknown":      "Unknown provider",
+		"example.com/test/unconfigured": "Missing provider configuration",
+	}
+	for source, want := range tests {
+		t.Run(source, func(t *testing.T) {
+			_, diags := h.Provider(context.Background(), ProviderConfigAddr{Source: source})
+			if !diags.HasErrors() {
+				t.Fatal("unexpected success")
+			}
+			if got := diags[0].Summary; got != want {
+				t.Errorf("wrong summary %q; want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestHostManagedResourceType(t *testing.T) {
+	addr := ProviderConfigAddr{Source: "example.com/test/test"}
+	newHost := func(p Provider) *Host {
+		h := NewHost()
+		h.AddProvider(addr.Source, func(ctx context.Context) (Provider, error) {
+			return p, nil
+		})
+		h.AddConfig(addr, cty.EmptyObjectVal)
+		return h
+	}
+
+	t.Run("unknown type", func(t *testing.T) {
+		h := newHost(&lazyFakeProvider{fakeProvider: fakeProvider{schema: testProviderSchema()}})
+		_, diags := h.ManagedResourceType(context.Background(), addr, "test_nonexist")
+		if !errors.Is(diags.Err(), ErrUnknownType) {
+			t.Errorf("wrong error %v; want ErrUnknownType", diags.Err())
+		}
+	})
+
+	t.Run("schema unavailable", func(t *testing.T) {
+		h := newHost(&lazyFakeProvider{
+			fakeProvider: fakeProvider{schema: testProviderSchema()},
+			schemaDiags: Diagnostics{
+				{Severity: Error, Summary: "Failed to retrieve provider schema"},
+			},
+		})
+		_, diags := h.ManagedResourceType(context.Background(), addr, "test_thing")
+		if !diags.HasErrors() {
+			t.Fatal("unexpected success")
+		}
+		if errors.Is(diags.Err(), ErrUnknownType) {
+			t.Error("schema failure reported as an unknown type")
+		}
+		if got, want := diags[0].Summary, "Failed to retrieve provider schema"; got != want {
+			t.Errorf("wrong summary %q; want %q", got, want)
+		}
+	})
+}
+
+func TestHostCloseErrors(t *testing.T) {
+	h := NewHost()
+	for _, source := range []string{"example.com/test/c", "example.com/test/a", "example.com/test/b"} {
+		source := source
+		h.AddProvider(source, func(ctx context.Context) (Provider, error) {
+			return &fakeProvider{closeErr: errors.New(source + " is stuck")}, nil
+		})
+		addr := ProviderConfigAddr{Source: source}
+		h.AddConfig(addr, cty.EmptyObjectVal)
+		if _, diags := h.Provider(context.Background(), addr); diags.HasErrors() {
+			t.Fatalf("unexpected errors: %s", diags.Err())
+		}
+	}
+
+	err := h.Close()
+	if err == nil {
+		t.Fatal("unexpected success")
+	}
+	if want := `failed to close provider["example.com/test/a"]: example.com/test/a is stuck`; err.Error() != want {
+		t.Errorf("wrong error\ngot:  %s\nwant: %s", err, want)
+	}
+}
@@ -0,0 +1,28 @@
+package common
+
+// Capabilities describes the plugin protocol version that a provider uses
+// and the optional protocol behaviors that it has opted in to.
+type Capabilities struct {
+	// ProtocolVersion is the major version of the plugin protocol that was
+	// negotiated with the provider. The protocol doesn't report which
+	// minor version the provider implements; the capability flags below
+	// are the only way to detect the newer behaviors.
+	ProtocolVersion int
+
+	// PlanDestroy means that the provider expects to be asked to plan the
+	// destruction of its objects. Other providers don't receive destroy
+	// plans, and ManagedResourceType.Plan plans them without calling the
+	// provider.
+	PlanDestroy bool
+
+	// GetProviderSchemaOptional means that the provider doesn't require
+	// its schema to be requested before other calls, so that a caller can
+	// use a cached copy of the schema instead. This module retrieves the
+	// schema of such a provider only when an operation first needs it.
+	GetProviderSchemaOptional bool
+
+	// MoveResourceState means that the provider can convert objects of
+	// other resource types into objects of its own managed resource types,
+	// using ManagedResourceType.MoveState.
+	MoveResourceState bool
+}
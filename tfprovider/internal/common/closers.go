@@ -0,0 +1,64 @@
+package common
+
+import (
+	"sync"
+)
+
+// Closers is a set of functions to call when a provider closes, so that
+// objects which depend on the provider, such as open ephemeral resources,
+// can release their remote objects first.
+//
+// The zero value of Closers is an empty set, ready to use.
+type Closers struct {
+	mu      sync.Mutex
+	closers map[*closer]struct{}
+	closed  bool
+}
+
+type closer struct {
+	fn func()
+}
+
+// Add adds the given function to the set, returning a function that removes
+// it again. If the set has already been closed, Add calls the given
+// function immediately instead.
+func (c *Closers) Add(fn func()) (remove func()) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		fn()
+		return func() {}
+	}
+	cl := &closer{fn}
+	if c.closers == nil {
+		c.closers = make(map[*closer]struct{})
+	}
+	c.closers[cl] = struct{}{}
+	c.mu.Unlock()
+
+	return func() {
+		c.mu.Lock()
+		delete(c.closers, cl)
+		c.mu.Unlock()
+	}
+}
+
+// Close calls all of the functions in the set concurrently, and waits for
+// them to return. Any later call to Add calls its function immediately.
+func (c *Closers) Close() {
+	c.mu.Lock()
+	c.closed = true
+	closers := c.closers
+	c.closers = nil
+	c.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for cl := range closers {
+		wg.Add(1)
+		go func(fn func()) {
+			defer wg.Done()
+			fn()
+		}(cl.fn)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestClosers(t *testing.T) {
+	var c Closers
+	var mu sync.Mutex
+	var called []string
+	record := func(name string) func() {
+		return func() {
+			mu.Lock()
+			called = append(called, name)
+			mu.Unlock()
+		}
+	}
+
+	c.Add(record("a"))
+	remove := c.Add(record("b"))
+	c.Add(record("c"))
+	remove()
+
+	c.Close()
+	if len(called) != 2 {
+		t.Fatalf("wrong calls %q; want a and c", called)
+	}
+	for _, name := range called {
+		if name == "b" {
+			t.Error("removed function was called")
+		}
+	}
+
+	// After Close, Add calls the function immediately.
+	called = nil
+	c.Add(record("d"))
+	if len(called) != 1 || called[0] != "d" {
+		t.Errorf("wrong calls after Close %q; want d", called)
+	}
+
+	// Closing again doesn't call the functions again.
+	called = nil
+	c.Close()
+	if len(called) != 0 {
+		t.Errorf("second Close called %q", called)
+	}
+}
@@ -8,4 +8,10 @@ import (
 // using Provider.PrepareConfig, ready to be passed to Configure.
 type Config struct {
 	Value cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle, which the provider may use to decide how to
+	// respond to later requests. PrepareConfig returns a Config with none
+	// of the capabilities set, so set them before calling Configure.
+	ClientCapabilities ClientCapabilities
 }
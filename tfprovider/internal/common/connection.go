@@ -0,0 +1,149 @@
+package common
+
+import (
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// Transport identifies the kind of network connection used to communicate
+// with a provider plugin.
+type Transport string
+
+const (
+	// TransportAny accepts whichever transport the provider plugin chooses.
+	TransportAny Transport = ""
+
+	// TransportUnix is a Unix domain socket.
+	TransportUnix Transport = "unix"
+
+	// TransportTCP is a TCP connection to a loopback address.
+	TransportTCP Transport = "tcp"
+)
+
+// ConnectionPolicy describes requirements for the gRPC connection to a
+// provider plugin.
+//
+// The provider plugin chooses its transport and whether to use TLS during
+// the plugin handshake, and this package has no way to ask it to choose
+// differently. RequireTransport and RequireServerTLS therefore don't select
+// how the connection is made. Instead, they are checked once the connection
+// is made, using a probe call that carries no configuration or state, and
+// the launch fails if the probe shows that the connection doesn't meet them.
+// The connection is also checked after each later call.
+//
+// The zero value of ConnectionPolicy accepts any connection and uses the
+// default gRPC message size limits.
+type ConnectionPolicy struct {
+	// MaxRecvMsgSize is the maximum size in bytes of a message received
+	// from the provider. Zero means the gRPC default of 4MiB, which is too
+	// small for some providers with very large schemas or states.
+	MaxRecvMsgSize int
+
+	// MaxSendMsgSize is the maximum size in bytes of a message sent to the
+	// provider. Zero means the gRPC default, which is effectively unlimited.
+	MaxSendMsgSize int
+
+	// RequireTransport is the transport that the provider plugin must have
+	// chosen for the connection. The default, TransportAny, accepts any
+	// transport.
+	RequireTransport Transport
+
+	// RequireServerTLS, if set, requires the provider plugin to have
+	// secured the connection using TLS, as negotiated automatically during
+	// the plugin handshake, presenting a certificate of its own.
+	//
+	// The handshake also gives the provider a certificate to expect from
+	// this process, but whether the provider actually verifies it, making
+	// the connection mutual TLS, can't be observed from this side of the
+	// connection. RequireServerTLS therefore guarantees that calls are
+	// encrypted, but not that the provider authenticates its caller.
+	RequireServerTLS bool
+}
+
+// callOptions returns the gRPC call options to use for each call under the
+// policy, which populate the given peer if the policy must check it.
+func (p *ConnectionPolicy) callOptions(pr *peer.Peer) []grpc.CallOption {
+	var opts []grpc.CallOption
+	if p.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxCallRecvMsgSize(p.MaxRecvMsgSize))
+	}
+	if p.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxCallSendMsgSize(p.MaxSendMsgSize))
+	}
+	if p.checksPeer() {
+		opts = append(opts, grpc.Peer(pr))
+	}
+	return opts
+}
+
+// checkProbe returns an error if the given peer, populated by a probe call
+// that returned the given error, doesn't meet the policy's requirements.
+//
+// Unlike checkPeer, checkProbe fails if the probe didn't reach the provider,
+// because then the connection can't be verified at all.
+func (p *ConnectionPolicy) checkProbe(pr *peer.Peer, err error) error {
+	if pr.Addr == nil {
+		if err == nil {
+			err = fmt.Errorf("no peer information available")
+		}
+		return grpcStatus.Errorf(codes.FailedPrecondition, "failed to verify provider plugin connection: %s", grpcStatus.Convert(err).Message())
+	}
+	return p.checkPeer(pr)
+}
+
+func (p *ConnectionPolicy) checksPeer() bool {
+	return p.RequireTransport != TransportAny || p.RequireServerTLS
+}
+
+// checkPeer returns an error if the given peer doesn't meet the policy's
+// requirements.
+func (p *ConnectionPolicy) checkPeer(pr *peer.Peer) error {
+	if pr.Addr == nil {
+		// The call failed before reaching the provider, so there's nothing
+		// to check.
+		return nil
+	}
+
+	switch p.RequireTransport {
+	case TransportAny:
+	case TransportUnix:
+		if network := pr.Addr.Network(); network != "unix" {
+			return grpcStatus.Errorf(codes.FailedPrecondition, "provider plugin connection uses transport %q, but a Unix socket is required", network)
+		}
+	case TransportTCP:
+		if network := pr.Addr.Network(); network != "tcp" {
+			return grpcStatus.Errorf(codes.FailedPrecondition, "provider plugin connection uses transport %q, but TCP is required", network)
+		}
+		if !isLoopbackAddr(pr.Addr) {
+			return grpcStatus.Errorf(codes.FailedPrecondition, "provider plugin connection is to %s, which is not a loopback address", pr.Addr)
+		}
+	default:
+		return fmt.Errorf("unsupported transport %q", p.RequireTransport)
+	}
+
+	if p.RequireServerTLS {
+		info, ok := pr.AuthInfo.(credentials.TLSInfo)
+		if !ok || !info.State.HandshakeComplete {
+			return grpcStatus.Errorf(codes.FailedPrecondition, "provider plugin connection is not using TLS, but TLS is required")
+		}
+		if len(info.State.PeerCertificates) == 0 {
+			return grpcStatus.Errorf(codes.FailedPrecondition, "provider plugin did not present a TLS certificate, but TLS is required")
+		}
+	}
+	return nil
+}
+
+func isLoopbackAddr(addr net.Addr) bool {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
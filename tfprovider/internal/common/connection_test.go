@@ -0,0 +1,169 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestConnectionPolicyCheckPeer(t *testing.T) {
+	unixAddr := &net.UnixAddr{Name: "/tmp/plugin.sock", Net: "unix"}
+	loopbackAddr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+	remoteAddr := &net.TCPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 1234}
+	tlsInfo := credentials.TLSInfo{
+		State: tls.ConnectionState{
+			HandshakeComplete: true,
+			PeerCertificates:  []*x509.Certificate{{}},
+		},
+	}
+	noCertInfo := credentials.TLSInfo{
+		State: tls.ConnectionState{
+			HandshakeComplete: true,
+		},
+	}
+
+	tests := map[string]struct {
+		policy ConnectionPolicy
+		peer   peer.Peer
+		ok     bool
+	}{
+		"any transport": {
+			policy: ConnectionPolicy{},
+			peer:   peer.Peer{Addr: remoteAddr},
+			ok:     true,
+		},
+		"unix required, unix used": {
+			policy: ConnectionPolicy{RequireTransport: TransportUnix},
+			peer:   peer.Peer{Addr: unixAddr},
+			ok:     true,
+		},
+		"unix required, tcp used": {
+			policy: ConnectionPolicy{RequireTransport: TransportUnix},
+			peer:   peer.Peer{Addr: loopbackAddr},
+			ok:     false,
+		},
+		"tcp required, loopback used": {
+			policy: ConnectionPolicy{RequireTransport: TransportTCP},
+			peer:   peer.Peer{Addr: loopbackAddr},
+			ok:     true,
+		},
+		"tcp required, non-loopback used": {
+			policy: ConnectionPolicy{RequireTransport: TransportTCP},
+			peer:   peer.Peer{Addr: remoteAddr},
+			ok:     false,
+		},
+		"tls required, tls used": {
+			policy: ConnectionPolicy{RequireServerTLS: true},
+			peer:   peer.Peer{Addr: unixAddr, AuthInfo: tlsInfo},
+			ok:     true,
+		},
+		"tls required, no tls": {
+			policy: ConnectionPolicy{RequireServerTLS: true},
+			peer:   peer.Peer{Addr: unixAddr},
+			ok:     false,
+		},
+		"tls required, no certificate": {
+			policy: ConnectionPolicy{RequireServerTLS: true},
+			peer:   peer.Peer{Addr: unixAddr, AuthInfo: noCertInfo},
+			ok:     false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.policy.checkPeer(&test.peer)
+			if test.ok && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if !test.ok && err == nil {
+				t.Error("unexpected success")
+			}
+		})
+	}
+}
+
+func TestCallRunnerCheckConnection(t *testing.T) {
+	t.Run("no requirements", func(t *testing.T) {
+		r := NewCallRunner(nil, nil)
+		err := r.CheckConnection(context.Background(), func(ctx context.Context, opts []grpc.CallOption) error {
+			t.Error("probe called with no requirements to check")
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("probe over real connection", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "tfprovider-test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		sockPath := filepath.Join(dir, "plugin.sock")
+		l, err := net.Listen("unix", sockPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		server := grpc.NewServer()
+		healthpb.RegisterHealthServer(server, health.NewServer())
+		go server.Serve(l)
+		defer server.Stop()
+
+		conn, err := grpc.Dial(
+			"passthrough:///plugin",
+			grpc.WithInsecure(),
+			grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", sockPath)
+			}),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		client := healthpb.NewHealthClient(conn)
+		probe := func(ctx context.Context, opts []grpc.CallOption) error {
+			_, err := client.Check(ctx, &healthpb.HealthCheckRequest{}, opts...)
+			return err
+		}
+
+		r := NewCallRunner(nil, &ConnectionPolicy{RequireTransport: TransportUnix})
+		if err := r.CheckConnection(context.Background(), probe); err != nil {
+			t.Errorf("unexpected error for Unix transport: %s", err)
+		}
+
+		r = NewCallRunner(nil, &ConnectionPolicy{RequireTransport: TransportTCP})
+		if err := r.CheckConnection(context.Background(), probe); grpcStatus.Code(err) != codes.FailedPrecondition {
+			t.Errorf("wrong error %v for TCP transport; want FailedPrecondition", err)
+		}
+
+		r = NewCallRunner(nil, &ConnectionPolicy{RequireServerTLS: true})
+		if err := r.CheckConnection(context.Background(), probe); grpcStatus.Code(err) != codes.FailedPrecondition {
+			t.Errorf("wrong error %v for required TLS; want FailedPrecondition", err)
+		}
+	})
+
+	t.Run("probe did not reach provider", func(t *testing.T) {
+		r := NewCallRunner(nil, &ConnectionPolicy{RequireTransport: TransportUnix})
+		err := r.CheckConnection(context.Background(), func(ctx context.Context, opts []grpc.CallOption) error {
+			return grpcStatus.Error(codes.Unavailable, "connection refused")
+		})
+		if grpcStatus.Code(err) != codes.FailedPrecondition {
+			t.Errorf("wrong error %v; want FailedPrecondition", err)
+		}
+	})
+}
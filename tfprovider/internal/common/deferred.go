@@ -0,0 +1,69 @@
+package common
+
+// ClientCapabilities describes optional protocol behaviors that the caller
+// is able to handle, which are sent to the provider with a request.
+//
+// The zero value of ClientCapabilities announces none of the optional
+// behaviors, which is correct for callers that don't check for them.
+type ClientCapabilities struct {
+	// DeferralAllowed means that the caller can handle a response that
+	// defers the action, as described by the Deferred field of the
+	// response, instead of a final result.
+	DeferralAllowed bool
+
+	// WriteOnlyAttributesAllowed means that the caller can handle
+	// write-only attributes in managed resource types. This package always
+	// announces it when validating configuration, because it removes
+	// write-only attributes from the results of Apply itself.
+	WriteOnlyAttributesAllowed bool
+}
+
+// DeferralReason is the reason that a provider gave for deferring an
+// action.
+type DeferralReason string
+
+const (
+	// DeferralReasonUnknown means that the provider didn't give a reason
+	// that this package recognizes.
+	DeferralReasonUnknown DeferralReason = ""
+
+	// DeferralReasonResourceConfigUnknown means that the configuration of
+	// the resource is only partially known, and the provider needs more of
+	// it to be known before it can complete the action.
+	DeferralReasonResourceConfigUnknown DeferralReason = "ResourceConfigUnknown"
+
+	// DeferralReasonProviderConfigUnknown means that the provider
+	// configuration was only partially known, so the provider can't yet
+	// complete actions that depend on it.
+	DeferralReasonProviderConfigUnknown DeferralReason = "ProviderConfigUnknown"
+
+	// DeferralReasonAbsentPrerequisite means that something that the
+	// action depends on doesn't exist yet.
+	DeferralReasonAbsentPrerequisite DeferralReason = "AbsentPrerequisite"
+)
+
+// Deferred describes a provider's decision to defer an action until more
+// information is available, instead of returning a final result.
+//
+// Providers only defer actions for requests whose ClientCapabilities have
+// DeferralAllowed set. When an action is deferred, any result values in the
+// response are only a prediction, and may be partially or wholly unknown.
+type Deferred struct {
+	Reason DeferralReason
+}
+
+// DeferralDiagnostics returns an error diagnostic if the given deferral,
+// which may be nil, was not allowed by the given client capabilities.
+func DeferralDiagnostics(deferred *Deferred, caps ClientCapabilities) Diagnostics {
+	if deferred == nil || caps.DeferralAllowed {
+		return nil
+	}
+	return Diagnostics{
+		{
+			Severity: Error,
+			Summary:  "Provider deferred action unexpectedly",
+			Detail:   "The provider deferred the action, even though the request did not allow deferral. This is a bug in the provider.",
+			Cause:    ErrInvalidResponse,
+		},
+	}
+}
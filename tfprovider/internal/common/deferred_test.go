@@ -0,0 +1,25 @@
+package common
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeferralDiagnostics(t *testing.T) {
+	deferred := &Deferred{Reason: DeferralReasonResourceConfigUnknown}
+
+	if diags := DeferralDiagnostics(nil, ClientCapabilities{}); diags != nil {
+		t.Errorf("unexpected diagnostics without a deferral: %#v", diags)
+	}
+	if diags := DeferralDiagnostics(deferred, ClientCapabilities{DeferralAllowed: true}); diags != nil {
+		t.Errorf("unexpected diagnostics for an allowed deferral: %#v", diags)
+	}
+
+	diags := DeferralDiagnostics(deferred, ClientCapabilities{})
+	if !diags.HasErrors() {
+		t.Fatal("no error for a deferral that wasn't allowed")
+	}
+	if !errors.Is(diags.Err(), ErrInvalidResponse) {
+		t.Error("error doesn't match ErrInvalidResponse")
+	}
+}
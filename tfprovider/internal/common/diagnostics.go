@@ -1,9 +1,11 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
+	"google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
 
 	"github.com/zclconf/go-cty/cty"
@@ -16,6 +18,12 @@ type Diagnostic struct {
 	Summary   string
 	Detail    string
 	Attribute cty.Path
+
+	// Cause is the error that caused the diagnostic, if any, for callers
+	// that need to distinguish between different kinds of failure using
+	// errors.Is or errors.As. It is usually either one of the Err...
+	// sentinel errors or an *RPCError, possibly wrapping another error.
+	Cause error
 }
 
 type DiagnosticSeverity rune
@@ -39,55 +47,78 @@ func RPCErrorDiagnostics(err error) Diagnostics {
 		return nil
 	}
 	var diags Diagnostics
+	if rpcErr, ok := err.(*RPCError); ok && rpcErr.Code == codes.Unimplemented {
+		// Calls that fail because the provider doesn't implement them are
+		// common enough during protocol upgrades that we give them their
+		// own message.
+		op := "this operation"
+		if rpcErr.RPC != "" {
+			op = fmt.Sprintf("the %s operation", rpcErr.RPC)
+		}
+		diags = append(diags, Diagnostic{
+			Severity: Error,
+			Summary:  "Operation not supported by this provider version",
+			Detail:   fmt.Sprintf("The provider does not support %s, probably because it was built for an older version of the plugin protocol. A newer version of the provider may support it.", op),
+			Cause:    rpcErr,
+		})
+		return diags
+	}
 	status, ok := grpcStatus.FromError(err)
 	if !ok {
 		diags = append(diags, Diagnostic{
 			Severity: Error,
 			Summary:  "Failed to call provider plugin",
 			Detail:   fmt.Sprintf("Provider RPC call failed: %s.", err),
+			Cause:    err,
 		})
 	} else {
+		rpc := ""
+		if rpcErr, ok := err.(*RPCError); ok {
+			rpc = rpcErr.RPC
+		}
+		detail := fmt.Sprintf("Provider returned RPC error %s: %s.", status.Code(), status.Message())
+		if rpc != "" {
+			detail = fmt.Sprintf("Provider returned RPC error %s from %s: %s.", status.Code(), rpc, status.Message())
+		}
 		diags = append(diags, Diagnostic{
 			Severity: Error,
 			Summary:  "Failed to call provider plugin",
-			Detail:   fmt.Sprintf("Provider returned RPC error %s: %s.", status.Code(), status.Message()),
+			Detail:   detail,
+			Cause: &RPCError{
+				RPC:     rpc,
+				Code:    status.Code(),
+				Message: status.Message(),
+			},
 		})
 	}
 	return diags
 }
 
 func ErrorDiagnostics(summary, detailPrefix string, err error) Diagnostics {
-	switch err := err.(type) {
-	case nil:
+	if err == nil {
 		return nil
-	case cty.PathError:
-		return Diagnostics{
-			{
-				Severity:  Error,
-				Summary:   summary,
-				Detail:    fmt.Sprintf("%s: %s.", detailPrefix, err.Error()),
-				Attribute: err.Path,
-			},
-		}
-	default:
-		return Diagnostics{
-			{
-				Severity: Error,
-				Summary:  summary,
-				Detail:   fmt.Sprintf("%s: %s.", detailPrefix, err.Error()),
-			},
-		}
 	}
-
+	diag := Diagnostic{
+		Severity: Error,
+		Summary:  summary,
+		Detail:   fmt.Sprintf("%s: %s.", detailPrefix, err.Error()),
+		Cause:    err,
+	}
+	// The path error may be wrapped, such as by KindError, so we must
+	// look for it in the whole chain rather than only at the top.
+	var pathErr cty.PathError
+	if errors.As(err, &pathErr) {
+		diag.Attribute = pathErr.Path
+	}
+	return Diagnostics{diag}
 }
 
 func FormatError(err error) string {
-	switch err := err.(type) {
-	case cty.PathError:
-		return fmt.Sprintf("%s: %s", FormatCtyPath(err.Path), err.Error())
-	default:
-		return err.Error()
+	var pathErr cty.PathError
+	if errors.As(err, &pathErr) {
+		return fmt.Sprintf("%s: %s", FormatCtyPath(pathErr.Path), err.Error())
 	}
+	return err.Error()
 }
 
 func FormatCtyPath(path cty.Path) string {
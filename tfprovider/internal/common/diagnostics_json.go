@@ -0,0 +1,130 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// diagnosticJSON is the JSON representation of a diagnostic, which matches
+// the diagnostic objects in Terraform's machine-readable output except that
+// it has an additional "attribute" property describing the attribute path,
+// if any, in the syntax produced by FormatCtyPath.
+type diagnosticJSON struct {
+	Severity  string `json:"severity"`
+	Summary   string `json:"summary"`
+	Detail    string `json:"detail"`
+	Attribute string `json:"attribute,omitempty"`
+}
+
+func (diag Diagnostic) MarshalJSON() ([]byte, error) {
+	raw := diagnosticJSON{
+		Summary: diag.Summary,
+		Detail:  diag.Detail,
+	}
+	switch diag.Severity {
+	case Error:
+		raw.Severity = "error"
+	case Warning:
+		raw.Severity = "warning"
+	default:
+		return nil, fmt.Errorf("invalid diagnostic severity %q", diag.Severity)
+	}
+	if len(diag.Attribute) != 0 {
+		raw.Attribute = FormatCtyPath(diag.Attribute)
+	}
+	return json.Marshal(raw)
+}
+
+func (diag *Diagnostic) UnmarshalJSON(src []byte) error {
+	var raw diagnosticJSON
+	if err := json.Unmarshal(src, &raw); err != nil {
+		return err
+	}
+	switch raw.Severity {
+	case "error":
+		diag.Severity = Error
+	case "warning":
+		diag.Severity = Warning
+	default:
+		return fmt.Errorf("invalid diagnostic severity %q", raw.Severity)
+	}
+	diag.Summary = raw.Summary
+	diag.Detail = raw.Detail
+
+	// FormatCtyPath can't represent all paths, so we keep as much of the
+	// path as we can parse rather than failing altogether.
+	diag.Attribute, _ = ParseCtyPath(raw.Attribute)
+	return nil
+}
+
+// ParseCtyPath parses a path in the syntax produced by FormatCtyPath.
+//
+// If the path contains a step that FormatCtyPath couldn't represent, or is
+// otherwise invalid, ParseCtyPath returns an error along with the steps
+// before the problem.
+func ParseCtyPath(s string) (cty.Path, error) {
+	var path cty.Path
+	for len(s) > 0 {
+		switch s[0] {
+		case '.':
+			end := strings.IndexAny(s[1:], ".[")
+			if end < 0 {
+				end = len(s) - 1
+			}
+			name := s[1 : end+1]
+			if name == "" {
+				return path, fmt.Errorf("missing attribute name")
+			}
+			path = path.GetAttr(name)
+			s = s[end+1:]
+		case '[':
+			if len(s) > 1 && s[1] == '"' {
+				quoted := quotedPrefix(s[1:])
+				key, err := strconv.Unquote(quoted)
+				if err != nil {
+					return path, fmt.Errorf("invalid index key %s", quoted)
+				}
+				s = s[1+len(quoted):]
+				if !strings.HasPrefix(s, "]") {
+					return path, fmt.Errorf("missing ] after index key")
+				}
+				path = path.Index(cty.StringVal(key))
+				s = s[1:]
+				continue
+			}
+			end := strings.IndexByte(s, ']')
+			if end < 0 {
+				return path, fmt.Errorf("missing ] after index key")
+			}
+			key, ok := new(big.Float).SetString(s[1:end])
+			if !ok {
+				return path, fmt.Errorf("unsupported index key %s", s[:end+1])
+			}
+			path = path.Index(cty.NumberVal(key))
+			s = s[end+1:]
+		default:
+			return path, fmt.Errorf("unexpected %q in attribute path", s[0])
+		}
+	}
+	return path, nil
+}
+
+// quotedPrefix returns the prefix of s up to and including the closing
+// quote of the Go-style quoted string that s starts with, or all of s if
+// there is no closing quote.
+func quotedPrefix(s string) string {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return s[:i+1]
+		}
+	}
+	return s
+}
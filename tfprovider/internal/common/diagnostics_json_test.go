@@ -0,0 +1,111 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDiagnosticJSON(t *testing.T) {
+	diag := Diagnostic{
+		Severity:  Error,
+		Summary:   "Invalid value",
+		Detail:    "The value must not be empty.",
+		Attribute: cty.GetAttrPath("tags").Index(cty.StringVal("a.b")).GetAttr("rules").Index(cty.NumberIntVal(2)),
+	}
+	raw, err := json.Marshal(diag)
+	if err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	want := `{"severity":"error","summary":"Invalid value","detail":"The value must not be empty.","attribute":".tags[\"a.b\"].rules[2]"}`
+	if string(raw) != want {
+		t.Errorf("wrong JSON\ngot:  %s\nwant: %s", raw, want)
+	}
+
+	var got Diagnostic
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("failed to decode: %s", err)
+	}
+	if got.Severity != diag.Severity || got.Summary != diag.Summary || got.Detail != diag.Detail {
+		t.Errorf("wrong diagnostic %#v", got)
+	}
+	if !got.Attribute.Equals(diag.Attribute) {
+		t.Errorf("wrong attribute %s; want %s", FormatCtyPath(got.Attribute), FormatCtyPath(diag.Attribute))
+	}
+}
+
+func TestDiagnosticJSONSeverity(t *testing.T) {
+	raw, err := json.Marshal(Diagnostic{Severity: Warning, Summary: "Deprecated"})
+	if err != nil {
+		t.Fatalf("failed to encode: %s", err)
+	}
+	if want := `{"severity":"warning","summary":"Deprecated","detail":""}`; string(raw) != want {
+		t.Errorf("wrong JSON\ngot:  %s\nwant: %s", raw, want)
+	}
+
+	if _, err := json.Marshal(Diagnostic{Summary: "No severity"}); err == nil {
+		t.Error("diagnostic with no severity encoded successfully")
+	}
+	var diag Diagnostic
+	if err := json.Unmarshal([]byte(`{"severity":"info","summary":"Hello"}`), &diag); err == nil {
+		t.Error("diagnostic with unknown severity decoded successfully")
+	}
+}
+
+func TestParseCtyPath(t *testing.T) {
+	tests := map[string]struct {
+		want    cty.Path
+		wantErr string
+	}{
+		"": {
+			want: nil,
+		},
+		".name": {
+			want: cty.GetAttrPath("name"),
+		},
+		".a.b": {
+			want: cty.GetAttrPath("a").GetAttr("b"),
+		},
+		`.tags["x\"y"]`: {
+			want: cty.GetAttrPath("tags").Index(cty.StringVal(`x"y`)),
+		},
+		".list[0].name": {
+			want: cty.GetAttrPath("list").Index(cty.NumberIntVal(0)).GetAttr("name"),
+		},
+		".set[...].name": {
+			want:    cty.GetAttrPath("set"),
+			wantErr: "unsupported index key [...]",
+		},
+		"name": {
+			wantErr: `unexpected 'n' in attribute path`,
+		},
+		".a..b": {
+			want:    cty.GetAttrPath("a"),
+			wantErr: "missing attribute name",
+		},
+		`.tags["x"`: {
+			want:    cty.GetAttrPath("tags"),
+			wantErr: "missing ] after index key",
+		},
+	}
+	for s, test := range tests {
+		t.Run(s, func(t *testing.T) {
+			got, err := ParseCtyPath(s)
+			switch {
+			case test.wantErr == "" && err != nil:
+				t.Errorf("unexpected error: %s", err)
+			case test.wantErr != "" && err == nil:
+				t.Errorf("unexpected success")
+			case test.wantErr != "" && err.Error() != test.wantErr:
+				t.Errorf("wrong error %q; want %q", err, test.wantErr)
+			}
+			if !got.Equals(test.want) {
+				t.Errorf("wrong path %s; want %s", FormatCtyPath(got), FormatCtyPath(test.want))
+			}
+			if err == nil && FormatCtyPath(got) != s {
+				t.Errorf("path doesn't format back to %q: %q", s, FormatCtyPath(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// The following are sentinel errors representing the kinds of failure that
+// callers may need to handle specially. Use errors.Is to test whether the
+// error returned from Diagnostics.Err is of one of these kinds.
+var (
+	// ErrProviderCrashed means that the provider plugin process exited or
+	// stopped responding while a call was in progress.
+	ErrProviderCrashed = errors.New("provider plugin crashed")
+
+	// ErrUnimplemented means that the provider plugin doesn't implement a
+	// call, which is usually because it was built with an older version
+	// of the plugin protocol.
+	ErrUnimplemented = errors.New("provider plugin does not implement this call")
+
+	// ErrNotConfigured means that an operation requires the provider to
+	// be configured, but it isn't.
+	ErrNotConfigured = errors.New("provider is not configured")
+
+	// ErrUnknownType means that a resource type name is not in the
+	// provider's schema.
+	ErrUnknownType = errors.New("unknown resource type")
+
+	// ErrUnknownFunction means that a function name is not in the
+	// provider's schema.
+	ErrUnknownFunction = errors.New("unknown function")
+
+	// ErrInvalidResponse means that the provider plugin returned a response
+	// that doesn't conform to its schema or to the plugin protocol.
+	ErrInvalidResponse = errors.New("invalid response from provider plugin")
+)
+
+// RPCError is the cause of a diagnostic describing a failed RPC call to a
+// provider plugin, preserving the gRPC status of the failure.
+//
+// RPCError matches ErrUnimplemented and ErrProviderCrashed when used with
+// errors.Is, depending on its status code.
+type RPCError struct {
+	// RPC is the name of the RPC that failed, if known.
+	RPC string
+
+	Code    codes.Code
+	Message string
+}
+
+func (e *RPCError) Error() string {
+	if e.RPC != "" {
+		return fmt.Sprintf("provider returned RPC error %s from %s: %s", e.Code, e.RPC, e.Message)
+	}
+	return fmt.Sprintf("provider returned RPC error %s: %s", e.Code, e.Message)
+}
+
+// GRPCStatus returns the gRPC status of the failure, allowing the error to
+// be used with the functions in the gRPC status package.
+func (e *RPCError) GRPCStatus() *grpcStatus.Status {
+	return grpcStatus.New(e.Code, e.Message)
+}
+
+func (e *RPCError) Is(target error) bool {
+	switch target {
+	case ErrUnimplemented:
+		return e.Code == codes.Unimplemented
+	case ErrProviderCrashed:
+		// Provider plugins always run locally, so an unavailable server
+		// means that the process has exited or its connection was lost.
+		return e.Code == codes.Unavailable
+	default:
+		return false
+	}
+}
+
+// FunctionError is the cause of a diagnostic describing an error returned
+// by a provider-defined function.
+type FunctionError struct {
+	Text string
+
+	// Argument is the index of the argument that caused the error, or nil
+	// if the error doesn't relate to a specific argument. Arguments for
+	// the variadic parameter, if any, follow the positional arguments.
+	Argument *int
+}
+
+func (e *FunctionError) Error() string {
+	if e.Argument != nil {
+		return fmt.Sprintf("invalid value for argument %d: %s", *e.Argument+1, e.Text)
+	}
+	return e.Text
+}
+
+// FunctionArgumentPath returns the path to use as the Attribute of a
+// diagnostic about the function argument with the given index.
+func FunctionArgumentPath(idx int) cty.Path {
+	return cty.Path{cty.IndexStep{Key: cty.NumberIntVal(int64(idx))}}
+}
+
+// FunctionErrorDiagnostic returns an error diagnostic describing the given
+// error returned from a call to the function with the given name.
+func FunctionErrorDiagnostic(name string, err *FunctionError) Diagnostic {
+	text := strings.TrimSuffix(err.Text, ".")
+	diag := Diagnostic{
+		Severity: Error,
+		Summary:  "Error in function call",
+		Detail:   fmt.Sprintf("Call to function %q failed: %s.", name, text),
+		Cause:    err,
+	}
+	if err.Argument != nil {
+		diag.Summary = "Invalid function argument"
+		diag.Detail = fmt.Sprintf("Invalid value for argument %d of function %q: %s.", *err.Argument+1, name, text)
+		diag.Attribute = FunctionArgumentPath(*err.Argument)
+	}
+	return diag
+}
+
+// KindError returns an error that has the same message as err but also
+// matches the given kind, which is one of the Err... sentinel errors, when
+// used with errors.Is. If err is nil, KindError returns kind itself.
+func KindError(kind, err error) error {
+	if err == nil {
+		return kind
+	}
+	return &kindError{kind: kind, err: err}
+}
+
+type kindError struct {
+	kind error
+	err  error
+}
+
+func (e *kindError) Error() string {
+	return e.err.Error()
+}
+
+func (e *kindError) Unwrap() error {
+	return e.err
+}
+
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
+
+// DiagnosticsError is an error representing a set of diagnostics that
+// includes at least one error, as returned from Diagnostics.Err.
+//
+// errors.Is and errors.As test each of the causes of the error diagnostics
+// in turn.
+type DiagnosticsError struct {
+	Diagnostics Diagnostics
+}
+
+// Err returns an error representing the error diagnostics in the receiver,
+// or nil if there are none.
+func (diags Diagnostics) Err() error {
+	if !diags.HasErrors() {
+		return nil
+	}
+	return &DiagnosticsError{Diagnostics: diags}
+}
+
+func (e *DiagnosticsError) Error() string {
+	var msgs []string
+	for _, diag := range e.Diagnostics {
+		if diag.Severity != Error {
+			continue
+		}
+		msg := diag.Summary
+		if diag.Detail != "" {
+			msg = msg + ": " + strings.TrimSuffix(diag.Detail, ".")
+		}
+		msgs = append(msgs, msg)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *DiagnosticsError) Is(target error) bool {
+	for _, diag := range e.Diagnostics {
+		if diag.Severity == Error && diag.Cause != nil && errors.Is(diag.Cause, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *DiagnosticsError) As(target interface{}) bool {
+	for _, diag := range e.Diagnostics {
+		if diag.Severity == Error && diag.Cause != nil && errors.As(diag.Cause, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestRPCErrorDiagnostics(t *testing.T) {
+	r := NewCallRunner(nil, nil)
+	err := r.Call(context.Background(), "ApplyResourceChange", func(ctx context.Context, opts []grpc.CallOption) error {
+		return grpcStatus.Error(codes.Unavailable, "transport is closing")
+	})
+
+	diags := RPCErrorDiagnostics(err)
+	if len(diags) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1", len(diags))
+	}
+	diag := diags[0]
+	if diag.Severity != Error {
+		t.Errorf("wrong severity %c", diag.Severity)
+	}
+	if want := "Provider returned RPC error Unavailable from ApplyResourceChange: transport is closing."; diag.Detail != want {
+		t.Errorf("wrong detail\ngot:  %s\nwant: %s", diag.Detail, want)
+	}
+	var rpcErr *RPCError
+	if !errors.As(diags.Err(), &rpcErr) {
+		t.Fatalf("cause is not an RPCError: %#v", diag.Cause)
+	}
+	if rpcErr.RPC != "ApplyResourceChange" || rpcErr.Code != codes.Unavailable {
+		t.Errorf("wrong cause %#v", rpcErr)
+	}
+	if !errors.Is(diags.Err(), ErrProviderCrashed) {
+		t.Error("Unavailable error doesn't match ErrProviderCrashed")
+	}
+	if errors.Is(diags.Err(), ErrUnimplemented) {
+		t.Error("Unavailable error matches ErrUnimplemented")
+	}
+}
+
+func TestRPCErrorDiagnosticsUnimplemented(t *testing.T) {
+	r := NewCallRunner(nil, nil)
+	err := r.Call(context.Background(), "MoveResourceState", func(ctx context.Context, opts []grpc.CallOption) error {
+		return grpcStatus.Error(codes.Unimplemented, "unknown method")
+	})
+
+	diags := RPCErrorDiagnostics(err)
+	if got, want := diags[0].Summary, "Operation not supported by this provider version"; got != want {
+		t.Errorf("wrong summary %q; want %q", got, want)
+	}
+	if !strings.Contains(diags[0].Detail, "the MoveResourceState operation") {
+		t.Errorf("detail doesn't name the RPC: %s", diags[0].Detail)
+	}
+	if !errors.Is(diags.Err(), ErrUnimplemented) {
+		t.Error("Unimplemented error doesn't match ErrUnimplemented")
+	}
+}
+
+func TestRPCErrorDiagnosticsOther(t *testing.T) {
+	if diags := RPCErrorDiagnostics(nil); diags != nil {
+		t.Errorf("unexpected diagnostics for nil error: %#v", diags)
+	}
+
+	diags := RPCErrorDiagnostics(context.Canceled)
+	if got, want := diags[0].Detail, "Provider RPC call failed: context canceled."; got != want {
+		t.Errorf("wrong detail %q; want %q", got, want)
+	}
+	if !errors.Is(diags.Err(), context.Canceled) {
+		t.Error("cause doesn't match context.Canceled")
+	}
+}
+
+func TestCallRunnerStreamError(t *testing.T) {
+	r := NewCallRunner(nil, nil)
+	if err := r.StreamError("ListResource", io.EOF); err != io.EOF {
+		t.Errorf("wrong error %v; want io.EOF", err)
+	}
+	err := r.StreamError("ListResource", grpcStatus.Error(codes.Internal, "oops"))
+	rpcErr, ok := err.(*RPCError)
+	if !ok {
+		t.Fatalf("wrong error %#v; want *RPCError", err)
+	}
+	if rpcErr.RPC != "ListResource" || rpcErr.Code != codes.Internal {
+		t.Errorf("wrong error %#v", rpcErr)
+	}
+}
+
+func TestDiagnosticsErr(t *testing.T) {
+	diags := Diagnostics{
+		{Severity: Warning, Summary: "Deprecated"},
+	}
+	if err := diags.Err(); err != nil {
+		t.Errorf("unexpected error for warnings only: %s", err)
+	}
+
+	diags = append(diags, Diagnostic{
+		Severity: Error,
+		Summary:  "Unknown type",
+		Detail:   "There is no such type.",
+		Cause:    ErrUnknownType,
+	})
+	err := diags.Err()
+	if got, want := err.Error(), "Unknown type: There is no such type"; got != want {
+		t.Errorf("wrong message %q; want %q", got, want)
+	}
+	if !errors.Is(err, ErrUnknownType) {
+		t.Error("error doesn't match ErrUnknownType")
+	}
+}
@@ -0,0 +1,47 @@
+package common
+
+// Feature identifies an optional capability of a provider, for use with
+// the Supports method of a provider.
+//
+// Some features correspond to RPCs that older providers may not implement.
+// For those, a provider is assumed to support the feature until a call to
+// the RPC fails because the provider doesn't implement it.
+type Feature string
+
+const (
+	// FeatureManagedResourceImport is support for importing existing
+	// remote objects using ManagedResourceType.Import.
+	FeatureManagedResourceImport Feature = "ManagedResourceImport"
+
+	// FeatureManagedResourceMoveState is support for moving objects
+	// between resource types using ManagedResourceType.MoveState.
+	FeatureManagedResourceMoveState Feature = "ManagedResourceMoveState"
+
+	// FeatureResourceIdentity is support for resource identities, which
+	// are described by ManagedResourceTypeSchema.Identity.
+	FeatureResourceIdentity Feature = "ResourceIdentity"
+
+	// FeatureDataResourceRead is support for reading data resources using
+	// DataResourceType.Read.
+	FeatureDataResourceRead Feature = "DataResourceRead"
+
+	// FeatureEphemeralResources is support for opening ephemeral
+	// resources using EphemeralResourceType.Open.
+	FeatureEphemeralResources Feature = "EphemeralResources"
+
+	// FeatureListResources is support for finding existing remote objects
+	// using ListResourceType.List.
+	FeatureListResources Feature = "ListResources"
+
+	// FeatureActions is support for invoking provider-defined actions
+	// using ActionType.Invoke.
+	FeatureActions Feature = "Actions"
+
+	// FeatureStop is support for gracefully aborting in-progress operations
+	// using Provider.Stop.
+	FeatureStop Feature = "Stop"
+
+	// FeatureFunctions is support for calling provider-defined functions
+	// using Provider.CallFunction.
+	FeatureFunctions Feature = "Functions"
+)
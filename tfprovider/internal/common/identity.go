@@ -0,0 +1,47 @@
+package common
+
+import (
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ResourceIdentitySchema describes the identity objects of a managed
+// resource type.
+//
+// An identity is a small object, separate from the main object, that
+// uniquely identifies a remote object. Unlike the main object, it doesn't
+// change over the remote object's lifetime, so callers can use it as a
+// long-term key for the remote object and to import it.
+type ResourceIdentitySchema struct {
+	// Version is the version of the identity schema, which is separate from
+	// the version of the resource type's schema. Identities with different
+	// versions should always be considered unequal.
+	Version int64
+
+	Attributes map[string]*IdentityAttribute
+}
+
+// IdentityAttribute describes one attribute of a resource identity.
+type IdentityAttribute struct {
+	Type cty.Type
+
+	// RequiredForImport means that the attribute must be set when
+	// importing an object by its identity.
+	RequiredForImport bool
+
+	// OptionalForImport means that the attribute may be set when importing
+	// an object by its identity, but the provider can determine it
+	// otherwise.
+	OptionalForImport bool
+
+	Description string
+}
+
+// ImpliedType returns the object type of identities conforming to the
+// schema.
+func (s *ResourceIdentitySchema) ImpliedType() cty.Type {
+	atys := make(map[string]cty.Type, len(s.Attributes))
+	for name, attr := range s.Attributes {
+		atys[name] = attr.Type
+	}
+	return cty.Object(atys)
+}
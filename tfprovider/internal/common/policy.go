@@ -0,0 +1,329 @@
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+// CallPolicy describes how to make RPC calls to a provider plugin: how long
+// to wait for each call, whether to retry calls that fail with a transient
+// error, and how many calls may be in progress at once.
+//
+// The zero value of CallPolicy makes each call exactly once, with no time
+// limit other than the caller's context and no limit on concurrency.
+type CallPolicy struct {
+	// Timeout is the maximum time to wait for each attempt of each call.
+	// Zero means no limit, other than any deadline of the caller's context.
+	//
+	// Timeouts don't apply to streaming RPCs such as ListResource, which
+	// last for as long as the caller keeps receiving results.
+	Timeout time.Duration
+
+	// RPCTimeouts overrides Timeout for specific RPCs, keyed by the RPC name
+	// as given in the protocol definition, such as "ApplyResourceChange".
+	// A zero value in this map means no limit for that RPC.
+	RPCTimeouts map[string]time.Duration
+
+	// MaxRetries is the maximum number of times to retry a call that fails
+	// with one of the status codes in RetryCodes. Zero disables retries.
+	//
+	// Only RPCs that don't change any state are retried: the validation,
+	// planning, read and schema RPCs. Calls that may have side-effects in
+	// the provider, such as ApplyResourceChange or ConfigureProvider, are
+	// never retried, because a failed attempt may have partially succeeded.
+	MaxRetries int
+
+	// RetryCodes are the gRPC status codes that cause a call to be retried.
+	// If RetryCodes is nil, the codes are Unavailable and ResourceExhausted.
+	//
+	// Unavailable can mean a temporary problem with the connection, but it
+	// is also the status of every call after the provider plugin has
+	// crashed, in which case each retry fails in the same way. Callers that
+	// would rather fail fast in that case can set RetryCodes to only
+	// ResourceExhausted.
+	RetryCodes []codes.Code
+
+	// RetryDelay is how long to wait before the first retry of a call. Each
+	// subsequent retry waits twice as long as the one before, up to
+	// MaxRetryDelay. If RetryDelay is zero, the delay is 100 milliseconds.
+	RetryDelay time.Duration
+
+	// MaxRetryDelay is the upper limit on the delay between retries. Zero
+	// means no limit.
+	MaxRetryDelay time.Duration
+
+	// MaxConcurrentCalls limits how many calls to the provider may be in
+	// progress at once. Zero means no limit.
+	//
+	// Set this to 1 to serialize all calls to a provider that is known not
+	// to be safe for concurrent use.
+	//
+	// Calls to Stop and StopProvider don't count towards the limit and are
+	// never delayed by it, because their purpose is to interrupt the calls
+	// that are already in progress.
+	MaxConcurrentCalls int
+}
+
+const defaultRetryDelay = 100 * time.Millisecond
+
+// defaultRetryCodes are the status codes that are retried when
+// CallPolicy.RetryCodes is nil.
+var defaultRetryCodes = []codes.Code{codes.Unavailable, codes.ResourceExhausted}
+
+// retryableRPCs are the RPCs that don't change any state, either in the
+// provider or in remote systems, and so are safe to retry.
+var retryableRPCs = map[string]bool{
+	"GetSchema":                       true,
+	"GetProviderSchema":               true,
+	"GetMetadata":                     true,
+	"GetResourceIdentitySchemas":      true,
+	"PrepareProviderConfig":           true,
+	"ValidateProviderConfig":          true,
+	"ValidateResourceTypeConfig":      true,
+	"ValidateResourceConfig":          true,
+	"ValidateDataSourceConfig":        true,
+	"ValidateDataResourceConfig":      true,
+	"ValidateEphemeralResourceConfig": true,
+	"ValidateListResourceConfig":      true,
+	"ValidateActionConfig":            true,
+	"UpgradeResourceState":            true,
+	"UpgradeResourceIdentity":         true,
+	"PlanResourceChange":              true,
+	"PlanAction":                      true,
+	"ReadResource":                    true,
+	"ReadDataSource":                  true,
+	"GetFunctions":                    true,
+	"CallFunction":                    true,
+}
+
+// unlimitedRPCs are the RPCs that aren't subject to MaxConcurrentCalls.
+var unlimitedRPCs = map[string]bool{
+	"Stop":         true,
+	"StopProvider": true,
+}
+
+// CallRunner applies a CallPolicy and a ConnectionPolicy to the calls made
+// to a single provider plugin instance.
+//
+// A CallRunner also remembers which RPCs the provider has reported as
+// unimplemented, and fails any later calls to them immediately.
+type CallRunner struct {
+	policy CallPolicy
+	conn   ConnectionPolicy
+	sem    chan struct{}
+
+	mu            sync.Mutex
+	unimplemented map[string]bool
+}
+
+// NewCallRunner returns a runner for the given policies. A nil policy is
+// equivalent to a zero-value policy.
+func NewCallRunner(policy *CallPolicy, conn *ConnectionPolicy) *CallRunner {
+	r := &CallRunner{}
+	if policy != nil {
+		r.policy = *policy
+	}
+	if conn != nil {
+		r.conn = *conn
+	}
+	if r.policy.MaxConcurrentCalls > 0 {
+		r.sem = make(chan struct{}, r.policy.MaxConcurrentCalls)
+	}
+	return r
+}
+
+// Call runs the given function, which should make a single call to the
+// RPC with the given name, in accordance with the runner's policies. The
+// function must pass the given call options to the RPC.
+//
+// If the call fails with a gRPC status, the returned error is an *RPCError
+// naming the RPC.
+//
+// The function may be called more than once if the policy calls for
+// retries, and so it must not have side-effects other than the RPC call
+// itself.
+func (r *CallRunner) Call(ctx context.Context, rpc string, call func(ctx context.Context, opts []grpc.CallOption) error) error {
+	timeout := r.policy.Timeout
+	if t, ok := r.policy.RPCTimeouts[rpc]; ok {
+		timeout = t
+	}
+	return r.call(ctx, rpc, timeout, call)
+}
+
+// Stream is like Call, but for opening a server-streaming RPC.
+//
+// The policy's timeouts don't apply to streams, because a stream lasts for
+// as long as the caller keeps receiving from it. For the same reason, a
+// stream counts towards MaxConcurrentCalls only while it is being opened.
+// Errors from receiving on the stream must be passed to StreamError, and
+// are never retried.
+func (r *CallRunner) Stream(ctx context.Context, rpc string, open func(ctx context.Context, opts []grpc.CallOption) error) error {
+	return r.call(ctx, rpc, 0, open)
+}
+
+// StreamError returns the error to report for the given error from
+// receiving on a stream of the RPC with the given name, remembering if the
+// provider reported that it doesn't implement the RPC. Providers usually
+// report that on the first receive rather than when the stream is opened.
+func (r *CallRunner) StreamError(rpc string, err error) error {
+	if grpcStatus.Code(err) == codes.Unimplemented {
+		return r.unimplementedError(rpc, err)
+	}
+	return rpcError(rpc, err)
+}
+
+func (r *CallRunner) call(ctx context.Context, rpc string, timeout time.Duration, call func(ctx context.Context, opts []grpc.CallOption) error) error {
+	delay := r.policy.RetryDelay
+	if delay == 0 {
+		delay = defaultRetryDelay
+	}
+
+	if r.Unimplemented(rpc) {
+		return &RPCError{
+			RPC:     rpc,
+			Code:    codes.Unimplemented,
+			Message: "the provider previously reported that it does not implement this call",
+		}
+	}
+
+	retries := 0
+	if retryableRPCs[rpc] {
+		retries = r.policy.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := r.attempt(ctx, rpc, timeout, call)
+		if grpcStatus.Code(err) == codes.Unimplemented {
+			return r.unimplementedError(rpc, err)
+		}
+		if err == nil || attempt >= retries || !r.isRetryableError(err) {
+			return rpcError(rpc, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return rpcError(rpc, err)
+		case <-timer.C:
+		}
+
+		delay *= 2
+		if max := r.policy.MaxRetryDelay; max > 0 && delay > max {
+			delay = max
+		}
+	}
+}
+
+// CheckConnection verifies that the connection to the provider meets the
+// runner's ConnectionPolicy, using the given probe function to make a call
+// that carries no configuration or state, such as GetMetadata. The probe's
+// own result doesn't matter, as long as the call reaches the provider.
+//
+// CheckConnection does nothing if the policy has no requirements to check.
+// Callers should check the connection before making any other call, so that
+// no configuration is sent over a connection that the policy rejects.
+func (r *CallRunner) CheckConnection(ctx context.Context, probe func(ctx context.Context, opts []grpc.CallOption) error) error {
+	if !r.conn.checksPeer() {
+		return nil
+	}
+	var pr peer.Peer
+	err := probe(ctx, r.conn.callOptions(&pr))
+	return r.conn.checkProbe(&pr, err)
+}
+
+// Unimplemented returns true if the provider has reported that it doesn't
+// implement the RPC with the given name.
+func (r *CallRunner) Unimplemented(rpc string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.unimplemented[rpc]
+}
+
+// unimplementedError remembers that the provider doesn't implement the RPC
+// with the given name, and returns the error to report for the given
+// Unimplemented error from calling it.
+func (r *CallRunner) unimplementedError(rpc string, err error) error {
+	r.mu.Lock()
+	if r.unimplemented == nil {
+		r.unimplemented = make(map[string]bool)
+	}
+	r.unimplemented[rpc] = true
+	r.mu.Unlock()
+	return &RPCError{
+		RPC:     rpc,
+		Code:    codes.Unimplemented,
+		Message: grpcStatus.Convert(err).Message(),
+	}
+}
+
+func (r *CallRunner) attempt(ctx context.Context, rpc string, timeout time.Duration, call func(ctx context.Context, opts []grpc.CallOption) error) error {
+	if r.sem != nil && !unlimitedRPCs[rpc] {
+		select {
+		case r.sem <- struct{}{}:
+			defer func() { <-r.sem }()
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	var pr peer.Peer
+	err := call(ctx, r.conn.callOptions(&pr))
+	if r.conn.checksPeer() {
+		if peerErr := r.conn.checkPeer(&pr); peerErr != nil {
+			return peerErr
+		}
+	}
+	return err
+}
+
+// rpcError returns the error to report for the given error from calling the
+// RPC with the given name. If the error has a gRPC status, the result is an
+// *RPCError that records which RPC failed. Other errors, such as those from
+// the caller's context while waiting for a concurrency slot, and io.EOF at
+// the end of a stream, are returned unchanged.
+func rpcError(rpc string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*RPCError); ok {
+		return err
+	}
+	status, ok := grpcStatus.FromError(err)
+	if !ok {
+		return err
+	}
+	return &RPCError{
+		RPC:     rpc,
+		Code:    status.Code(),
+		Message: status.Message(),
+	}
+}
+
+// isRetryableError returns true if the given error has one of the status
+// codes that the policy retries.
+func (r *CallRunner) isRetryableError(err error) bool {
+	retryCodes := r.policy.RetryCodes
+	if retryCodes == nil {
+		retryCodes = defaultRetryCodes
+	}
+	code := grpcStatus.Code(err)
+	for _, retryCode := range retryCodes {
+		if code == retryCode {
+			return true
+		}
+	}
+	return false
+}
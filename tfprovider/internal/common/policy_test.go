@@ -0,0 +1,203 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestCallRunnerRetries(t *testing.T) {
+	tests := map[string]struct {
+		rpc        string
+		code       codes.Code
+		retryCodes []codes.Code
+		calls      int
+	}{
+		"read-only RPC with transient error": {
+			rpc:   "ReadResource",
+			code:  codes.ResourceExhausted,
+			calls: 3,
+		},
+		"state-changing RPC with transient error": {
+			rpc:   "ApplyResourceChange",
+			code:  codes.ResourceExhausted,
+			calls: 1,
+		},
+		"read-only RPC unavailable": {
+			rpc:   "ReadResource",
+			code:  codes.Unavailable,
+			calls: 3,
+		},
+		"unavailable not in retry codes": {
+			rpc:        "ReadResource",
+			code:       codes.Unavailable,
+			retryCodes: []codes.Code{codes.ResourceExhausted},
+			calls:      1,
+		},
+		"other error": {
+			rpc:   "PlanResourceChange",
+			code:  codes.InvalidArgument,
+			calls: 1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := NewCallRunner(&CallPolicy{
+				MaxRetries: 2,
+				RetryDelay: time.Millisecond,
+				RetryCodes: test.retryCodes,
+			}, nil)
+			calls := 0
+			err := r.Call(context.Background(), test.rpc, func(ctx context.Context, opts []grpc.CallOption) error {
+				calls++
+				return grpcStatus.Error(test.code, "failed")
+			})
+			if got := grpcStatus.Code(err); got != test.code {
+				t.Errorf("wrong error code %s; want %s", got, test.code)
+			}
+			if calls != test.calls {
+				t.Errorf("wrong number of calls %d; want %d", calls, test.calls)
+			}
+		})
+	}
+}
+
+func TestCallRunnerRetrySuccess(t *testing.T) {
+	r := NewCallRunner(&CallPolicy{
+		MaxRetries: 5,
+		RetryDelay: time.Millisecond,
+	}, nil)
+	calls := 0
+	err := r.Call(context.Background(), "GetProviderSchema", func(ctx context.Context, opts []grpc.CallOption) error {
+		calls++
+		if calls < 3 {
+			return grpcStatus.Error(codes.ResourceExhausted, "busy")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("wrong number of calls %d; want 3", calls)
+	}
+}
+
+func TestCallRunnerTimeout(t *testing.T) {
+	r := NewCallRunner(&CallPolicy{
+		Timeout: time.Hour,
+		RPCTimeouts: map[string]time.Duration{
+			"ApplyResourceChange": 0,
+		},
+	}, nil)
+
+	err := r.Call(context.Background(), "ReadResource", func(ctx context.Context, opts []grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("ReadResource has no deadline")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = r.Call(context.Background(), "ApplyResourceChange", func(ctx context.Context, opts []grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); ok {
+			t.Error("ApplyResourceChange has a deadline")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestCallRunnerMaxConcurrentCalls(t *testing.T) {
+	r := NewCallRunner(&CallPolicy{
+		MaxConcurrentCalls: 1,
+	}, nil)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- r.Call(context.Background(), "ApplyResourceChange", func(ctx context.Context, opts []grpc.CallOption) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	// A second call must wait for the first to finish.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := r.Call(ctx, "ReadResource", func(ctx context.Context, opts []grpc.CallOption) error {
+		t.Error("ReadResource called while another call was in progress")
+		return nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("wrong error %v; want %v", err, context.DeadlineExceeded)
+	}
+
+	// Stop is exempt from the limit, because it must be able to interrupt
+	// the call that is holding it.
+	stopped := false
+	err = r.Call(context.Background(), "Stop", func(ctx context.Context, opts []grpc.CallOption) error {
+		stopped = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error from Stop: %s", err)
+	}
+	if !stopped {
+		t.Error("Stop was not called")
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestCallRunnerUnimplemented(t *testing.T) {
+	r := NewCallRunner(nil, nil)
+
+	calls := 0
+	call := func(ctx context.Context, opts []grpc.CallOption) error {
+		calls++
+		return grpcStatus.Error(codes.Unimplemented, "not here")
+	}
+	for i := 0; i < 2; i++ {
+		err := r.Call(context.Background(), "MoveResourceState", call)
+		if !errors.Is(err, ErrUnimplemented) {
+			t.Errorf("wrong error %v; want ErrUnimplemented", err)
+		}
+		if rpcErr, ok := err.(*RPCError); !ok || rpcErr.RPC != "MoveResourceState" {
+			t.Errorf("wrong error %#v; want RPCError for MoveResourceState", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("wrong number of calls %d; want 1", calls)
+	}
+	if !r.Unimplemented("MoveResourceState") {
+		t.Error("MoveResourceState not recorded as unimplemented")
+	}
+	if r.Unimplemented("ReadResource") {
+		t.Error("ReadResource recorded as unimplemented")
+	}
+
+	err := r.StreamError("ListResource", grpcStatus.Error(codes.Unimplemented, "not here"))
+	if !errors.Is(err, ErrUnimplemented) {
+		t.Errorf("wrong error %v; want ErrUnimplemented", err)
+	}
+	if !r.Unimplemented("ListResource") {
+		t.Error("ListResource not recorded as unimplemented")
+	}
+}
@@ -1,15 +1,282 @@
 package common
 
 import (
+	"time"
+
 	"github.com/zclconf/go-cty/cty"
 )
 
 type ManagedResourceReadRequest struct {
 	PreviousValue cty.Value
 	OpaquePrivate []byte
+
+	// PreviousIdentity is the identity of the object, as returned with
+	// PreviousValue, or cty.NilVal if it has none.
+	PreviousIdentity cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle in the response.
+	ClientCapabilities ClientCapabilities
 }
 
 type ManagedResourceReadResponse struct {
 	RefreshedValue cty.Value
 	OpaquePrivate  []byte
+
+	// RefreshedIdentity is the identity of the object. It is a null value
+	// if the provider returned no identity, or cty.NilVal if the resource
+	// type doesn't have identities. The same applies to the identities in
+	// the other response types.
+	RefreshedIdentity cty.Value
+
+	// Deferred is set if the provider deferred the action, in which case
+	// the other fields are only a prediction of the result.
+	Deferred *Deferred
+}
+
+type ManagedResourcePlanRequest struct {
+	// PriorValue is the current state of the object, or a null value if
+	// the object is to be created.
+	PriorValue cty.Value
+
+	// ProposedValue is the result of merging the configuration with the
+	// prior state, or a null value if the object is to be destroyed.
+	ProposedValue cty.Value
+
+	// Config is the configuration for the object, or a null value if the
+	// object is to be destroyed.
+	Config cty.Value
+
+	OpaquePrivate []byte
+
+	// PriorIdentity is the identity of the object as returned with
+	// PriorValue, or cty.NilVal if it has none.
+	PriorIdentity cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle in the response.
+	ClientCapabilities ClientCapabilities
+}
+
+type ManagedResourcePlanResponse struct {
+	PlannedValue    cty.Value
+	RequiresReplace []cty.Path
+	OpaquePrivate   []byte
+	PlannedIdentity cty.Value
+
+	// Deferred is set if the provider deferred the action, in which case
+	// the other fields are only a prediction of the result.
+	Deferred *Deferred
+}
+
+type ManagedResourceApplyRequest struct {
+	PriorValue    cty.Value
+	PlannedValue  cty.Value
+	Config        cty.Value
+	OpaquePrivate []byte
+
+	// PlannedIdentity is the identity returned with PlannedValue, or
+	// cty.NilVal if there is none.
+	PlannedIdentity cty.Value
+}
+
+type ManagedResourceApplyResponse struct {
+	NewValue      cty.Value
+	OpaquePrivate []byte
+	NewIdentity   cty.Value
+}
+
+type ManagedResourceImportRequest struct {
+	// Exactly one of ID and Identity must be set. ID is the import ID
+	// string, whose format is specific to the resource type. Identity is an
+	// identity conforming to the resource type's identity schema, with at
+	// least the attributes that are required for import set.
+	ID       string
+	Identity cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle in the response.
+	ClientCapabilities ClientCapabilities
+}
+
+type ManagedResourceImportResponse struct {
+	Imported []ImportedManagedResource
+
+	// Deferred is set if the provider deferred the action, in which case
+	// the other fields are only a prediction of the result.
+	Deferred *Deferred
+}
+
+// ImportedManagedResource is one of the objects returned from an import
+// request. Some providers return objects of more than one resource type
+// for a single import request.
+type ImportedManagedResource struct {
+	TypeName      string
+	Value         cty.Value
+	OpaquePrivate []byte
+	Identity      cty.Value
+}
+
+type DataResourceReadRequest struct {
+	Config cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle in the response.
+	ClientCapabilities ClientCapabilities
+}
+
+type DataResourceReadResponse struct {
+	State cty.Value
+
+	// Deferred is set if the provider deferred the action, in which case
+	// the other fields are only a prediction of the result.
+	Deferred *Deferred
+}
+
+type EphemeralResourceOpenRequest struct {
+	Config cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle in the response.
+	ClientCapabilities ClientCapabilities
+}
+
+type EphemeralResourceOpenResponse struct {
+	Result        cty.Value
+	OpaquePrivate []byte
+
+	// RenewAt is the time before which the caller must call Renew to keep
+	// the object alive, or the zero time if the object doesn't need
+	// renewing.
+	RenewAt time.Time
+
+	// Deferred is set if the provider deferred the action, in which case
+	// the other fields are only a prediction of the result.
+	Deferred *Deferred
+}
+
+type EphemeralResourceRenewRequest struct {
+	OpaquePrivate []byte
+}
+
+type EphemeralResourceRenewResponse struct {
+	OpaquePrivate []byte
+
+	// RenewAt is the time before which the caller must call Renew again,
+	// or the zero time if the object doesn't need renewing again.
+	RenewAt time.Time
+}
+
+type EphemeralResourceCloseRequest struct {
+	OpaquePrivate []byte
+}
+
+type ListResourceValidateRequest struct {
+	Config cty.Value
+
+	// IncludeResourceObject and Limit are the values given for the
+	// corresponding fields of ListResourceRequest, which may be unknown at
+	// validation time. A null value or cty.NilVal means that the caller
+	// won't set the field.
+	IncludeResourceObject cty.Value
+	Limit                 cty.Value
+}
+
+type ListResourceRequest struct {
+	Config cty.Value
+
+	// IncludeResourceObject asks the provider to return the full object for
+	// each result, rather than only its identity and display name.
+	IncludeResourceObject bool
+
+	// Limit is the maximum number of results to return, or zero for no
+	// limit.
+	Limit int64
+}
+
+// ListResourceResult is a single result from ListResourceType.List.
+type ListResourceResult struct {
+	// Identity is the identity of the remote object, conforming to the
+	// identity schema of the managed resource type with the same name.
+	Identity cty.Value
+
+	// DisplayName is a name for the remote object that is meaningful to
+	// humans, if the provider returned one.
+	DisplayName string
+
+	// Object is the full object, conforming to the schema of the managed
+	// resource type with the same name, or cty.NilVal if the provider
+	// didn't return it.
+	Object cty.Value
+
+	// Diagnostics are any warnings or errors relating to this result.
+	Diagnostics Diagnostics
+}
+
+type ActionPlanRequest struct {
+	Config cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle in the response.
+	ClientCapabilities ClientCapabilities
+}
+
+type ActionPlanResponse struct {
+	// Deferred is set if the provider deferred the action, in which case
+	// the caller should not invoke it yet.
+	Deferred *Deferred
+}
+
+type ActionInvokeRequest struct {
+	Config cty.Value
+
+	// ClientCapabilities describes the optional protocol behaviors that
+	// the caller can handle.
+	ClientCapabilities ClientCapabilities
+}
+
+type ManagedResourceMoveStateRequest struct {
+	// SourceProviderAddr is the source address of the provider that the
+	// object is being moved from, such as "registry.terraform.io/hashicorp/aws".
+	SourceProviderAddr string
+
+	// SourceTypeName and SourceSchemaVersion are the managed resource type
+	// that the object is being moved from and the version of that type's
+	// schema that SourceState conforms to.
+	SourceTypeName      string
+	SourceSchemaVersion int64
+
+	// SourceState is the JSON representation of the object as saved in
+	// state, which the provider decodes using the source type's schema.
+	SourceState []byte
+
+	SourceOpaquePrivate []byte
+
+	// SourceIdentity is the JSON representation of the object's identity,
+	// if any, and SourceIdentitySchemaVersion is the version of the source
+	// type's identity schema that it conforms to.
+	SourceIdentity              []byte
+	SourceIdentitySchemaVersion int64
+}
+
+type ManagedResourceMoveStateResponse struct {
+	NewValue      cty.Value
+	OpaquePrivate []byte
+	NewIdentity   cty.Value
+}
+
+type ManagedResourceUpgradeIdentityRequest struct {
+	// Version is the version of the identity schema that RawIdentity
+	// conforms to, which is usually older than the current version.
+	Version int64
+
+	// RawIdentity is the JSON representation of the identity as it was
+	// saved.
+	RawIdentity []byte
+}
+
+type ManagedResourceUpgradeIdentityResponse struct {
+	// Identity is the identity, converted to conform to the current
+	// identity schema.
+	Identity cty.Value
 }
@@ -16,6 +16,29 @@ type ManagedResourceType interface {
 	// changes that may have occurred to the corresponding remote object.
 	Read(context.Context, ManagedResourceReadRequest) (ManagedResourceReadResponse, Diagnostics)
 
+	// Plan asks the provider to predict the effect of changing an object
+	// of this type to match a new configuration, or of destroying it.
+	Plan(context.Context, ManagedResourcePlanRequest) (ManagedResourcePlanResponse, Diagnostics)
+
+	// Apply asks the provider to carry out a change previously returned
+	// from Plan, creating, updating or destroying a remote object.
+	Apply(context.Context, ManagedResourceApplyRequest) (ManagedResourceApplyResponse, Diagnostics)
+
+	// Import asks the provider to find an existing remote object with the
+	// given ID and return its current state, so that it can be managed
+	// afterwards.
+	Import(context.Context, ManagedResourceImportRequest) (ManagedResourceImportResponse, Diagnostics)
+
+	// MoveState asks the provider to convert an object of another resource
+	// type, possibly belonging to another provider, into an object of this
+	// type, such as after a resource type has been renamed.
+	MoveState(context.Context, ManagedResourceMoveStateRequest) (ManagedResourceMoveStateResponse, Diagnostics)
+
+	// UpgradeIdentity asks the provider to convert an identity saved with
+	// an older version of the resource type's identity schema so that it
+	// conforms to the current version.
+	UpgradeIdentity(context.Context, ManagedResourceUpgradeIdentityRequest) (ManagedResourceUpgradeIdentityResponse, Diagnostics)
+
 	// Sealed is a do-nothing method that exists only to represent that this
 	// interface may not be implemented by any type outside of this module,
 	// to allow the interface to expand in future to support new provider
@@ -30,9 +53,143 @@ type ManagedResourceType interface {
 // new protocol features, so no packages outside of this module should attempt
 // to implement it.
 type DataResourceType interface {
+	// Read asks the provider to retrieve the current state of the remote
+	// object described by the given configuration.
+	Read(context.Context, DataResourceReadRequest) (DataResourceReadResponse, Diagnostics)
+
 	// Sealed is a do-nothing method that exists only to represent that this
 	// interface may not be implemented by any type outside of this module,
 	// to allow the interface to expand in future to support new provider
 	// plugin protocol features.
 	Sealed() Sealed
 }
+
+// EphemeralResourceType represents an ephemeral resource type belonging to
+// a provider.
+//
+// An ephemeral resource is a remote object, such as a short-lived
+// credential, that exists only between calls to Open and Close and is never
+// saved in state.
+//
+// This interface will grow in future versions of this module to support
+// new protocol features, so no packages outside of this module should attempt
+// to implement it.
+type EphemeralResourceType interface {
+	// Open asks the provider to create or retrieve the remote object
+	// described by the given configuration.
+	Open(context.Context, EphemeralResourceOpenRequest) (EphemeralResourceOpenResponse, Diagnostics)
+
+	// Renew asks the provider to extend the lifetime of an object
+	// previously returned from Open. Callers should call Renew before the
+	// RenewAt time returned from Open or from the previous call to Renew.
+	Renew(context.Context, EphemeralResourceRenewRequest) (EphemeralResourceRenewResponse, Diagnostics)
+
+	// Close asks the provider to release an object previously returned
+	// from Open, such as by revoking a credential.
+	Close(context.Context, EphemeralResourceCloseRequest) Diagnostics
+
+	// Sealed is a do-nothing method that exists only to represent that this
+	// interface may not be implemented by any type outside of this module,
+	// to allow the interface to expand in future to support new provider
+	// plugin protocol features.
+	Sealed() Sealed
+}
+
+// ListResourceType represents a list resource type belonging to a
+// provider, which finds existing remote objects of the managed resource
+// type with the same name.
+//
+// This interface will grow in future versions of this module to support
+// new protocol features, so no packages outside of this module should attempt
+// to implement it.
+type ListResourceType interface {
+	// List asks the provider to find the existing remote objects that
+	// match the given configuration, returning the results as they arrive.
+	//
+	// The given context applies to the whole stream of results rather than
+	// just the call to start it. The caller must call Close on the returned
+	// results once it's done with them, unless the diagnostics have errors.
+	List(context.Context, ListResourceRequest) (ListResourceResults, Diagnostics)
+
+	// Sealed is a do-nothing method that exists only to represent that this
+	// interface may not be implemented by any type outside of this module,
+	// to allow the interface to expand in future to support new provider
+	// plugin protocol features.
+	Sealed() Sealed
+}
+
+// ListResourceResults is a stream of results from ListResourceType.List.
+//
+// The provider produces results only as fast as the caller consumes them
+// by calling Next, so a caller can process each result before asking for
+// the next without the results piling up in memory.
+type ListResourceResults interface {
+	// Next waits for the next result, returning true if there is one or
+	// false at the end of the stream. After Next returns true, Result
+	// returns the new result.
+	Next() bool
+
+	// Result returns the result that the most recent call to Next
+	// prepared.
+	Result() ListResourceResult
+
+	// Diagnostics returns any diagnostics that don't relate to a single
+	// result, such as an error that ended the stream early. They are
+	// complete only once Next has returned false.
+	Diagnostics() Diagnostics
+
+	// Close stops the stream, if it isn't already finished, and releases
+	// its resources. Next always returns false after Close is called.
+	Close()
+}
+
+// ActionType represents a provider-defined action, which is an imperative
+// operation such as invalidating a cache or rebooting a server.
+//
+// This interface will grow in future versions of this module to support
+// new protocol features, so no packages outside of this module should attempt
+// to implement it.
+type ActionType interface {
+	// Plan asks the provider whether it can invoke the action with the
+	// given configuration, without invoking it.
+	Plan(context.Context, ActionPlanRequest) (ActionPlanResponse, Diagnostics)
+
+	// Invoke asks the provider to carry out the action described by the
+	// given configuration, returning its progress as it happens.
+	//
+	// The given context applies to the whole invocation rather than just
+	// the call to start it. The caller must call Close on the returned
+	// invocation once it's done with it, unless the diagnostics have
+	// errors.
+	Invoke(context.Context, ActionInvokeRequest) (ActionInvocation, Diagnostics)
+
+	// Sealed is a do-nothing method that exists only to represent that this
+	// interface may not be implemented by any type outside of this module,
+	// to allow the interface to expand in future to support new provider
+	// plugin protocol features.
+	Sealed() Sealed
+}
+
+// ActionInvocation is an action in progress, as returned from
+// ActionType.Invoke, which reports a stream of progress messages until the
+// action completes.
+type ActionInvocation interface {
+	// Next waits for the next progress message, returning true if there is
+	// one or false once the action has completed. After Next returns true,
+	// Progress returns the new message.
+	Next() bool
+
+	// Progress returns the message that the most recent call to Next
+	// prepared.
+	Progress() string
+
+	// Diagnostics returns the outcome of the action, which is complete
+	// only once Next has returned false. The action succeeded if there
+	// are no error diagnostics.
+	Diagnostics() Diagnostics
+
+	// Close stops waiting for the action, if it hasn't already completed,
+	// and releases the invocation's resources. Next always returns false
+	// after Close is called.
+	Close()
+}
@@ -2,23 +2,51 @@ package common
 
 import (
 	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
 )
 
 type Schema struct {
-	ProviderConfig       *tfschema.Block
-	ManagedResourceTypes map[string]*ManagedResourceTypeSchema
-	DataResourceTypes    map[string]*DataResourceTypeSchema
+	ProviderConfig         *tfschema.Block
+	ManagedResourceTypes   map[string]*ManagedResourceTypeSchema
+	DataResourceTypes      map[string]*DataResourceTypeSchema
+	EphemeralResourceTypes map[string]*EphemeralResourceTypeSchema
+	ListResourceTypes      map[string]*ListResourceTypeSchema
+	ActionTypes            map[string]*ActionTypeSchema
+	Functions              map[string]*FunctionSchema
 }
 
 type ManagedResourceTypeSchema struct {
 	Version int64
 	Content *tfschema.Block
+
+	// WriteOnlyAttributes describes the attributes in Content that are
+	// write-only, or is nil if there are none.
+	WriteOnlyAttributes *WriteOnlyAttributes
+
+	// Identity describes the identity objects of the resource type, or is
+	// nil if the resource type doesn't have identities.
+	Identity *ResourceIdentitySchema
 }
 
 type DataResourceTypeSchema struct {
 	Content *tfschema.Block
 }
 
+type EphemeralResourceTypeSchema struct {
+	Content *tfschema.Block
+}
+
+// ListResourceTypeSchema describes the configuration of a list resource
+// type, which finds existing objects of the managed resource type with the
+// same name.
+type ListResourceTypeSchema struct {
+	Content *tfschema.Block
+}
+
+type ActionTypeSchema struct {
+	Content *tfschema.Block
+}
+
 func (s *Schema) HasManagedResourceType(name string) bool {
 	_, ok := s.ManagedResourceTypes[name]
 	return ok
@@ -28,3 +56,57 @@ func (s *Schema) HasDataResourceType(name string) bool {
 	_, ok := s.DataResourceTypes[name]
 	return ok
 }
+
+func (s *Schema) HasEphemeralResourceType(name string) bool {
+	_, ok := s.EphemeralResourceTypes[name]
+	return ok
+}
+
+func (s *Schema) HasListResourceType(name string) bool {
+	_, ok := s.ListResourceTypes[name]
+	return ok
+}
+
+func (s *Schema) HasActionType(name string) bool {
+	_, ok := s.ActionTypes[name]
+	return ok
+}
+
+func (s *Schema) HasFunction(name string) bool {
+	_, ok := s.Functions[name]
+	return ok
+}
+
+// FunctionSchema describes a provider-defined function.
+type FunctionSchema struct {
+	// Parameters are the function's positional parameters, in order.
+	Parameters []*FunctionParameter
+
+	// VariadicParameter, if not nil, accepts zero or more additional
+	// arguments after the positional parameters.
+	VariadicParameter *FunctionParameter
+
+	ReturnType cty.Type
+
+	Summary            string
+	Description        string
+	DeprecationMessage string
+}
+
+// FunctionParameter describes a single parameter of a provider-defined
+// function.
+type FunctionParameter struct {
+	Name string
+	Type cty.Type
+
+	// AllowNullValue means that the function accepts a null value for the
+	// parameter. Otherwise, a null argument is an error.
+	AllowNullValue bool
+
+	// AllowUnknownValues means that the function accepts unknown values for
+	// the parameter. Otherwise, the result is unknown without calling the
+	// function if the argument is unknown.
+	AllowUnknownValues bool
+
+	Description string
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"fmt"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// WriteOnlyAttributes describes which attributes of a block and of its
+// nested blocks are write-only.
+//
+// The value of a write-only attribute is sent to the provider as part of the
+// configuration, but is never returned by the provider and must never be
+// saved in state. A nil *WriteOnlyAttributes means that there are no
+// write-only attributes, and all of its methods accept a nil receiver.
+type WriteOnlyAttributes struct {
+	// Attributes are the names of the write-only attributes of the block
+	// itself.
+	Attributes map[string]bool
+
+	// BlockTypes describe the write-only attributes of nested blocks, for
+	// each nested block type that has any.
+	BlockTypes map[string]*WriteOnlyAttributes
+}
+
+// IsWriteOnly returns true if the given path, relative to an object of the
+// block type, refers to a write-only attribute.
+//
+// Index steps into the collections of nested blocks are ignored, so the
+// result is the same for every element of a nested block collection.
+func (w *WriteOnlyAttributes) IsWriteOnly(path cty.Path) bool {
+	for i, step := range path {
+		if w == nil {
+			return false
+		}
+		attr, ok := step.(cty.GetAttrStep)
+		if !ok {
+			continue
+		}
+		if w.Attributes[attr.Name] {
+			return i == len(path)-1
+		}
+		w = w.BlockTypes[attr.Name]
+	}
+	return false
+}
+
+// Strip returns the given value with all of its write-only attributes set
+// to null, ready to be saved in state.
+func (w *WriteOnlyAttributes) Strip(val cty.Value) cty.Value {
+	if w == nil {
+		return val
+	}
+	ret, _ := cty.Transform(val, func(path cty.Path, v cty.Value) (cty.Value, error) {
+		if w.IsWriteOnly(path) {
+			return cty.NullVal(v.Type()), nil
+		}
+		return v, nil
+	})
+	return ret
+}
+
+// NonNullPaths returns the paths of any write-only attributes that have
+// non-null values in the given value.
+func (w *WriteOnlyAttributes) NonNullPaths(val cty.Value) []cty.Path {
+	if w == nil {
+		return nil
+	}
+	var ret []cty.Path
+	cty.Walk(val, func(path cty.Path, v cty.Value) (bool, error) {
+		if w.IsWriteOnly(path) {
+			if !v.IsNull() {
+				ret = append(ret, path.Copy())
+			}
+			return false, nil
+		}
+		return true, nil
+	})
+	return ret
+}
+
+// WriteOnlyDiagnostics returns an error diagnostic for each write-only
+// attribute that has a non-null value in the given value, which the provider
+// returned as the result of the given action on an object of the given
+// resource type.
+func WriteOnlyDiagnostics(w *WriteOnlyAttributes, val cty.Value, action, typeName string) Diagnostics {
+	var diags Diagnostics
+	for _, path := range w.NonNullPaths(val) {
+		diags = append(diags, Diagnostic{
+			Severity:  Error,
+			Summary:   "Provider returned invalid result object",
+			Detail:    fmt.Sprintf("The provider returned a value for write-only attribute %s after %s %s. Write-only attributes must always be null in results. This is a bug in the provider.", FormatCtyPath(path), action, typeName),
+			Attribute: path,
+			Cause:     ErrInvalidResponse,
+		})
+	}
+	return diags
+}
@@ -0,0 +1,91 @@
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+var testWriteOnly = &WriteOnlyAttributes{
+	Attributes: map[string]bool{"password": true},
+	BlockTypes: map[string]*WriteOnlyAttributes{
+		"rule": {
+			Attributes: map[string]bool{"token": true},
+		},
+	},
+}
+
+func testWriteOnlyValue(password, token cty.Value) cty.Value {
+	return cty.ObjectVal(map[string]cty.Value{
+		"name":     cty.StringVal("a"),
+		"password": password,
+		"rule": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"port":  cty.NumberIntVal(22),
+				"token": token,
+			}),
+		}),
+	})
+}
+
+func TestWriteOnlyAttributesIsWriteOnly(t *testing.T) {
+	tests := []struct {
+		path cty.Path
+		want bool
+	}{
+		{cty.GetAttrPath("password"), true},
+		{cty.GetAttrPath("name"), false},
+		{cty.GetAttrPath("rule"), false},
+		{cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("token"), true},
+		{cty.GetAttrPath("rule").Index(cty.NumberIntVal(0)).GetAttr("port"), false},
+		{cty.GetAttrPath("password").GetAttr("inner"), false},
+		{cty.GetAttrPath("other").GetAttr("token"), false},
+	}
+	for _, test := range tests {
+		if got := testWriteOnly.IsWriteOnly(test.path); got != test.want {
+			t.Errorf("wrong result for %s: got %t, want %t", FormatCtyPath(test.path), got, test.want)
+		}
+	}
+
+	var none *WriteOnlyAttributes
+	if none.IsWriteOnly(cty.GetAttrPath("password")) {
+		t.Error("nil WriteOnlyAttributes has a write-only attribute")
+	}
+}
+
+func TestWriteOnlyAttributesStrip(t *testing.T) {
+	val := testWriteOnlyValue(cty.StringVal("hunter2"), cty.StringVal("t0ken"))
+	got := testWriteOnly.Strip(val)
+	want := testWriteOnlyValue(cty.NullVal(cty.String), cty.NullVal(cty.String))
+	if !got.RawEquals(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+
+	var none *WriteOnlyAttributes
+	if got := none.Strip(val); !got.RawEquals(val) {
+		t.Errorf("nil WriteOnlyAttributes changed the value to %#v", got)
+	}
+}
+
+func TestWriteOnlyDiagnostics(t *testing.T) {
+	clean := testWriteOnlyValue(cty.NullVal(cty.String), cty.NullVal(cty.String))
+	if diags := WriteOnlyDiagnostics(testWriteOnly, clean, "applying", "test_thing"); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics for value without write-only values: %s", diags.Err())
+	}
+
+	val := testWriteOnlyValue(cty.NullVal(cty.String), cty.StringVal("t0ken"))
+	diags := WriteOnlyDiagnostics(testWriteOnly, val, "applying", "test_thing")
+	if len(diags) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1", len(diags))
+	}
+	if got, want := FormatCtyPath(diags[0].Attribute), ".rule[0].token"; got != want {
+		t.Errorf("wrong attribute %s; want %s", got, want)
+	}
+	if want := "The provider returned a value for write-only attribute .rule[0].token after applying test_thing. Write-only attributes must always be null in results. This is a bug in the provider."; diags[0].Detail != want {
+		t.Errorf("wrong detail\ngot:  %s\nwant: %s", diags[0].Detail, want)
+	}
+	if !errors.Is(diags.Err(), ErrInvalidResponse) {
+		t.Error("error doesn't match ErrInvalidResponse")
+	}
+}
@@ -0,0 +1,139 @@
+package protocol5
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// fakeActionClient is a provider client whose InvokeAction stream returns
+// the given events followed by the given error, or io.EOF if it's nil.
+type fakeActionClient struct {
+	tfplugin5.ProviderClient
+
+	events []*tfplugin5.InvokeAction_Event
+	err    error
+
+	req *tfplugin5.InvokeAction_Request
+	ctx context.Context
+}
+
+func (c *fakeActionClient) InvokeAction(ctx context.Context, req *tfplugin5.InvokeAction_Request, opts ...grpc.CallOption) (tfplugin5.Provider_InvokeActionClient, error) {
+	c.req = req
+	c.ctx = ctx
+	return &fakeActionStream{client: c}, nil
+}
+
+type fakeActionStream struct {
+	grpc.ClientStream
+	client *fakeActionClient
+	next   int
+}
+
+func (s *fakeActionStream) Recv() (*tfplugin5.InvokeAction_Event, error) {
+	if s.next < len(s.client.events) {
+		ev := s.client.events[s.next]
+		s.next++
+		return ev, nil
+	}
+	if s.client.err != nil {
+		return nil, s.client.err
+	}
+	return nil, io.EOF
+}
+
+func progressEvent(msg string) *tfplugin5.InvokeAction_Event {
+	return &tfplugin5.InvokeAction_Event{
+		Type: &tfplugin5.InvokeAction_Event_Progress_{
+			Progress: &tfplugin5.InvokeAction_Event_Progress{Message: msg},
+		},
+	}
+}
+
+func testActionType(client tfplugin5.ProviderClient) *ActionType {
+	return &ActionType{
+		client:   client,
+		typeName: "test_action",
+		schema: &common.ActionTypeSchema{
+			Content: &tfschema.Block{},
+		},
+	}
+}
+
+func TestActionTypeInvoke(t *testing.T) {
+	client := &fakeActionClient{
+		events: []*tfplugin5.InvokeAction_Event{
+			progressEvent("starting"),
+			progressEvent("halfway"),
+			{
+				Type: &tfplugin5.InvokeAction_Event_Completed_{
+					Completed: &tfplugin5.InvokeAction_Event_Completed{
+						Diagnostics: []*tfplugin5.Diagnostic{
+							{Severity: tfplugin5.Diagnostic_ERROR, Summary: "Action failed"},
+						},
+					},
+				},
+			},
+		},
+	}
+	at := testActionType(client)
+
+	inv, diags := at.Invoke(context.Background(), common.ActionInvokeRequest{
+		Config: cty.EmptyObjectVal,
+	})
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if client.req.ActionType != "test_action" {
+		t.Errorf("wrong action type %q", client.req.ActionType)
+	}
+
+	var progress []string
+	for inv.Next() {
+		progress = append(progress, inv.Progress())
+	}
+	if len(progress) != 2 || progress[0] != "starting" || progress[1] != "halfway" {
+		t.Errorf("wrong progress messages %q", progress)
+	}
+	if diags := inv.Diagnostics(); !diags.HasErrors() || diags[0].Summary != "Action failed" {
+		t.Errorf("wrong diagnostics %#v", diags)
+	}
+	if client.ctx.Err() == nil {
+		t.Error("stream context not cancelled once the action completed")
+	}
+}
+
+func TestActionTypeInvokeError(t *testing.T) {
+	client := &fakeActionClient{
+		events: []*tfplugin5.InvokeAction_Event{
+			progressEvent("starting"),
+		},
+		err: grpcStatus.Error(codes.Unavailable, "transport is closing"),
+	}
+	at := testActionType(client)
+
+	inv, _ := at.Invoke(context.Background(), common.ActionInvokeRequest{
+		Config: cty.EmptyObjectVal,
+	})
+	if !inv.Next() {
+		t.Fatal("no first progress message")
+	}
+	if inv.Next() {
+		t.Fatal("Next returned true for a failed stream")
+	}
+	if !inv.Diagnostics().HasErrors() {
+		t.Error("no error diagnostics for a failed stream")
+	}
+	if client.ctx.Err() == nil {
+		t.Error("stream context not cancelled after an error")
+	}
+}
@@ -0,0 +1,46 @@
+package protocol5
+
+import (
+	"context"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+type DataResourceType struct {
+	client   tfplugin5.ProviderClient
+	typeName string
+	schema   *common.DataResourceTypeSchema
+}
+
+func (rt *DataResourceType) Read(ctx context.Context, req common.DataResourceReadRequest) (common.DataResourceReadResponse, common.Diagnostics) {
+	resp := common.DataResourceReadResponse{}
+	dv, diags := encodeDynamicValue(req.Config, rt.schema.Content)
+	if diags.HasErrors() {
+		return resp, diags
+	}
+
+	rawResp, err := rt.client.ReadDataSource(ctx, &tfplugin5.ReadDataSource_Request{
+		TypeName:           rt.typeName,
+		Config:             dv,
+		ClientCapabilities: encodeClientCapabilities(req.ClientCapabilities),
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+	resp.Deferred = decodeDeferred(rawResp.Deferred)
+	diags = append(diags, common.DeferralDiagnostics(resp.Deferred, req.ClientCapabilities)...)
+
+	if raw := rawResp.State; raw != nil {
+		v, moreDiags := decodeDynamicValue(raw, rt.schema.Content)
+		resp.State = v
+		diags = append(diags, moreDiags...)
+	}
+	return resp, diags
+}
+
+func (rt *DataResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
@@ -0,0 +1,31 @@
+package protocol5
+
+import (
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+func encodeClientCapabilities(caps common.ClientCapabilities) *tfplugin5.ClientCapabilities {
+	return &tfplugin5.ClientCapabilities{
+		DeferralAllowed:            caps.DeferralAllowed,
+		WriteOnlyAttributesAllowed: caps.WriteOnlyAttributesAllowed,
+	}
+}
+
+func decodeDeferred(raw *tfplugin5.Deferred) *common.Deferred {
+	if raw == nil {
+		return nil
+	}
+	ret := &common.Deferred{}
+	switch raw.Reason {
+	case tfplugin5.Deferred_RESOURCE_CONFIG_UNKNOWN:
+		ret.Reason = common.DeferralReasonResourceConfigUnknown
+	case tfplugin5.Deferred_PROVIDER_CONFIG_UNKNOWN:
+		ret.Reason = common.DeferralReasonProviderConfigUnknown
+	case tfplugin5.Deferred_ABSENT_PREREQ:
+		ret.Reason = common.DeferralReasonAbsentPrerequisite
+	default:
+		ret.Reason = common.DeferralReasonUnknown
+	}
+	return ret
+}
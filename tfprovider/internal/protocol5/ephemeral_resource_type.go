@@ -0,0 +1,100 @@
+package protocol5
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+type EphemeralResourceType struct {
+	client   tfplugin5.ProviderClient
+	typeName string
+	schema   *common.EphemeralResourceTypeSchema
+}
+
+func (rt *EphemeralResourceType) Open(ctx context.Context, req common.EphemeralResourceOpenRequest) (common.EphemeralResourceOpenResponse, common.Diagnostics) {
+	resp := common.EphemeralResourceOpenResponse{}
+	dv, diags := encodeDynamicValue(req.Config, rt.schema.Content)
+	if diags.HasErrors() {
+		return resp, diags
+	}
+
+	rawResp, err := rt.client.OpenEphemeralResource(ctx, &tfplugin5.OpenEphemeralResource_Request{
+		TypeName:           rt.typeName,
+		Config:             dv,
+		ClientCapabilities: encodeClientCapabilities(req.ClientCapabilities),
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+	resp.Deferred = decodeDeferred(rawResp.Deferred)
+	diags = append(diags, common.DeferralDiagnostics(resp.Deferred, req.ClientCapabilities)...)
+
+	if raw := rawResp.Result; raw != nil {
+		v, moreDiags := decodeDynamicValue(raw, rt.schema.Content)
+		resp.Result = v
+		diags = append(diags, moreDiags...)
+	}
+	resp.OpaquePrivate = rawResp.GetPrivate()
+	resp.RenewAt = decodeTimestamp(rawResp.GetRenewAt())
+	return resp, diags
+}
+
+func (rt *EphemeralResourceType) Renew(ctx context.Context, req common.EphemeralResourceRenewRequest) (common.EphemeralResourceRenewResponse, common.Diagnostics) {
+	resp := common.EphemeralResourceRenewResponse{}
+	rawReq := &tfplugin5.RenewEphemeralResource_Request{
+		TypeName: rt.typeName,
+	}
+	if req.OpaquePrivate != nil {
+		rawReq.XPrivate = &tfplugin5.RenewEphemeralResource_Request_Private{Private: req.OpaquePrivate}
+	}
+	rawResp, err := rt.client.RenewEphemeralResource(ctx, rawReq)
+	diags := common.RPCErrorDiagnostics(err)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+
+	resp.OpaquePrivate = rawResp.GetPrivate()
+	resp.RenewAt = decodeTimestamp(rawResp.GetRenewAt())
+	return resp, diags
+}
+
+func (rt *EphemeralResourceType) Close(ctx context.Context, req common.EphemeralResourceCloseRequest) common.Diagnostics {
+	rawReq := &tfplugin5.CloseEphemeralResource_Request{
+		TypeName: rt.typeName,
+	}
+	if req.OpaquePrivate != nil {
+		rawReq.XPrivate = &tfplugin5.CloseEphemeralResource_Request_Private{Private: req.OpaquePrivate}
+	}
+	rawResp, err := rt.client.CloseEphemeralResource(ctx, rawReq)
+	diags := common.RPCErrorDiagnostics(err)
+	if err != nil {
+		return diags
+	}
+	return append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+}
+
+func (rt *EphemeralResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// decodeTimestamp returns the time represented by the given timestamp, or
+// the zero time if it is nil or invalid.
+func decodeTimestamp(raw *timestamp.Timestamp) time.Time {
+	if raw == nil {
+		return time.Time{}
+	}
+	t, err := ptypes.Timestamp(raw)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,179 @@
+package protocol5
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+type ListResourceType struct {
+	client   tfplugin5.ProviderClient
+	typeName string
+	schema   *common.ListResourceTypeSchema
+
+	// resourceSchema is the schema of the managed resource type with the
+	// same name, which the results conform to.
+	resourceSchema *common.ManagedResourceTypeSchema
+}
+
+func (rt *ListResourceType) List(ctx context.Context, req common.ListResourceRequest) (common.ListResourceResults, common.Diagnostics) {
+	dv, diags := encodeDynamicValue(req.Config, rt.schema.Content)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := rt.client.ListResource(ctx, &tfplugin5.ListResource_Request{
+		TypeName:              rt.typeName,
+		Config:                dv,
+		IncludeResourceObject: req.IncludeResourceObject,
+		Limit:                 req.Limit,
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		cancel()
+		return nil, diags
+	}
+	return &listResourceResults{
+		rt:     rt,
+		stream: stream,
+		cancel: cancel,
+	}, diags
+}
+
+func (rt *ListResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// listResourceResults is the implementation of common.ListResourceResults,
+// which receives each event from the stream only when the caller asks for
+// the next result.
+type listResourceResults struct {
+	rt     *ListResourceType
+	stream tfplugin5.Provider_ListResourceClient
+	cancel context.CancelFunc
+
+	result common.ListResourceResult
+	diags  common.Diagnostics
+	done   bool
+}
+
+func (r *listResourceResults) Next() bool {
+	for !r.done {
+		ev, err := r.stream.Recv()
+		if err == io.EOF {
+			r.Close()
+			return false
+		}
+		if err != nil {
+			r.diags = append(r.diags, common.RPCErrorDiagnostics(err)...)
+			r.Close()
+			return false
+		}
+
+		diags := decodeDiagnostics(ev.Diagnostic)
+		if ev.Identity == nil && ev.GetResourceObject() == nil {
+			// Providers report problems that don't relate to any
+			// particular object as events with only diagnostics.
+			r.diags = append(r.diags, diags...)
+			continue
+		}
+
+		result := common.ListResourceResult{
+			DisplayName: ev.DisplayName,
+		}
+		var moreDiags common.Diagnostics
+		result.Identity, moreDiags = decodeIdentity(ev.Identity, r.rt.resourceSchema.Identity)
+		diags = append(diags, moreDiags...)
+		if raw := ev.GetResourceObject(); raw != nil {
+			result.Object, moreDiags = decodeDynamicValue(raw, r.rt.resourceSchema.Content)
+			diags = append(diags, moreDiags...)
+		}
+		result.Diagnostics = diags
+		r.result = result
+		return true
+	}
+	return false
+}
+
+func (r *listResourceResults) Result() common.ListResourceResult {
+	return r.result
+}
+
+func (r *listResourceResults) Diagnostics() common.Diagnostics {
+	return r.diags
+}
+
+func (r *listResourceResults) Close() {
+	r.done = true
+	r.cancel()
+}
+
+func (p *Provider) ValidateListResourceConfig(ctx context.Context, typeName string, req common.ListResourceValidateRequest) common.Diagnostics {
+	schema, diags := p.schema.listResourceType(ctx, typeName)
+	if diags.HasErrors() {
+		return diags
+	}
+	if schema == nil {
+		return common.Diagnostics{
+			{
+				Severity: common.Error,
+				Summary:  "Unsupported list resource type",
+				Detail:   fmt.Sprintf("This provider does not support list resource type %q.", typeName),
+				Cause:    common.ErrUnknownType,
+			},
+		}
+	}
+	rawReq := &tfplugin5.ValidateListResourceConfig_Request{
+		TypeName: typeName,
+	}
+	var moreDiags common.Diagnostics
+	rawReq.Config, moreDiags = encodeDynamicValue(req.Config, schema.Content)
+	diags = append(diags, moreDiags...)
+	if !req.IncludeResourceObject.IsNull() {
+		rawReq.IncludeResourceObject, moreDiags = encodeDynamicValueType(req.IncludeResourceObject, cty.Bool)
+		diags = append(diags, moreDiags...)
+	}
+	if !req.Limit.IsNull() {
+		rawReq.Limit, moreDiags = encodeDynamicValueType(req.Limit, cty.Number)
+		diags = append(diags, moreDiags...)
+	}
+	if diags.HasErrors() {
+		return diags
+	}
+
+	resp, err := p.client.ValidateListResourceConfig(ctx, rawReq)
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return diags
+	}
+	diags = append(diags, decodeDiagnostics(resp.Diagnostics)...)
+	return diags
+}
+
+func (p *Provider) ListResourceType(typeName string) common.ListResourceType {
+	if !p.isConfigured() {
+		return nil
+	}
+
+	// As for ManagedResourceType, the schema was retrieved by Configure.
+	schema, _ := p.schema.listResourceType(context.Background(), typeName)
+	if schema == nil {
+		return nil
+	}
+	resourceSchema, _ := p.schema.managedResourceType(context.Background(), typeName)
+	if resourceSchema == nil {
+		return nil
+	}
+	return &ListResourceType{
+		client:         p.client,
+		typeName:       typeName,
+		schema:         schema,
+		resourceSchema: resourceSchema,
+	}
+}
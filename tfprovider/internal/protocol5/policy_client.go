@@ -0,0 +1,293 @@
+package protocol5
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// policyClient is an implementation of tfplugin5.ProviderClient that makes
+// each call through a common.CallRunner, so that all calls are subject to
+// the caller's call policy.
+type policyClient struct {
+	client tfplugin5.ProviderClient
+	runner *common.CallRunner
+}
+
+var _ tfplugin5.ProviderClient = (*policyClient)(nil)
+
+func (c *policyClient) GetMetadata(ctx context.Context, in *tfplugin5.GetMetadata_Request, opts ...grpc.CallOption) (*tfplugin5.GetMetadata_Response, error) {
+	var resp *tfplugin5.GetMetadata_Response
+	err := c.runner.Call(ctx, "GetMetadata", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetMetadata(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) GetSchema(ctx context.Context, in *tfplugin5.GetProviderSchema_Request, opts ...grpc.CallOption) (*tfplugin5.GetProviderSchema_Response, error) {
+	var resp *tfplugin5.GetProviderSchema_Response
+	err := c.runner.Call(ctx, "GetSchema", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetSchema(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) GetResourceIdentitySchemas(ctx context.Context, in *tfplugin5.GetResourceIdentitySchemas_Request, opts ...grpc.CallOption) (*tfplugin5.GetResourceIdentitySchemas_Response, error) {
+	var resp *tfplugin5.GetResourceIdentitySchemas_Response
+	err := c.runner.Call(ctx, "GetResourceIdentitySchemas", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetResourceIdentitySchemas(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) PrepareProviderConfig(ctx context.Context, in *tfplugin5.PrepareProviderConfig_Request, opts ...grpc.CallOption) (*tfplugin5.PrepareProviderConfig_Response, error) {
+	var resp *tfplugin5.PrepareProviderConfig_Response
+	err := c.runner.Call(ctx, "PrepareProviderConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.PrepareProviderConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateResourceTypeConfig(ctx context.Context, in *tfplugin5.ValidateResourceTypeConfig_Request, opts ...grpc.CallOption) (*tfplugin5.ValidateResourceTypeConfig_Response, error) {
+	var resp *tfplugin5.ValidateResourceTypeConfig_Response
+	err := c.runner.Call(ctx, "ValidateResourceTypeConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateResourceTypeConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateDataSourceConfig(ctx context.Context, in *tfplugin5.ValidateDataSourceConfig_Request, opts ...grpc.CallOption) (*tfplugin5.ValidateDataSourceConfig_Response, error) {
+	var resp *tfplugin5.ValidateDataSourceConfig_Response
+	err := c.runner.Call(ctx, "ValidateDataSourceConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateDataSourceConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) UpgradeResourceState(ctx context.Context, in *tfplugin5.UpgradeResourceState_Request, opts ...grpc.CallOption) (*tfplugin5.UpgradeResourceState_Response, error) {
+	var resp *tfplugin5.UpgradeResourceState_Response
+	err := c.runner.Call(ctx, "UpgradeResourceState", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.UpgradeResourceState(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) UpgradeResourceIdentity(ctx context.Context, in *tfplugin5.UpgradeResourceIdentity_Request, opts ...grpc.CallOption) (*tfplugin5.UpgradeResourceIdentity_Response, error) {
+	var resp *tfplugin5.UpgradeResourceIdentity_Response
+	err := c.runner.Call(ctx, "UpgradeResourceIdentity", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.UpgradeResourceIdentity(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) Configure(ctx context.Context, in *tfplugin5.Configure_Request, opts ...grpc.CallOption) (*tfplugin5.Configure_Response, error) {
+	var resp *tfplugin5.Configure_Response
+	err := c.runner.Call(ctx, "Configure", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.Configure(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ReadResource(ctx context.Context, in *tfplugin5.ReadResource_Request, opts ...grpc.CallOption) (*tfplugin5.ReadResource_Response, error) {
+	var resp *tfplugin5.ReadResource_Response
+	err := c.runner.Call(ctx, "ReadResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ReadResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) PlanResourceChange(ctx context.Context, in *tfplugin5.PlanResourceChange_Request, opts ...grpc.CallOption) (*tfplugin5.PlanResourceChange_Response, error) {
+	var resp *tfplugin5.PlanResourceChange_Response
+	err := c.runner.Call(ctx, "PlanResourceChange", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.PlanResourceChange(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ApplyResourceChange(ctx context.Context, in *tfplugin5.ApplyResourceChange_Request, opts ...grpc.CallOption) (*tfplugin5.ApplyResourceChange_Response, error) {
+	var resp *tfplugin5.ApplyResourceChange_Response
+	err := c.runner.Call(ctx, "ApplyResourceChange", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ApplyResourceChange(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ImportResourceState(ctx context.Context, in *tfplugin5.ImportResourceState_Request, opts ...grpc.CallOption) (*tfplugin5.ImportResourceState_Response, error) {
+	var resp *tfplugin5.ImportResourceState_Response
+	err := c.runner.Call(ctx, "ImportResourceState", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ImportResourceState(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) MoveResourceState(ctx context.Context, in *tfplugin5.MoveResourceState_Request, opts ...grpc.CallOption) (*tfplugin5.MoveResourceState_Response, error) {
+	var resp *tfplugin5.MoveResourceState_Response
+	err := c.runner.Call(ctx, "MoveResourceState", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.MoveResourceState(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ReadDataSource(ctx context.Context, in *tfplugin5.ReadDataSource_Request, opts ...grpc.CallOption) (*tfplugin5.ReadDataSource_Response, error) {
+	var resp *tfplugin5.ReadDataSource_Response
+	err := c.runner.Call(ctx, "ReadDataSource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ReadDataSource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateEphemeralResourceConfig(ctx context.Context, in *tfplugin5.ValidateEphemeralResourceConfig_Request, opts ...grpc.CallOption) (*tfplugin5.ValidateEphemeralResourceConfig_Response, error) {
+	var resp *tfplugin5.ValidateEphemeralResourceConfig_Response
+	err := c.runner.Call(ctx, "ValidateEphemeralResourceConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateEphemeralResourceConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) OpenEphemeralResource(ctx context.Context, in *tfplugin5.OpenEphemeralResource_Request, opts ...grpc.CallOption) (*tfplugin5.OpenEphemeralResource_Response, error) {
+	var resp *tfplugin5.OpenEphemeralResource_Response
+	err := c.runner.Call(ctx, "OpenEphemeralResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.OpenEphemeralResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) RenewEphemeralResource(ctx context.Context, in *tfplugin5.RenewEphemeralResource_Request, opts ...grpc.CallOption) (*tfplugin5.RenewEphemeralResource_Response, error) {
+	var resp *tfplugin5.RenewEphemeralResource_Response
+	err := c.runner.Call(ctx, "RenewEphemeralResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.RenewEphemeralResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) CloseEphemeralResource(ctx context.Context, in *tfplugin5.CloseEphemeralResource_Request, opts ...grpc.CallOption) (*tfplugin5.CloseEphemeralResource_Response, error) {
+	var resp *tfplugin5.CloseEphemeralResource_Response
+	err := c.runner.Call(ctx, "CloseEphemeralResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.CloseEphemeralResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateListResourceConfig(ctx context.Context, in *tfplugin5.ValidateListResourceConfig_Request, opts ...grpc.CallOption) (*tfplugin5.ValidateListResourceConfig_Response, error) {
+	var resp *tfplugin5.ValidateListResourceConfig_Response
+	err := c.runner.Call(ctx, "ValidateListResourceConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateListResourceConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) GetFunctions(ctx context.Context, in *tfplugin5.GetFunctions_Request, opts ...grpc.CallOption) (*tfplugin5.GetFunctions_Response, error) {
+	var resp *tfplugin5.GetFunctions_Response
+	err := c.runner.Call(ctx, "GetFunctions", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetFunctions(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) CallFunction(ctx context.Context, in *tfplugin5.CallFunction_Request, opts ...grpc.CallOption) (*tfplugin5.CallFunction_Response, error) {
+	var resp *tfplugin5.CallFunction_Response
+	err := c.runner.Call(ctx, "CallFunction", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.CallFunction(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateActionConfig(ctx context.Context, in *tfplugin5.ValidateActionConfig_Request, opts ...grpc.CallOption) (*tfplugin5.ValidateActionConfig_Response, error) {
+	var resp *tfplugin5.ValidateActionConfig_Response
+	err := c.runner.Call(ctx, "ValidateActionConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateActionConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) PlanAction(ctx context.Context, in *tfplugin5.PlanAction_Request, opts ...grpc.CallOption) (*tfplugin5.PlanAction_Response, error) {
+	var resp *tfplugin5.PlanAction_Response
+	err := c.runner.Call(ctx, "PlanAction", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.PlanAction(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) Stop(ctx context.Context, in *tfplugin5.Stop_Request, opts ...grpc.CallOption) (*tfplugin5.Stop_Response, error) {
+	var resp *tfplugin5.Stop_Response
+	err := c.runner.Call(ctx, "Stop", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.Stop(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ListResource(ctx context.Context, in *tfplugin5.ListResource_Request, opts ...grpc.CallOption) (tfplugin5.Provider_ListResourceClient, error) {
+	var stream tfplugin5.Provider_ListResourceClient
+	err := c.runner.Stream(ctx, "ListResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		stream, err = c.client.ListResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &policyListResourceClient{stream, c.runner}, nil
+}
+
+// policyListResourceClient passes errors from receiving on the stream
+// through the runner of the policyClient that opened it.
+type policyListResourceClient struct {
+	tfplugin5.Provider_ListResourceClient
+	runner *common.CallRunner
+}
+
+func (s *policyListResourceClient) Recv() (*tfplugin5.ListResource_Event, error) {
+	ev, err := s.Provider_ListResourceClient.Recv()
+	return ev, s.runner.StreamError("ListResource", err)
+}
+
+func (c *policyClient) InvokeAction(ctx context.Context, in *tfplugin5.InvokeAction_Request, opts ...grpc.CallOption) (tfplugin5.Provider_InvokeActionClient, error) {
+	var stream tfplugin5.Provider_InvokeActionClient
+	err := c.runner.Stream(ctx, "InvokeAction", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		stream, err = c.client.InvokeAction(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &policyInvokeActionClient{stream, c.runner}, nil
+}
+
+// policyInvokeActionClient passes errors from receiving on the stream
+// through the runner of the policyClient that opened it.
+type policyInvokeActionClient struct {
+	tfplugin5.Provider_InvokeActionClient
+	runner *common.CallRunner
+}
+
+func (s *policyInvokeActionClient) Recv() (*tfplugin5.InvokeAction_Event, error) {
+	ev, err := s.Provider_InvokeActionClient.Recv()
+	return ev, s.runner.StreamError("InvokeAction", err)
+}
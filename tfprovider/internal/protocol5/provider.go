@@ -2,41 +2,65 @@ package protocol5
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"sync"
 
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+
 	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
 	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
 	"github.com/zclconf/go-cty/cty"
-	"go.rpcplugin.org/rpcplugin"
 )
 
 // Provider is the implementation of tfprovider.Provider for provider plugin
 // protocol version 5.
 type Provider struct {
 	client tfplugin5.ProviderClient
-	plugin *rpcplugin.Plugin
-	schema *common.Schema
+	runner *common.CallRunner
+	plugin io.Closer
+	schema *providerSchema
+	caps   common.Capabilities
+
+	// closers are called before closing the plugin, to release any
+	// objects that depend on it.
+	closers common.Closers
 
 	configured   bool
 	configuredMu *sync.Mutex
 }
 
-func NewProvider(ctx context.Context, plugin *rpcplugin.Plugin, clientProxy interface{}) (*Provider, error) {
+// NewProvider returns a provider that makes calls using the given client
+// proxy. Close closes the given plugin, which is usually the
+// *rpcplugin.Plugin that the client proxy belongs to.
+func NewProvider(ctx context.Context, plugin io.Closer, clientProxy interface{}) (*Provider, error) {
 	client := clientProxy.(tfplugin5.ProviderClient)
 
-	// We proactively fetch the schema here because you can't really do anything
-	// useful to a provider without it: we need it to serialize any values given
-	// in msgpack format.
-	schema, err := loadSchema(ctx, client)
+	// We prepare the schema here because you can't really do anything useful
+	// to a provider without it: we need it to serialize any values given in
+	// msgpack format. The schema is only retrieved here if the provider
+	// requires that, and is decoded lazily either way.
+	schema, caps, err := loadSchema(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
+	// The client is normally a policyClient, whose runner keeps track of
+	// which RPCs the provider has reported as unimplemented.
+	var runner *common.CallRunner
+	if pc, ok := client.(*policyClient); ok {
+		runner = pc.runner
+	}
+
 	return &Provider{
-		client:     client,
-		plugin:     plugin,
-		schema:     schema,
-		configured: false,
+		client: client,
+		runner: runner,
+		plugin: plugin,
+		schema: schema,
+		caps:   caps,
+
+		configured:   false,
+		configuredMu: new(sync.Mutex),
 	}, nil
 }
 
@@ -44,29 +68,67 @@ func (p *Provider) Sealed() common.Sealed {
 	return common.Sealed{}
 }
 
+func (p *Provider) Capabilities() common.Capabilities {
+	return p.caps
+}
+
 func (p *Provider) Schema(ctx context.Context) (*common.Schema, common.Diagnostics) {
-	return p.schema, nil
+	return p.schema.all(ctx)
+}
+
+// ProviderConfigSchema returns the schema of the provider's configuration,
+// retrieving the provider's schema first if necessary.
+//
+// This and the other methods that return the schema of a single type avoid
+// decoding the whole schema, unlike Schema, and return nil if the provider
+// has no such type.
+func (p *Provider) ProviderConfigSchema(ctx context.Context) (*tfschema.Block, common.Diagnostics) {
+	return p.schema.providerConfig(ctx)
+}
+
+func (p *Provider) ManagedResourceTypeSchema(ctx context.Context, typeName string) (*common.ManagedResourceTypeSchema, common.Diagnostics) {
+	return p.schema.managedResourceType(ctx, typeName)
+}
+
+func (p *Provider) DataResourceTypeSchema(ctx context.Context, typeName string) (*common.DataResourceTypeSchema, common.Diagnostics) {
+	return p.schema.dataResourceType(ctx, typeName)
+}
+
+func (p *Provider) EphemeralResourceTypeSchema(ctx context.Context, typeName string) (*common.EphemeralResourceTypeSchema, common.Diagnostics) {
+	return p.schema.ephemeralResourceType(ctx, typeName)
+}
+
+func (p *Provider) ListResourceTypeSchema(ctx context.Context, typeName string) (*common.ListResourceTypeSchema, common.Diagnostics) {
+	return p.schema.listResourceType(ctx, typeName)
+}
+
+func (p *Provider) ActionTypeSchema(ctx context.Context, typeName string) (*common.ActionTypeSchema, common.Diagnostics) {
+	return p.schema.actionType(ctx, typeName)
 }
 
 func (p *Provider) PrepareConfig(ctx context.Context, config cty.Value) (common.Config, common.Diagnostics) {
-	dv, diags := encodeDynamicValue(config, p.schema.ProviderConfig)
+	configSchema, diags := p.schema.providerConfig(ctx)
+	if diags.HasErrors() {
+		return common.Config{Value: config}, diags
+	}
+	dv, diags := encodeDynamicValue(config, configSchema)
 	if diags.HasErrors() {
-		return common.Config{config}, diags
+		return common.Config{Value: config}, diags
 	}
 	resp, err := p.client.PrepareProviderConfig(ctx, &tfplugin5.PrepareProviderConfig_Request{
 		Config: dv,
 	})
 	diags = append(diags, common.RPCErrorDiagnostics(err)...)
 	if err != nil {
-		return common.Config{config}, diags
+		return common.Config{Value: config}, diags
 	}
 	diags = append(diags, decodeDiagnostics(resp.Diagnostics)...)
 	if raw := resp.PreparedConfig; raw != nil {
-		v, moreDiags := decodeDynamicValue(raw, p.schema.ProviderConfig)
+		v, moreDiags := decodeDynamicValue(raw, configSchema)
 		diags = append(diags, moreDiags...)
-		return common.Config{v}, diags
+		return common.Config{Value: v}, diags
 	}
-	return common.Config{cty.DynamicVal}, diags
+	return common.Config{Value: cty.DynamicVal}, diags
 }
 
 func (p *Provider) Configure(ctx context.Context, config common.Config) common.Diagnostics {
@@ -82,12 +144,17 @@ func (p *Provider) Configure(ctx context.Context, config common.Config) common.D
 		}
 	}
 
-	dv, diags := encodeDynamicValue(config.Value, p.schema.ProviderConfig)
+	configSchema, diags := p.schema.providerConfig(ctx)
+	if diags.HasErrors() {
+		return diags
+	}
+	dv, diags := encodeDynamicValue(config.Value, configSchema)
 	if diags.HasErrors() {
 		return diags
 	}
 	resp, err := p.client.Configure(ctx, &tfplugin5.Configure_Request{
-		Config: dv,
+		Config:             dv,
+		ClientCapabilities: encodeClientCapabilities(config.ClientCapabilities),
 	})
 	diags = append(diags, common.RPCErrorDiagnostics(err)...)
 	if err != nil {
@@ -101,12 +168,34 @@ func (p *Provider) Configure(ctx context.Context, config common.Config) common.D
 }
 
 func (p *Provider) ValidateManagedResourceConfig(ctx context.Context, typeName string, config cty.Value) common.Diagnostics {
-	dv, diags := encodeDynamicValue(config, p.schema.ProviderConfig)
+	schema, diags := p.schema.managedResourceType(ctx, typeName)
+	if diags.HasErrors() {
+		return diags
+	}
+	if schema == nil {
+		return common.Diagnostics{
+			{
+				Severity: common.Error,
+				Summary:  "Unsupported managed resource type",
+				Detail:   fmt.Sprintf("This provider does not support managed resource type %q.", typeName),
+				Cause:    common.ErrUnknownType,
+			},
+		}
+	}
+	dv, moreDiags := encodeDynamicValue(config, schema.Content)
+	diags = append(diags, moreDiags...)
 	if diags.HasErrors() {
 		return diags
 	}
 	resp, err := p.client.ValidateResourceTypeConfig(ctx, &tfplugin5.ValidateResourceTypeConfig_Request{
-		Config: dv,
+		TypeName: typeName,
+		Config:   dv,
+
+		// This client can handle write-only attributes, because it
+		// removes them from the results of Apply.
+		ClientCapabilities: encodeClientCapabilities(common.ClientCapabilities{
+			WriteOnlyAttributesAllowed: true,
+		}),
 	})
 	diags = append(diags, common.RPCErrorDiagnostics(err)...)
 	if err != nil {
@@ -117,12 +206,28 @@ func (p *Provider) ValidateManagedResourceConfig(ctx context.Context, typeName s
 }
 
 func (p *Provider) ValidateDataResourceConfig(ctx context.Context, typeName string, config cty.Value) common.Diagnostics {
-	dv, diags := encodeDynamicValue(config, p.schema.ProviderConfig)
+	schema, diags := p.schema.dataResourceType(ctx, typeName)
+	if diags.HasErrors() {
+		return diags
+	}
+	if schema == nil {
+		return common.Diagnostics{
+			{
+				Severity: common.Error,
+				Summary:  "Unsupported data resource type",
+				Detail:   fmt.Sprintf("This provider does not support data resource type %q.", typeName),
+				Cause:    common.ErrUnknownType,
+			},
+		}
+	}
+	dv, moreDiags := encodeDynamicValue(config, schema.Content)
+	diags = append(diags, moreDiags...)
 	if diags.HasErrors() {
 		return diags
 	}
 	resp, err := p.client.ValidateDataSourceConfig(ctx, &tfplugin5.ValidateDataSourceConfig_Request{
-		Config: dv,
+		TypeName: typeName,
+		Config:   dv,
 	})
 	diags = append(diags, common.RPCErrorDiagnostics(err)...)
 	if err != nil {
@@ -133,24 +238,115 @@ func (p *Provider) ValidateDataResourceConfig(ctx context.Context, typeName stri
 }
 
 func (p *Provider) ManagedResourceType(typeName string) common.ManagedResourceType {
-	p.configuredMu.Lock()
-	if !p.configured {
+	if !p.isConfigured() {
 		return nil
 	}
-	p.configuredMu.Unlock()
 
-	schema, ok := p.schema.ManagedResourceTypes[typeName]
-	if !ok {
+	// Configure can only succeed after retrieving the provider's schema,
+	// so this lookup only decodes the part of the schema for this type and
+	// never calls the provider, which is why it needs no real context and
+	// can't fail to retrieve the schema. Callers that need to tell a
+	// retrieval failure apart from an unknown type, such as Host, use
+	// ManagedResourceTypeSchema instead, which reports it.
+	schema, _ := p.schema.managedResourceType(context.Background(), typeName)
+	if schema == nil {
 		return nil
 	}
 	return &ManagedResourceType{
+		client:         p.client,
+		typeName:       typeName,
+		schema:         schema,
+		providerSchema: p.schema,
+		planDestroy:    p.caps.PlanDestroy,
+		moveState:      p.caps.MoveResourceState,
+	}
+}
+
+func (p *Provider) DataResourceType(typeName string) common.DataResourceType {
+	if !p.isConfigured() {
+		return nil
+	}
+
+	// As for ManagedResourceType, the schema was retrieved by Configure.
+	schema, _ := p.schema.dataResourceType(context.Background(), typeName)
+	if schema == nil {
+		return nil
+	}
+	return &DataResourceType{
+		client:   p.client,
+		typeName: typeName,
+		schema:   schema,
+	}
+}
+
+func (p *Provider) EphemeralResourceType(typeName string) common.EphemeralResourceType {
+	if !p.isConfigured() {
+		return nil
+	}
+
+	// As for ManagedResourceType, the schema was retrieved by Configure.
+	schema, _ := p.schema.ephemeralResourceType(context.Background(), typeName)
+	if schema == nil {
+		return nil
+	}
+	return &EphemeralResourceType{
 		client:   p.client,
 		typeName: typeName,
 		schema:   schema,
 	}
 }
 
+// OnClose arranges for the given function to be called when the provider
+// is closed, before closing the plugin. It returns a function that cancels
+// the arrangement. If the provider is already closed, OnClose calls the
+// function immediately.
+func (p *Provider) OnClose(fn func()) (cancel func()) {
+	return p.closers.Add(fn)
+}
+
+// featureRPCs maps each feature that depends on an RPC that a provider
+// might not implement to the name of that RPC.
+var featureRPCs = map[common.Feature]string{
+	common.FeatureManagedResourceImport:    "ImportResourceState",
+	common.FeatureManagedResourceMoveState: "MoveResourceState",
+	common.FeatureResourceIdentity:         "GetResourceIdentitySchemas",
+	common.FeatureDataResourceRead:         "ReadDataSource",
+	common.FeatureEphemeralResources:       "OpenEphemeralResource",
+	common.FeatureListResources:            "ListResource",
+	common.FeatureActions:                  "InvokeAction",
+	common.FeatureFunctions:                "CallFunction",
+	common.FeatureStop:                     "Stop",
+}
+
+func (p *Provider) Supports(feature common.Feature) bool {
+	rpc, ok := featureRPCs[feature]
+	if !ok {
+		return false
+	}
+	if feature == common.FeatureManagedResourceMoveState && !p.caps.MoveResourceState {
+		return false
+	}
+	return p.runner == nil || !p.runner.Unimplemented(rpc)
+}
+
+func (p *Provider) Stop(ctx context.Context) common.Diagnostics {
+	resp, err := p.client.Stop(ctx, &tfplugin5.Stop_Request{})
+	diags := common.RPCErrorDiagnostics(err)
+	if err != nil {
+		return diags
+	}
+	if resp.Error != "" {
+		diags = append(diags, common.Diagnostic{
+			Severity: common.Error,
+			Summary:  "Failed to stop provider",
+			Detail:   fmt.Sprintf("The provider failed to stop its in-progress operations: %s.", resp.Error),
+		})
+	}
+	return diags
+}
+
 func (p *Provider) Close() error {
+	p.closers.Close()
 	return p.plugin.Close()
 }
 
@@ -162,8 +358,15 @@ func (p *Provider) requireConfigured() common.Diagnostics {
 			Severity: common.Error,
 			Summary:  "Provider unconfigured",
 			Detail:   "This operation requires a configured provider, but the provider isn't configured yet.",
+			Cause:    common.ErrNotConfigured,
 		})
 	}
 	p.configuredMu.Unlock()
 	return diags
 }
+
+func (p *Provider) isConfigured() bool {
+	p.configuredMu.Lock()
+	defer p.configuredMu.Unlock()
+	return p.configured
+}
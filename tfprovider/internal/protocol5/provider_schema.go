@@ -0,0 +1,358 @@
+package protocol5
+
+import (
+	"context"
+	"sync"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// providerSchema is the schema of a provider, which is decoded one type at
+// a time as each type is first needed, because decoding the whole schema is
+// the main cost of starting a provider that has thousands of types.
+//
+// If the provider doesn't require its schema to be retrieved before other
+// calls, the schema isn't even retrieved until an operation first needs it.
+// Until then, the type names from GetMetadata are enough to know which
+// types exist.
+type providerSchema struct {
+	client tfplugin5.ProviderClient
+
+	mu sync.Mutex
+
+	// names are the names of the provider's types as returned from
+	// GetMetadata, or nil if the schema was retrieved up front.
+	names *typeNames
+
+	// raw and rawIdentities are the schema as retrieved from the provider,
+	// or nil if it isn't retrieved yet.
+	raw           *tfplugin5.GetProviderSchema_Response
+	rawIdentities map[string]*tfplugin5.ResourceIdentitySchema
+
+	// decoded has the parts of the schema that are decoded so far, which
+	// is all of them once complete is set.
+	decoded  common.Schema
+	complete bool
+}
+
+// typeNames are the names of each kind of type that a provider has.
+type typeNames struct {
+	managed   map[string]bool
+	data      map[string]bool
+	ephemeral map[string]bool
+	list      map[string]bool
+	actions   map[string]bool
+	functions map[string]bool
+}
+
+// loadSchema prepares the schema of the provider that the given client
+// belongs to, retrieving it unless the provider says that isn't necessary.
+func loadSchema(ctx context.Context, client tfplugin5.ProviderClient) (*providerSchema, common.Capabilities, error) {
+	caps := common.Capabilities{
+		ProtocolVersion: 5,
+	}
+	s := &providerSchema{
+		client: client,
+		decoded: common.Schema{
+			ManagedResourceTypes:   make(map[string]*common.ManagedResourceTypeSchema),
+			DataResourceTypes:      make(map[string]*common.DataResourceTypeSchema),
+			EphemeralResourceTypes: make(map[string]*common.EphemeralResourceTypeSchema),
+			ListResourceTypes:      make(map[string]*common.ListResourceTypeSchema),
+			ActionTypes:            make(map[string]*common.ActionTypeSchema),
+			Functions:              make(map[string]*common.FunctionSchema),
+		},
+	}
+
+	// Providers that predate GetMetadata don't implement it, and so we
+	// just retrieve their schemas in that case.
+	meta, err := client.GetMetadata(ctx, &tfplugin5.GetMetadata_Request{})
+	if err == nil && !decodeDiagnostics(meta.Diagnostics).HasErrors() && meta.GetServerCapabilities().GetGetProviderSchemaOptional() {
+		decodeServerCapabilities(meta.ServerCapabilities, &caps)
+		s.names = decodeTypeNames(meta)
+		return s, caps, nil
+	}
+
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, caps, diags.Err()
+	}
+	decodeServerCapabilities(s.raw.ServerCapabilities, &caps)
+	return s, caps, nil
+}
+
+func decodeServerCapabilities(raw *tfplugin5.ServerCapabilities, caps *common.Capabilities) {
+	if raw == nil {
+		return
+	}
+	caps.PlanDestroy = raw.PlanDestroy
+	caps.GetProviderSchemaOptional = raw.GetProviderSchemaOptional
+	caps.MoveResourceState = raw.MoveResourceState
+}
+
+func decodeTypeNames(meta *tfplugin5.GetMetadata_Response) *typeNames {
+	names := &typeNames{
+		managed:   make(map[string]bool),
+		data:      make(map[string]bool),
+		ephemeral: make(map[string]bool),
+		list:      make(map[string]bool),
+		actions:   make(map[string]bool),
+		functions: make(map[string]bool),
+	}
+	for _, raw := range meta.Resources {
+		names.managed[raw.TypeName] = true
+	}
+	for _, raw := range meta.DataSources {
+		names.data[raw.TypeName] = true
+	}
+	for _, raw := range meta.EphemeralResources {
+		names.ephemeral[raw.TypeName] = true
+	}
+	for _, raw := range meta.ListResources {
+		names.list[raw.TypeName] = true
+	}
+	for _, raw := range meta.Actions {
+		names.actions[raw.TypeName] = true
+	}
+	for _, raw := range meta.Functions {
+		names.functions[raw.Name] = true
+	}
+	return names
+}
+
+// retrieve retrieves the schema from the provider, if it isn't already
+// retrieved, returning the provider's own diagnostics if it fails. The
+// caller must hold s.mu, except in loadSchema.
+func (s *providerSchema) retrieve(ctx context.Context) common.Diagnostics {
+	if s.raw != nil {
+		return nil
+	}
+	resp, err := s.client.GetSchema(ctx, &tfplugin5.GetProviderSchema_Request{})
+	if err != nil {
+		return common.RPCErrorDiagnostics(err)
+	}
+	diags := decodeDiagnostics(resp.Diagnostics)
+	if diags.HasErrors() {
+		return diags
+	}
+	identities, moreDiags := loadIdentitySchemas(ctx, s.client)
+	diags = append(diags, moreDiags...)
+	if moreDiags.HasErrors() {
+		return diags
+	}
+	s.raw = resp
+	s.rawIdentities = identities
+	s.decoded.ProviderConfig = decodeProviderSchemaBlock(resp.GetProvider().GetBlock())
+	return diags
+}
+
+// all returns the whole schema, decoding any parts that aren't decoded yet.
+func (s *providerSchema) all(ctx context.Context) (*common.Schema, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.complete {
+		return &s.decoded, nil
+	}
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	for name, raw := range s.raw.ResourceSchemas {
+		if _, ok := s.decoded.ManagedResourceTypes[name]; !ok {
+			s.decoded.ManagedResourceTypes[name] = s.decodeManagedResourceType(name, raw)
+		}
+	}
+	for name, raw := range s.raw.DataSourceSchemas {
+		if _, ok := s.decoded.DataResourceTypes[name]; !ok {
+			s.decoded.DataResourceTypes[name] = &common.DataResourceTypeSchema{
+				Content: decodeProviderSchemaBlock(raw.Block),
+			}
+		}
+	}
+	for name, raw := range s.raw.EphemeralResourceSchemas {
+		if _, ok := s.decoded.EphemeralResourceTypes[name]; !ok {
+			s.decoded.EphemeralResourceTypes[name] = &common.EphemeralResourceTypeSchema{
+				Content: decodeProviderSchemaBlock(raw.Block),
+			}
+		}
+	}
+	for name, raw := range s.raw.ListResourceSchemas {
+		if _, ok := s.decoded.ListResourceTypes[name]; !ok {
+			s.decoded.ListResourceTypes[name] = &common.ListResourceTypeSchema{
+				Content: decodeProviderSchemaBlock(raw.Block),
+			}
+		}
+	}
+	for name, raw := range s.raw.ActionSchemas {
+		if _, ok := s.decoded.ActionTypes[name]; !ok {
+			s.decoded.ActionTypes[name] = &common.ActionTypeSchema{
+				Content: decodeProviderSchemaBlock(raw.GetSchema().GetBlock()),
+			}
+		}
+	}
+	for name, raw := range s.raw.Functions {
+		if _, ok := s.decoded.Functions[name]; !ok {
+			s.decoded.Functions[name] = decodeFunctionSchema(raw)
+		}
+	}
+	s.complete = true
+	return &s.decoded, nil
+}
+
+func (s *providerSchema) providerConfig(ctx context.Context) (*tfschema.Block, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	return s.decoded.ProviderConfig, nil
+}
+
+// managedResourceType returns the schema of the managed resource type with
+// the given name, or nil if there is no such type. The methods for the
+// other kinds of type follow the same pattern.
+func (s *providerSchema) managedResourceType(ctx context.Context, name string) (*common.ManagedResourceTypeSchema, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ret, ok := s.decoded.ManagedResourceTypes[name]; ok || s.complete {
+		return ret, nil
+	}
+	if s.names != nil && !s.names.managed[name] {
+		return nil, nil
+	}
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	raw, ok := s.raw.ResourceSchemas[name]
+	if !ok {
+		return nil, nil
+	}
+	ret := s.decodeManagedResourceType(name, raw)
+	s.decoded.ManagedResourceTypes[name] = ret
+	return ret, nil
+}
+
+func (s *providerSchema) decodeManagedResourceType(name string, raw *tfplugin5.Schema) *common.ManagedResourceTypeSchema {
+	ret := &common.ManagedResourceTypeSchema{
+		Version:             raw.Version,
+		Content:             decodeProviderSchemaBlock(raw.Block),
+		WriteOnlyAttributes: decodeWriteOnlyAttributes(raw.Block),
+	}
+	if rawIdentity, ok := s.rawIdentities[name]; ok {
+		ret.Identity = decodeIdentitySchema(rawIdentity)
+	}
+	return ret
+}
+
+func (s *providerSchema) dataResourceType(ctx context.Context, name string) (*common.DataResourceTypeSchema, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ret, ok := s.decoded.DataResourceTypes[name]; ok || s.complete {
+		return ret, nil
+	}
+	if s.names != nil && !s.names.data[name] {
+		return nil, nil
+	}
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	raw, ok := s.raw.DataSourceSchemas[name]
+	if !ok {
+		return nil, nil
+	}
+	ret := &common.DataResourceTypeSchema{
+		Content: decodeProviderSchemaBlock(raw.Block),
+	}
+	s.decoded.DataResourceTypes[name] = ret
+	return ret, nil
+}
+
+func (s *providerSchema) ephemeralResourceType(ctx context.Context, name string) (*common.EphemeralResourceTypeSchema, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ret, ok := s.decoded.EphemeralResourceTypes[name]; ok || s.complete {
+		return ret, nil
+	}
+	if s.names != nil && !s.names.ephemeral[name] {
+		return nil, nil
+	}
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	raw, ok := s.raw.EphemeralResourceSchemas[name]
+	if !ok {
+		return nil, nil
+	}
+	ret := &common.EphemeralResourceTypeSchema{
+		Content: decodeProviderSchemaBlock(raw.Block),
+	}
+	s.decoded.EphemeralResourceTypes[name] = ret
+	return ret, nil
+}
+
+func (s *providerSchema) listResourceType(ctx context.Context, name string) (*common.ListResourceTypeSchema, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ret, ok := s.decoded.ListResourceTypes[name]; ok || s.complete {
+		return ret, nil
+	}
+	if s.names != nil && !s.names.list[name] {
+		return nil, nil
+	}
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	raw, ok := s.raw.ListResourceSchemas[name]
+	if !ok {
+		return nil, nil
+	}
+	ret := &common.ListResourceTypeSchema{
+		Content: decodeProviderSchemaBlock(raw.Block),
+	}
+	s.decoded.ListResourceTypes[name] = ret
+	return ret, nil
+}
+
+func (s *providerSchema) actionType(ctx context.Context, name string) (*common.ActionTypeSchema, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ret, ok := s.decoded.ActionTypes[name]; ok || s.complete {
+		return ret, nil
+	}
+	if s.names != nil && !s.names.actions[name] {
+		return nil, nil
+	}
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	raw, ok := s.raw.ActionSchemas[name]
+	if !ok {
+		return nil, nil
+	}
+	ret := &common.ActionTypeSchema{
+		Content: decodeProviderSchemaBlock(raw.GetSchema().GetBlock()),
+	}
+	s.decoded.ActionTypes[name] = ret
+	return ret, nil
+}
+
+func (s *providerSchema) function(ctx context.Context, name string) (*common.FunctionSchema, common.Diagnostics) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ret, ok := s.decoded.Functions[name]; ok || s.complete {
+		return ret, nil
+	}
+	if s.names != nil && !s.names.functions[name] {
+		return nil, nil
+	}
+	if diags := s.retrieve(ctx); diags.HasErrors() {
+		return nil, diags
+	}
+	raw, ok := s.raw.Functions[name]
+	if !ok {
+		return nil, nil
+	}
+	ret := decodeFunctionSchema(raw)
+	s.decoded.Functions[name] = ret
+	return ret, nil
+}
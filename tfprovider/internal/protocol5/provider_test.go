@@ -0,0 +1,83 @@
+package protocol5
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// fakeValidateClient is a provider client that implements only the RPCs
+// that validate resource configuration, recording the requests.
+type fakeValidateClient struct {
+	tfplugin5.ProviderClient
+
+	resourceReq   *tfplugin5.ValidateResourceTypeConfig_Request
+	dataSourceReq *tfplugin5.ValidateDataSourceConfig_Request
+}
+
+func (c *fakeValidateClient) ValidateResourceTypeConfig(ctx context.Context, req *tfplugin5.ValidateResourceTypeConfig_Request, opts ...grpc.CallOption) (*tfplugin5.ValidateResourceTypeConfig_Response, error) {
+	c.resourceReq = req
+	return &tfplugin5.ValidateResourceTypeConfig_Response{}, nil
+}
+
+func (c *fakeValidateClient) ValidateDataSourceConfig(ctx context.Context, req *tfplugin5.ValidateDataSourceConfig_Request, opts ...grpc.CallOption) (*tfplugin5.ValidateDataSourceConfig_Response, error) {
+	c.dataSourceReq = req
+	return &tfplugin5.ValidateDataSourceConfig_Response{}, nil
+}
+
+func TestProviderValidateConfig(t *testing.T) {
+	content := &tfschema.Block{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	client := &fakeValidateClient{}
+	p := &Provider{
+		client: client,
+		schema: &providerSchema{
+			decoded: common.Schema{
+				ProviderConfig: &tfschema.Block{},
+				ManagedResourceTypes: map[string]*common.ManagedResourceTypeSchema{
+					"test_thing": {Content: content},
+				},
+				DataResourceTypes: map[string]*common.DataResourceTypeSchema{
+					"test_lookup": {Content: content},
+				},
+			},
+			complete: true,
+		},
+	}
+	config := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a"),
+	})
+
+	if diags := p.ValidateManagedResourceConfig(context.Background(), "test_thing", config); diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+	if got := client.resourceReq.TypeName; got != "test_thing" {
+		t.Errorf("wrong type name %q", got)
+	}
+	if got, _ := decodeDynamicValue(client.resourceReq.Config, content); !got.RawEquals(config) {
+		t.Errorf("wrong config %#v", got)
+	}
+
+	if diags := p.ValidateDataResourceConfig(context.Background(), "test_lookup", config); diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+	if got := client.dataSourceReq.TypeName; got != "test_lookup" {
+		t.Errorf("wrong type name %q", got)
+	}
+	if got, _ := decodeDynamicValue(client.dataSourceReq.Config, content); !got.RawEquals(config) {
+		t.Errorf("wrong config %#v", got)
+	}
+
+	if diags := p.ValidateManagedResourceConfig(context.Background(), "test_nonexist", config); !diags.HasErrors() {
+		t.Error("unexpected success for unknown type")
+	}
+}
@@ -1,9 +1,6 @@
 package protocol5
 
 import (
-	"context"
-	"fmt"
-
 	"github.com/apparentlymart/terraform-schema-go/tfschema"
 	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
@@ -68,35 +65,82 @@ func decodeProviderSchemaBlock(raw *tfplugin5.Schema_Block) *tfschema.Block {
 	return &ret
 }
 
-func loadSchema(ctx context.Context, client tfplugin5.ProviderClient) (*common.Schema, error) {
-	resp, err := client.GetSchema(ctx, &tfplugin5.GetProviderSchema_Request{})
-	if err != nil {
-		return nil, err
-	}
-	diags := decodeDiagnostics(resp.Diagnostics)
-	if diags.HasErrors() {
-		return nil, fmt.Errorf("failed to retrieve provider schema")
+// decodeWriteOnlyAttributes returns the write-only attributes of the given
+// block and its nested blocks, or nil if there are none.
+func decodeWriteOnlyAttributes(raw *tfplugin5.Schema_Block) *common.WriteOnlyAttributes {
+	if raw == nil {
+		return nil
 	}
-	var ret common.Schema
-	ret.ProviderConfig = decodeProviderSchemaBlock(resp.Provider.Block)
-	ret.ManagedResourceTypes = make(map[string]*common.ManagedResourceTypeSchema)
-	for name, raw := range resp.ResourceSchemas {
-		ret.ManagedResourceTypes[name] = &common.ManagedResourceTypeSchema{
-			Version: raw.Version,
-			Content: decodeProviderSchemaBlock(raw.Block),
+	var ret common.WriteOnlyAttributes
+	for _, rawAttr := range raw.Attributes {
+		if rawAttr.WriteOnly {
+			if ret.Attributes == nil {
+				ret.Attributes = make(map[string]bool)
+			}
+			ret.Attributes[rawAttr.Name] = true
 		}
 	}
-	ret.DataResourceTypes = make(map[string]*common.DataResourceTypeSchema)
-	for name, raw := range resp.DataSourceSchemas {
-		ret.DataResourceTypes[name] = &common.DataResourceTypeSchema{
-			Content: decodeProviderSchemaBlock(raw.Block),
+	for _, rawBlock := range raw.BlockTypes {
+		if nested := decodeWriteOnlyAttributes(rawBlock.Block); nested != nil {
+			if ret.BlockTypes == nil {
+				ret.BlockTypes = make(map[string]*common.WriteOnlyAttributes)
+			}
+			ret.BlockTypes[rawBlock.TypeName] = nested
 		}
 	}
-	return &ret, nil
+	if ret.Attributes == nil && ret.BlockTypes == nil {
+		return nil
+	}
+	return &ret
+}
+
+func decodeFunctionSchema(raw *tfplugin5.Function) *common.FunctionSchema {
+	ret := &common.FunctionSchema{
+		ReturnType:         cty.DynamicPseudoType,
+		Summary:            raw.Summary,
+		Description:        raw.Description,
+		DeprecationMessage: raw.DeprecationMessage,
+	}
+	for _, rawParam := range raw.Parameters {
+		ret.Parameters = append(ret.Parameters, decodeFunctionParameter(rawParam))
+	}
+	if raw.VariadicParameter != nil {
+		ret.VariadicParameter = decodeFunctionParameter(raw.VariadicParameter)
+	}
+	if raw.Return != nil {
+		ret.ReturnType = decodeType(raw.Return.Type)
+	}
+	return ret
+}
+
+func decodeFunctionParameter(raw *tfplugin5.Function_Parameter) *common.FunctionParameter {
+	return &common.FunctionParameter{
+		Name:               raw.Name,
+		Type:               decodeType(raw.Type),
+		AllowNullValue:     raw.AllowNullValue,
+		AllowUnknownValues: raw.AllowUnknownValues,
+		Description:        raw.Description,
+	}
+}
+
+func decodeType(raw []byte) cty.Type {
+	ty, err := ctyjson.UnmarshalType(raw)
+	if err != nil {
+		// If the provider sends us an invalid type then we'll just
+		// replace it with dynamic, since the provider is misbehaving.
+		return cty.DynamicPseudoType
+	}
+	return ty
 }
 
 func encodeDynamicValue(val cty.Value, schema *tfschema.Block) (*tfplugin5.DynamicValue, common.Diagnostics) {
-	ty := schema.ImpliedType()
+	return encodeDynamicValueType(val, schema.ImpliedType())
+}
+
+func encodeDynamicValueType(val cty.Value, ty cty.Type) (*tfplugin5.DynamicValue, common.Diagnostics) {
+	// The protocol has no way to represent value marks, such as those
+	// from package secrets, so the provider gets the bare values.
+	val, _ = val.UnmarkDeep()
 	raw, err := ctymsgpack.Marshal(val, ty)
 	if err != nil {
 		return nil, common.ErrorDiagnostics(
@@ -111,7 +155,10 @@ func encodeDynamicValue(val cty.Value, schema *tfschema.Block) (*tfplugin5.Dynam
 }
 
 func decodeDynamicValue(raw *tfplugin5.DynamicValue, schema *tfschema.Block) (cty.Value, common.Diagnostics) {
-	ty := schema.ImpliedType()
+	return decodeDynamicValueType(raw, schema.ImpliedType())
+}
+
+func decodeDynamicValueType(raw *tfplugin5.DynamicValue, ty cty.Type) (cty.Value, common.Diagnostics) {
 	switch {
 	case len(raw.Json) > 0:
 		val, err := ctyjson.Unmarshal(raw.Json, ty)
@@ -119,17 +166,17 @@ func decodeDynamicValue(raw *tfplugin5.DynamicValue, schema *tfschema.Block) (ct
 			return cty.DynamicVal, common.ErrorDiagnostics(
 				"Provider returned invalid object",
 				"Provider's JSON response does not conform to the expected type",
-				err,
+				common.KindError(common.ErrInvalidResponse, err),
 			)
 		}
 		return val, nil
 	case len(raw.Msgpack) > 0:
-		val, err := ctymsgpack.Unmarshal(raw.Json, ty)
+		val, err := ctymsgpack.Unmarshal(raw.Msgpack, ty)
 		if err != nil {
 			return cty.DynamicVal, common.ErrorDiagnostics(
 				"Provider returned invalid object",
 				"Provider's msgpack response does not conform to the expected type",
-				err,
+				common.KindError(common.ErrInvalidResponse, err),
 			)
 		}
 		return val, nil
@@ -139,6 +186,7 @@ func decodeDynamicValue(raw *tfplugin5.DynamicValue, schema *tfschema.Block) (ct
 				Severity: common.Error,
 				Summary:  "Provider using unsupported response format",
 				Detail:   "Provider's response is not in either JSON or msgpack format",
+				Cause:    common.ErrInvalidResponse,
 			},
 		}
 	}
@@ -0,0 +1,165 @@
+package protocol6
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+type ActionType struct {
+	client   tfplugin6.ProviderClient
+	typeName string
+	schema   *common.ActionTypeSchema
+}
+
+func (at *ActionType) Plan(ctx context.Context, req common.ActionPlanRequest) (common.ActionPlanResponse, common.Diagnostics) {
+	resp := common.ActionPlanResponse{}
+	dv, diags := encodeDynamicValue(req.Config, at.schema.Content)
+	if diags.HasErrors() {
+		return resp, diags
+	}
+
+	rawResp, err := at.client.PlanAction(ctx, &tfplugin6.PlanAction_Request{
+		ActionType:         at.typeName,
+		Config:             dv,
+		ClientCapabilities: encodeClientCapabilities(req.ClientCapabilities),
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+	resp.Deferred = decodeDeferred(rawResp.Deferred)
+	diags = append(diags, common.DeferralDiagnostics(resp.Deferred, req.ClientCapabilities)...)
+	return resp, diags
+}
+
+func (at *ActionType) Invoke(ctx context.Context, req common.ActionInvokeRequest) (common.ActionInvocation, common.Diagnostics) {
+	dv, diags := encodeDynamicValue(req.Config, at.schema.Content)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := at.client.InvokeAction(ctx, &tfplugin6.InvokeAction_Request{
+		ActionType:         at.typeName,
+		Config:             dv,
+		ClientCapabilities: encodeClientCapabilities(req.ClientCapabilities),
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		cancel()
+		return nil, diags
+	}
+	return &actionInvocation{
+		stream: stream,
+		cancel: cancel,
+	}, diags
+}
+
+func (at *ActionType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// actionInvocation is the implementation of common.ActionInvocation, which
+// receives each event from the stream only when the caller asks for the
+// next progress message.
+type actionInvocation struct {
+	stream tfplugin6.Provider_InvokeActionClient
+	cancel context.CancelFunc
+
+	progress string
+	diags    common.Diagnostics
+	done     bool
+}
+
+func (i *actionInvocation) Next() bool {
+	for !i.done {
+		ev, err := i.stream.Recv()
+		if err == io.EOF {
+			i.Close()
+			return false
+		}
+		if err != nil {
+			i.diags = append(i.diags, common.RPCErrorDiagnostics(err)...)
+			i.Close()
+			return false
+		}
+
+		if raw := ev.GetProgress(); raw != nil {
+			i.progress = raw.Message
+			return true
+		}
+		if raw := ev.GetCompleted(); raw != nil {
+			i.diags = append(i.diags, decodeDiagnostics(raw.Diagnostics)...)
+		}
+	}
+	return false
+}
+
+func (i *actionInvocation) Progress() string {
+	return i.progress
+}
+
+func (i *actionInvocation) Diagnostics() common.Diagnostics {
+	return i.diags
+}
+
+func (i *actionInvocation) Close() {
+	i.done = true
+	i.cancel()
+}
+
+func (p *Provider) ValidateActionConfig(ctx context.Context, typeName string, config cty.Value) common.Diagnostics {
+	schema, diags := p.schema.actionType(ctx, typeName)
+	if diags.HasErrors() {
+		return diags
+	}
+	if schema == nil {
+		return common.Diagnostics{
+			{
+				Severity: common.Error,
+				Summary:  "Unsupported action type",
+				Detail:   fmt.Sprintf("This provider does not support action type %q.", typeName),
+				Cause:    common.ErrUnknownType,
+			},
+		}
+	}
+	dv, moreDiags := encodeDynamicValue(config, schema.Content)
+	diags = append(diags, moreDiags...)
+	if diags.HasErrors() {
+		return diags
+	}
+	resp, err := p.client.ValidateActionConfig(ctx, &tfplugin6.ValidateActionConfig_Request{
+		ActionType: typeName,
+		Config:     dv,
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return diags
+	}
+	diags = append(diags, decodeDiagnostics(resp.Diagnostics)...)
+	return diags
+}
+
+func (p *Provider) ActionType(typeName string) common.ActionType {
+	if !p.isConfigured() {
+		return nil
+	}
+
+	// As for ManagedResourceType, the schema was retrieved by Configure.
+	schema, _ := p.schema.actionType(context.Background(), typeName)
+	if schema == nil {
+		return nil
+	}
+	return &ActionType{
+		client:   p.client,
+		typeName: typeName,
+		schema:   schema,
+	}
+}
@@ -0,0 +1,31 @@
+package protocol6
+
+import (
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+func encodeClientCapabilities(caps common.ClientCapabilities) *tfplugin6.ClientCapabilities {
+	return &tfplugin6.ClientCapabilities{
+		DeferralAllowed:            caps.DeferralAllowed,
+		WriteOnlyAttributesAllowed: caps.WriteOnlyAttributesAllowed,
+	}
+}
+
+func decodeDeferred(raw *tfplugin6.Deferred) *common.Deferred {
+	if raw == nil {
+		return nil
+	}
+	ret := &common.Deferred{}
+	switch raw.Reason {
+	case tfplugin6.Deferred_RESOURCE_CONFIG_UNKNOWN:
+		ret.Reason = common.DeferralReasonResourceConfigUnknown
+	case tfplugin6.Deferred_PROVIDER_CONFIG_UNKNOWN:
+		ret.Reason = common.DeferralReasonProviderConfigUnknown
+	case tfplugin6.Deferred_ABSENT_PREREQ:
+		ret.Reason = common.DeferralReasonAbsentPrerequisite
+	default:
+		ret.Reason = common.DeferralReasonUnknown
+	}
+	return ret
+}
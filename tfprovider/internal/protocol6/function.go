@@ -0,0 +1,90 @@
+package protocol6
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+func (p *Provider) CallFunction(ctx context.Context, name string, args []cty.Value) (cty.Value, common.Diagnostics) {
+	schema, diags := p.schema.function(ctx, name)
+	if diags.HasErrors() {
+		return cty.DynamicVal, diags
+	}
+	if schema == nil {
+		return cty.DynamicVal, common.Diagnostics{
+			{
+				Severity: common.Error,
+				Summary:  "Unsupported function",
+				Detail:   fmt.Sprintf("This provider does not have a function named %q.", name),
+				Cause:    common.ErrUnknownFunction,
+			},
+		}
+	}
+	if len(args) < len(schema.Parameters) || (len(args) > len(schema.Parameters) && schema.VariadicParameter == nil) {
+		return cty.UnknownVal(schema.ReturnType), common.Diagnostics{
+			{
+				Severity: common.Error,
+				Summary:  "Wrong number of function arguments",
+				Detail:   fmt.Sprintf("Function %q expects %d arguments, but got %d.", name, len(schema.Parameters), len(args)),
+			},
+		}
+	}
+
+	rawArgs := make([]*tfplugin6.DynamicValue, len(args))
+	for i, arg := range args {
+		param := schema.VariadicParameter
+		if i < len(schema.Parameters) {
+			param = schema.Parameters[i]
+		}
+		dv, moreDiags := encodeDynamicValueType(arg, param.Type)
+		for _, diag := range moreDiags {
+			diag.Attribute = common.FunctionArgumentPath(i)
+			diags = append(diags, diag)
+		}
+		rawArgs[i] = dv
+	}
+	if diags.HasErrors() {
+		return cty.UnknownVal(schema.ReturnType), diags
+	}
+
+	resp, err := p.client.CallFunction(ctx, &tfplugin6.CallFunction_Request{
+		Name:      name,
+		Arguments: rawArgs,
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return cty.UnknownVal(schema.ReturnType), diags
+	}
+	if resp.Error != nil {
+		diags = append(diags, common.FunctionErrorDiagnostic(name, decodeFunctionError(resp.Error)))
+		return cty.UnknownVal(schema.ReturnType), diags
+	}
+	if resp.Result == nil {
+		diags = append(diags, common.Diagnostic{
+			Severity: common.Error,
+			Summary:  "Provider returned invalid result",
+			Detail:   fmt.Sprintf("The provider returned no result for a call to function %q.", name),
+			Cause:    common.ErrInvalidResponse,
+		})
+		return cty.UnknownVal(schema.ReturnType), diags
+	}
+	ret, moreDiags := decodeDynamicValueType(resp.Result, schema.ReturnType)
+	diags = append(diags, moreDiags...)
+	return ret, diags
+}
+
+func decodeFunctionError(raw *tfplugin6.FunctionError) *common.FunctionError {
+	ret := &common.FunctionError{
+		Text: raw.Text,
+	}
+	if arg, ok := raw.GetXFunctionArgument().(*tfplugin6.FunctionError_FunctionArgument); ok {
+		idx := int(arg.FunctionArgument)
+		ret.Argument = &idx
+	}
+	return ret
+}
@@ -0,0 +1,107 @@
+package protocol6
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// loadIdentitySchemas retrieves the identity schemas of the provider's
+// managed resource types. Providers that predate resource identities don't
+// implement the RPC, so they just have no identities.
+func loadIdentitySchemas(ctx context.Context, client tfplugin6.ProviderClient) (map[string]*tfplugin6.ResourceIdentitySchema, common.Diagnostics) {
+	resp, err := client.GetResourceIdentitySchemas(ctx, &tfplugin6.GetResourceIdentitySchemas_Request{})
+	if grpcStatus.Code(err) == codes.Unimplemented {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, common.RPCErrorDiagnostics(err)
+	}
+	diags := decodeDiagnostics(resp.Diagnostics)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return resp.IdentitySchemas, diags
+}
+
+func decodeIdentitySchema(raw *tfplugin6.ResourceIdentitySchema) *common.ResourceIdentitySchema {
+	ret := &common.ResourceIdentitySchema{
+		Version:    raw.Version,
+		Attributes: make(map[string]*common.IdentityAttribute),
+	}
+	for _, rawAttr := range raw.IdentityAttributes {
+		ret.Attributes[rawAttr.Name] = &common.IdentityAttribute{
+			Type:              decodeType(rawAttr.Type),
+			RequiredForImport: rawAttr.RequiredForImport,
+			OptionalForImport: rawAttr.OptionalForImport,
+			Description:       rawAttr.Description,
+		}
+	}
+	return ret
+}
+
+// encodeIdentity returns the protocol representation of the given identity,
+// or nil if the resource type has no identities or the identity is null or
+// cty.NilVal.
+func encodeIdentity(val cty.Value, schema *common.ResourceIdentitySchema) (*tfplugin6.ResourceIdentityData, common.Diagnostics) {
+	if schema == nil || val.IsNull() {
+		return nil, nil
+	}
+	dv, diags := encodeDynamicValueType(val, schema.ImpliedType())
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return &tfplugin6.ResourceIdentityData{
+		IdentityData: dv,
+	}, diags
+}
+
+// decodeIdentity returns the identity represented by the given protocol
+// value, which is a null value if raw is nil, or cty.NilVal if the resource
+// type has no identities.
+func decodeIdentity(raw *tfplugin6.ResourceIdentityData, schema *common.ResourceIdentitySchema) (cty.Value, common.Diagnostics) {
+	if schema == nil {
+		return cty.NilVal, nil
+	}
+	if raw == nil || raw.IdentityData == nil {
+		return cty.NullVal(schema.ImpliedType()), nil
+	}
+	return decodeDynamicValueType(raw.IdentityData, schema.ImpliedType())
+}
+
+func (rt *ManagedResourceType) UpgradeIdentity(ctx context.Context, req common.ManagedResourceUpgradeIdentityRequest) (common.ManagedResourceUpgradeIdentityResponse, common.Diagnostics) {
+	resp := common.ManagedResourceUpgradeIdentityResponse{}
+	if rt.schema.Identity == nil {
+		return resp, common.Diagnostics{
+			{
+				Severity: common.Error,
+				Summary:  "Resource type has no identity",
+				Detail:   fmt.Sprintf("Managed resource type %q does not have resource identities.", rt.typeName),
+			},
+		}
+	}
+
+	rawResp, err := rt.client.UpgradeResourceIdentity(ctx, &tfplugin6.UpgradeResourceIdentity_Request{
+		TypeName: rt.typeName,
+		Version:  req.Version,
+		RawIdentity: &tfplugin6.RawState{
+			Json: req.RawIdentity,
+		},
+	})
+	diags := common.RPCErrorDiagnostics(err)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+
+	v, moreDiags := decodeIdentity(rawResp.UpgradedIdentity, rt.schema.Identity)
+	resp.Identity = v
+	diags = append(diags, moreDiags...)
+	return resp, diags
+}
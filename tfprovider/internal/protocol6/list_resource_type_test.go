@@ -0,0 +1,191 @@
+package protocol6
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// fakeListClient is a provider client whose ListResource stream returns the
+// given events followed by the given error, or io.EOF if it's nil.
+type fakeListClient struct {
+	tfplugin6.ProviderClient
+
+	events []*tfplugin6.ListResource_Event
+	err    error
+
+	req *tfplugin6.ListResource_Request
+	ctx context.Context
+}
+
+func (c *fakeListClient) ListResource(ctx context.Context, req *tfplugin6.ListResource_Request, opts ...grpc.CallOption) (tfplugin6.Provider_ListResourceClient, error) {
+	c.req = req
+	c.ctx = ctx
+	return &fakeListStream{client: c}, nil
+}
+
+type fakeListStream struct {
+	grpc.ClientStream
+	client *fakeListClient
+	next   int
+}
+
+func (s *fakeListStream) Recv() (*tfplugin6.ListResource_Event, error) {
+	if s.next < len(s.client.events) {
+		ev := s.client.events[s.next]
+		s.next++
+		return ev, nil
+	}
+	if s.client.err != nil {
+		return nil, s.client.err
+	}
+	return nil, io.EOF
+}
+
+func testListResourceType(client tfplugin6.ProviderClient) *ListResourceType {
+	return &ListResourceType{
+		client:   client,
+		typeName: "test_thing",
+		schema: &common.ListResourceTypeSchema{
+			Content: &tfschema.Block{},
+		},
+		resourceSchema: &common.ManagedResourceTypeSchema{
+			Content: &tfschema.Block{
+				Attributes: map[string]*tfschema.Attribute{
+					"id": {Type: cty.String, Computed: true},
+				},
+			},
+			Identity: &common.ResourceIdentitySchema{
+				Attributes: map[string]*common.IdentityAttribute{
+					"id": {Type: cty.String, RequiredForImport: true},
+				},
+			},
+		},
+	}
+}
+
+func TestListResourceTypeList(t *testing.T) {
+	client := &fakeListClient{
+		events: []*tfplugin6.ListResource_Event{
+			{
+				Identity:    &tfplugin6.ResourceIdentityData{IdentityData: &tfplugin6.DynamicValue{Json: []byte(`{"id":"a"}`)}},
+				DisplayName: "Thing A",
+			},
+			{
+				// An event with only diagnostics doesn't relate to any
+				// particular object.
+				Diagnostic: []*tfplugin6.Diagnostic{
+					{Severity: tfplugin6.Diagnostic_WARNING, Summary: "Results truncated"},
+				},
+			},
+			{
+				Identity: &tfplugin6.ResourceIdentityData{IdentityData: &tfplugin6.DynamicValue{Json: []byte(`{"id":"b"}`)}},
+				XResourceObject: &tfplugin6.ListResource_Event_ResourceObject{
+					ResourceObject: &tfplugin6.DynamicValue{Json: []byte(`{"id":"b"}`)},
+				},
+			},
+		},
+	}
+	rt := testListResourceType(client)
+
+	results, diags := rt.List(context.Background(), common.ListResourceRequest{
+		Config:                cty.EmptyObjectVal,
+		IncludeResourceObject: true,
+		Limit:                 10,
+	})
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if client.req.TypeName != "test_thing" || !client.req.IncludeResourceObject || client.req.Limit != 10 {
+		t.Errorf("wrong request %#v", client.req)
+	}
+
+	var got []common.ListResourceResult
+	for results.Next() {
+		got = append(got, results.Result())
+	}
+	if len(got) != 2 {
+		t.Fatalf("wrong number of results %d; want 2", len(got))
+	}
+	if want := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("a")}); !got[0].Identity.RawEquals(want) {
+		t.Errorf("wrong identity %#v; want %#v", got[0].Identity, want)
+	}
+	if got[0].DisplayName != "Thing A" {
+		t.Errorf("wrong display name %q", got[0].DisplayName)
+	}
+	if got[0].Object != cty.NilVal {
+		t.Errorf("unexpected object %#v", got[0].Object)
+	}
+	if diags := got[1].Diagnostics; diags.HasErrors() {
+		t.Errorf("unexpected errors for result: %s", diags.Err())
+	}
+	if want := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("b")}); !got[1].Object.RawEquals(want) {
+		t.Errorf("wrong object %#v; want %#v", got[1].Object, want)
+	}
+
+	if diags := results.Diagnostics(); len(diags) != 1 || diags[0].Summary != "Results truncated" {
+		t.Errorf("wrong diagnostics %#v", diags)
+	}
+	if client.ctx.Err() == nil {
+		t.Error("stream context not cancelled at the end of the results")
+	}
+	if results.Next() {
+		t.Error("Next returned true after the end of the results")
+	}
+}
+
+func TestListResourceTypeListError(t *testing.T) {
+	client := &fakeListClient{
+		err: grpcStatus.Error(codes.Unavailable, "transport is closing"),
+	}
+	rt := testListResourceType(client)
+
+	results, diags := rt.List(context.Background(), common.ListResourceRequest{
+		Config: cty.EmptyObjectVal,
+	})
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if results.Next() {
+		t.Fatal("Next returned true for a failed stream")
+	}
+	if !results.Diagnostics().HasErrors() {
+		t.Error("no error diagnostics for a failed stream")
+	}
+	if client.ctx.Err() == nil {
+		t.Error("stream context not cancelled after an error")
+	}
+}
+
+func TestListResourceTypeListClose(t *testing.T) {
+	client := &fakeListClient{
+		events: []*tfplugin6.ListResource_Event{
+			{Identity: &tfplugin6.ResourceIdentityData{IdentityData: &tfplugin6.DynamicValue{Json: []byte(`{"id":"a"}`)}}},
+			{Identity: &tfplugin6.ResourceIdentityData{IdentityData: &tfplugin6.DynamicValue{Json: []byte(`{"id":"b"}`)}}},
+		},
+	}
+	rt := testListResourceType(client)
+
+	results, _ := rt.List(context.Background(), common.ListResourceRequest{
+		Config: cty.EmptyObjectVal,
+	})
+	if !results.Next() {
+		t.Fatal("no first result")
+	}
+	results.Close()
+	if client.ctx.Err() == nil {
+		t.Error("stream context not cancelled by Close")
+	}
+	if results.Next() {
+		t.Error("Next returned true after Close")
+	}
+}
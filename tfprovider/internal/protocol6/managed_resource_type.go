@@ -2,41 +2,259 @@ package protocol6
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
 	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc/codes"
 )
 
 type ManagedResourceType struct {
-	client   tfplugin6.ProviderClient
-	typeName string
-	schema   *common.ManagedResourceTypeSchema
+	client         tfplugin6.ProviderClient
+	typeName       string
+	schema         *common.ManagedResourceTypeSchema
+	providerSchema *providerSchema
+
+	// planDestroy is set if the provider has opted in to planning the
+	// destruction of its objects.
+	planDestroy bool
+
+	// moveState is set if the provider supports moving objects from other
+	// resource types into this one.
+	moveState bool
 }
 
 func (rt *ManagedResourceType) Read(ctx context.Context, req common.ManagedResourceReadRequest) (common.ManagedResourceReadResponse, common.Diagnostics) {
 	resp := common.ManagedResourceReadResponse{}
 	dv, diags := encodeDynamicValue(req.PreviousValue, rt.schema.Content)
+	identity, moreDiags := encodeIdentity(req.PreviousIdentity, rt.schema.Identity)
+	diags = append(diags, moreDiags...)
 	if diags.HasErrors() {
 		return resp, diags
 	}
 
 	rawResp, err := rt.client.ReadResource(ctx, &tfplugin6.ReadResource_Request{
-		TypeName:     rt.typeName,
-		CurrentState: dv,
-		Private:      req.OpaquePrivate,
+		TypeName:           rt.typeName,
+		CurrentState:       dv,
+		Private:            req.OpaquePrivate,
+		ClientCapabilities: encodeClientCapabilities(req.ClientCapabilities),
+		CurrentIdentity:    identity,
 	})
 	diags = append(diags, common.RPCErrorDiagnostics(err)...)
 	if err != nil {
 		return resp, diags
 	}
 	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+	resp.Deferred = decodeDeferred(rawResp.Deferred)
+	diags = append(diags, common.DeferralDiagnostics(resp.Deferred, req.ClientCapabilities)...)
 
 	if raw := rawResp.NewState; raw != nil {
 		v, moreDiags := decodeDynamicValue(raw, rt.schema.Content)
 		resp.RefreshedValue = v
 		diags = append(diags, moreDiags...)
 	}
+	resp.RefreshedIdentity, moreDiags = decodeIdentity(rawResp.NewIdentity, rt.schema.Identity)
+	diags = append(diags, moreDiags...)
+	resp.OpaquePrivate = rawResp.Private
+	return resp, diags
+}
+
+func (rt *ManagedResourceType) Plan(ctx context.Context, req common.ManagedResourcePlanRequest) (common.ManagedResourcePlanResponse, common.Diagnostics) {
+	resp := common.ManagedResourcePlanResponse{}
+	if req.ProposedValue.IsNull() && !rt.planDestroy {
+		// Providers that haven't opted in to planning destroys don't
+		// expect to receive them, so we plan them ourselves in the same
+		// way as Terraform does.
+		resp.PlannedValue = cty.NullVal(rt.schema.Content.ImpliedType())
+		resp.OpaquePrivate = req.OpaquePrivate
+		resp.PlannedIdentity, _ = decodeIdentity(nil, rt.schema.Identity)
+		return resp, nil
+	}
+	var diags common.Diagnostics
+	priorDV, moreDiags := encodeDynamicValue(req.PriorValue, rt.schema.Content)
+	diags = append(diags, moreDiags...)
+	proposedDV, moreDiags := encodeDynamicValue(req.ProposedValue, rt.schema.Content)
+	diags = append(diags, moreDiags...)
+	configDV, moreDiags := encodeDynamicValue(req.Config, rt.schema.Content)
+	diags = append(diags, moreDiags...)
+	identity, moreDiags := encodeIdentity(req.PriorIdentity, rt.schema.Identity)
+	diags = append(diags, moreDiags...)
+	if diags.HasErrors() {
+		return resp, diags
+	}
+
+	rawResp, err := rt.client.PlanResourceChange(ctx, &tfplugin6.PlanResourceChange_Request{
+		TypeName:           rt.typeName,
+		PriorState:         priorDV,
+		ProposedNewState:   proposedDV,
+		Config:             configDV,
+		PriorPrivate:       req.OpaquePrivate,
+		ClientCapabilities: encodeClientCapabilities(req.ClientCapabilities),
+		PriorIdentity:      identity,
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+	resp.Deferred = decodeDeferred(rawResp.Deferred)
+	diags = append(diags, common.DeferralDiagnostics(resp.Deferred, req.ClientCapabilities)...)
+
+	if raw := rawResp.PlannedState; raw != nil {
+		v, moreDiags := decodeDynamicValue(raw, rt.schema.Content)
+		resp.PlannedValue = v
+		diags = append(diags, moreDiags...)
+	}
+	for _, raw := range rawResp.RequiresReplace {
+		resp.RequiresReplace = append(resp.RequiresReplace, decodeAttributePath(raw))
+	}
+	resp.OpaquePrivate = rawResp.PlannedPrivate
+	resp.PlannedIdentity, moreDiags = decodeIdentity(rawResp.PlannedIdentity, rt.schema.Identity)
+	diags = append(diags, moreDiags...)
+	return resp, diags
+}
+
+func (rt *ManagedResourceType) Apply(ctx context.Context, req common.ManagedResourceApplyRequest) (common.ManagedResourceApplyResponse, common.Diagnostics) {
+	resp := common.ManagedResourceApplyResponse{}
+	var diags common.Diagnostics
+	priorDV, moreDiags := encodeDynamicValue(req.PriorValue, rt.schema.Content)
+	diags = append(diags, moreDiags...)
+	plannedDV, moreDiags := encodeDynamicValue(req.PlannedValue, rt.schema.Content)
+	diags = append(diags, moreDiags...)
+	configDV, moreDiags := encodeDynamicValue(req.Config, rt.schema.Content)
+	diags = append(diags, moreDiags...)
+	identity, moreDiags := encodeIdentity(req.PlannedIdentity, rt.schema.Identity)
+	diags = append(diags, moreDiags...)
+	if diags.HasErrors() {
+		return resp, diags
+	}
+
+	rawResp, err := rt.client.ApplyResourceChange(ctx, &tfplugin6.ApplyResourceChange_Request{
+		TypeName:        rt.typeName,
+		PriorState:      priorDV,
+		PlannedState:    plannedDV,
+		Config:          configDV,
+		PlannedPrivate:  req.OpaquePrivate,
+		PlannedIdentity: identity,
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+
+	if raw := rawResp.NewState; raw != nil {
+		v, moreDiags := decodeDynamicValue(raw, rt.schema.Content)
+		diags = append(diags, moreDiags...)
+		diags = append(diags, common.WriteOnlyDiagnostics(rt.schema.WriteOnlyAttributes, v, "applying changes to", rt.typeName)...)
+		resp.NewValue = rt.schema.WriteOnlyAttributes.Strip(v)
+	}
 	resp.OpaquePrivate = rawResp.Private
+	resp.NewIdentity, moreDiags = decodeIdentity(rawResp.NewIdentity, rt.schema.Identity)
+	diags = append(diags, moreDiags...)
+	return resp, diags
+}
+
+func (rt *ManagedResourceType) Import(ctx context.Context, req common.ManagedResourceImportRequest) (common.ManagedResourceImportResponse, common.Diagnostics) {
+	resp := common.ManagedResourceImportResponse{}
+	identity, diags := encodeIdentity(req.Identity, rt.schema.Identity)
+	if diags.HasErrors() {
+		return resp, diags
+	}
+
+	rawResp, err := rt.client.ImportResourceState(ctx, &tfplugin6.ImportResourceState_Request{
+		TypeName:           rt.typeName,
+		Id:                 req.ID,
+		ClientCapabilities: encodeClientCapabilities(req.ClientCapabilities),
+		Identity:           identity,
+	})
+	diags = append(diags, common.RPCErrorDiagnostics(err)...)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+	resp.Deferred = decodeDeferred(rawResp.Deferred)
+	diags = append(diags, common.DeferralDiagnostics(resp.Deferred, req.ClientCapabilities)...)
+
+	for _, raw := range rawResp.ImportedResources {
+		// An import can return objects of other resource types belonging
+		// to the same provider, so we must decode each one using the
+		// schema of its own type.
+		schema, _ := rt.providerSchema.managedResourceType(ctx, raw.TypeName)
+		if schema == nil {
+			diags = append(diags, common.Diagnostic{
+				Severity: common.Error,
+				Summary:  "Provider returned invalid import result",
+				Detail:   fmt.Sprintf("The provider returned an imported object of type %q, which is not a managed resource type in its schema.", raw.TypeName),
+				Cause:    common.ErrInvalidResponse,
+			})
+			continue
+		}
+		imported := common.ImportedManagedResource{
+			TypeName:      raw.TypeName,
+			Value:         cty.NullVal(schema.Content.ImpliedType()),
+			OpaquePrivate: raw.Private,
+		}
+		if raw.State != nil {
+			v, moreDiags := decodeDynamicValue(raw.State, schema.Content)
+			imported.Value = v
+			diags = append(diags, moreDiags...)
+		}
+		v, moreDiags := decodeIdentity(raw.Identity, schema.Identity)
+		imported.Identity = v
+		diags = append(diags, moreDiags...)
+		resp.Imported = append(resp.Imported, imported)
+	}
+	return resp, diags
+}
+
+func (rt *ManagedResourceType) MoveState(ctx context.Context, req common.ManagedResourceMoveStateRequest) (common.ManagedResourceMoveStateResponse, common.Diagnostics) {
+	resp := common.ManagedResourceMoveStateResponse{}
+	if !rt.moveState {
+		// Providers that don't announce the capability may still have the
+		// RPC, but aren't prepared to handle calls to it.
+		return resp, common.RPCErrorDiagnostics(&common.RPCError{
+			RPC:     "MoveResourceState",
+			Code:    codes.Unimplemented,
+			Message: "provider does not have the move_resource_state capability",
+		})
+	}
+
+	rawReq := &tfplugin6.MoveResourceState_Request{
+		SourceProviderAddress: req.SourceProviderAddr,
+		SourceTypeName:        req.SourceTypeName,
+		SourceSchemaVersion:   req.SourceSchemaVersion,
+		SourceState: &tfplugin6.RawState{
+			Json: req.SourceState,
+		},
+		SourcePrivate:  req.SourceOpaquePrivate,
+		TargetTypeName: rt.typeName,
+
+		SourceIdentitySchemaVersion: req.SourceIdentitySchemaVersion,
+	}
+	if req.SourceIdentity != nil {
+		rawReq.SourceIdentity = &tfplugin6.RawState{
+			Json: req.SourceIdentity,
+		}
+	}
+	rawResp, err := rt.client.MoveResourceState(ctx, rawReq)
+	diags := common.RPCErrorDiagnostics(err)
+	if err != nil {
+		return resp, diags
+	}
+	diags = append(diags, decodeDiagnostics(rawResp.Diagnostics)...)
+
+	resp.NewValue = cty.NullVal(rt.schema.Content.ImpliedType())
+	if raw := rawResp.TargetState; raw != nil {
+		v, moreDiags := decodeDynamicValue(raw, rt.schema.Content)
+		resp.NewValue = v
+		diags = append(diags, moreDiags...)
+	}
+	resp.OpaquePrivate = rawResp.TargetPrivate
+	v, moreDiags := decodeIdentity(rawResp.TargetIdentity, rt.schema.Identity)
+	resp.NewIdentity = v
+	diags = append(diags, moreDiags...)
 	return resp, diags
 }
 
@@ -0,0 +1,147 @@
+package protocol6
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// fakeChangeClient is a provider client that implements only the RPCs
+// that change remote objects, recording the requests.
+type fakeChangeClient struct {
+	tfplugin6.ProviderClient
+
+	applyReq    *tfplugin6.ApplyResourceChange_Request
+	imported    []*tfplugin6.ImportResourceState_ImportedResource
+	stopMessage string
+}
+
+func (c *fakeChangeClient) ApplyResourceChange(ctx context.Context, req *tfplugin6.ApplyResourceChange_Request, opts ...grpc.CallOption) (*tfplugin6.ApplyResourceChange_Response, error) {
+	c.applyReq = req
+	return &tfplugin6.ApplyResourceChange_Response{
+		NewState: req.PlannedState,
+		Private:  []byte("applied"),
+	}, nil
+}
+
+func (c *fakeChangeClient) ImportResourceState(ctx context.Context, req *tfplugin6.ImportResourceState_Request, opts ...grpc.CallOption) (*tfplugin6.ImportResourceState_Response, error) {
+	return &tfplugin6.ImportResourceState_Response{
+		ImportedResources: c.imported,
+	}, nil
+}
+
+func (c *fakeChangeClient) StopProvider(ctx context.Context, req *tfplugin6.StopProvider_Request, opts ...grpc.CallOption) (*tfplugin6.StopProvider_Response, error) {
+	return &tfplugin6.StopProvider_Response{Error: c.stopMessage}, nil
+}
+
+func testChangeSchema() *common.Schema {
+	return &common.Schema{
+		ProviderConfig: &tfschema.Block{},
+		ManagedResourceTypes: map[string]*common.ManagedResourceTypeSchema{
+			"test_thing": {
+				Content: &tfschema.Block{
+					Attributes: map[string]*tfschema.Attribute{
+						"id": {Type: cty.String, Computed: true},
+					},
+				},
+			},
+			"test_rule": {
+				Content: &tfschema.Block{
+					Attributes: map[string]*tfschema.Attribute{
+						"port": {Type: cty.Number, Required: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testManagedResourceType(client tfplugin6.ProviderClient) *ManagedResourceType {
+	schema := testChangeSchema()
+	return &ManagedResourceType{
+		client:         client,
+		typeName:       "test_thing",
+		schema:         schema.ManagedResourceTypes["test_thing"],
+		providerSchema: &providerSchema{decoded: *schema, complete: true},
+	}
+}
+
+func TestManagedResourceTypeApply(t *testing.T) {
+	client := &fakeChangeClient{}
+	rt := testManagedResourceType(client)
+	planned := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("a")})
+
+	resp, diags := rt.Apply(context.Background(), common.ManagedResourceApplyRequest{
+		PriorValue:    cty.NullVal(planned.Type()),
+		PlannedValue:  planned,
+		Config:        cty.ObjectVal(map[string]cty.Value{"id": cty.NullVal(cty.String)}),
+		OpaquePrivate: []byte("planned"),
+	})
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+	if client.applyReq.TypeName != "test_thing" || string(client.applyReq.PlannedPrivate) != "planned" {
+		t.Errorf("wrong request %#v", client.applyReq)
+	}
+	if !resp.NewValue.RawEquals(planned) {
+		t.Errorf("wrong new value %#v; want %#v", resp.NewValue, planned)
+	}
+	if string(resp.OpaquePrivate) != "applied" {
+		t.Errorf("wrong private data %q", resp.OpaquePrivate)
+	}
+}
+
+func TestManagedResourceTypeImport(t *testing.T) {
+	schema := testChangeSchema()
+	thing := cty.ObjectVal(map[string]cty.Value{"id": cty.StringVal("a")})
+	rule := cty.ObjectVal(map[string]cty.Value{"port": cty.NumberIntVal(22)})
+	thingDV, _ := encodeDynamicValue(thing, schema.ManagedResourceTypes["test_thing"].Content)
+	ruleDV, _ := encodeDynamicValue(rule, schema.ManagedResourceTypes["test_rule"].Content)
+	client := &fakeChangeClient{
+		imported: []*tfplugin6.ImportResourceState_ImportedResource{
+			{TypeName: "test_thing", State: thingDV},
+			{TypeName: "test_rule", State: ruleDV},
+			{TypeName: "test_nonexist"},
+		},
+	}
+	rt := testManagedResourceType(client)
+
+	// An import can return objects of other types, which are decoded using
+	// the schemas of their own types.
+	resp, diags := rt.Import(context.Background(), common.ManagedResourceImportRequest{ID: "a"})
+	if len(resp.Imported) != 2 {
+		t.Fatalf("wrong number of imported objects %d; want 2", len(resp.Imported))
+	}
+	if got := resp.Imported[0]; got.TypeName != "test_thing" || !got.Value.RawEquals(thing) {
+		t.Errorf("wrong first object %#v", got)
+	}
+	if got := resp.Imported[1]; got.TypeName != "test_rule" || !got.Value.RawEquals(rule) {
+		t.Errorf("wrong second object %#v", got)
+	}
+	if len(diags) != 1 || diags[0].Summary != "Provider returned invalid import result" {
+		t.Errorf("wrong diagnostics %#v", diags)
+	}
+}
+
+func TestProviderStop(t *testing.T) {
+	client := &fakeChangeClient{}
+	p := &Provider{client: client}
+	if diags := p.Stop(context.Background()); diags.HasErrors() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+
+	client.stopMessage = "still busy"
+	diags := p.Stop(context.Background())
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success")
+	}
+	if got, want := diags[0].Detail, "The provider failed to stop its in-progress operations: still busy."; got != want {
+		t.Errorf("wrong detail\ngot:  %s\nwant: %s", got, want)
+	}
+}
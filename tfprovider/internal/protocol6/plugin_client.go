@@ -3,12 +3,39 @@ package protocol6
 import (
 	"context"
 
-	"github.com/apparentlymart/terraform-provider/internal/tfplugin5"
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
 	"google.golang.org/grpc"
 )
 
-type PluginClient struct{}
+type PluginClient struct {
+	// Policy, if not nil, is the call policy to apply to all of the calls
+	// made through the client.
+	Policy *common.CallPolicy
+
+	// Connection, if not nil, sets message size limits and requirements
+	// for the connection to the provider plugin.
+	Connection *common.ConnectionPolicy
+}
 
 func (c PluginClient) ClientProxy(ctx context.Context, conn *grpc.ClientConn) (interface{}, error) {
-	return tfplugin5.NewProviderClient(conn), nil
+	raw := tfplugin6.NewProviderClient(conn)
+	runner := common.NewCallRunner(c.Policy, c.Connection)
+
+	// GetMetadata carries no configuration, so it's a safe probe even if
+	// the connection turns out not to meet the policy. Providers that don't
+	// implement it still respond, which is enough to check the connection.
+	err := runner.CheckConnection(ctx, func(ctx context.Context, opts []grpc.CallOption) error {
+		_, err := raw.GetMetadata(ctx, &tfplugin6.GetMetadata_Request{}, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var client tfplugin6.ProviderClient = &policyClient{
+		client: raw,
+		runner: runner,
+	}
+	return client, nil
 }
@@ -0,0 +1,293 @@
+package protocol6
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// policyClient is an implementation of tfplugin6.ProviderClient that makes
+// each call through a common.CallRunner, so that all calls are subject to
+// the caller's call policy.
+type policyClient struct {
+	client tfplugin6.ProviderClient
+	runner *common.CallRunner
+}
+
+var _ tfplugin6.ProviderClient = (*policyClient)(nil)
+
+func (c *policyClient) GetMetadata(ctx context.Context, in *tfplugin6.GetMetadata_Request, opts ...grpc.CallOption) (*tfplugin6.GetMetadata_Response, error) {
+	var resp *tfplugin6.GetMetadata_Response
+	err := c.runner.Call(ctx, "GetMetadata", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetMetadata(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) GetProviderSchema(ctx context.Context, in *tfplugin6.GetProviderSchema_Request, opts ...grpc.CallOption) (*tfplugin6.GetProviderSchema_Response, error) {
+	var resp *tfplugin6.GetProviderSchema_Response
+	err := c.runner.Call(ctx, "GetProviderSchema", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetProviderSchema(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) GetResourceIdentitySchemas(ctx context.Context, in *tfplugin6.GetResourceIdentitySchemas_Request, opts ...grpc.CallOption) (*tfplugin6.GetResourceIdentitySchemas_Response, error) {
+	var resp *tfplugin6.GetResourceIdentitySchemas_Response
+	err := c.runner.Call(ctx, "GetResourceIdentitySchemas", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetResourceIdentitySchemas(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateProviderConfig(ctx context.Context, in *tfplugin6.ValidateProviderConfig_Request, opts ...grpc.CallOption) (*tfplugin6.ValidateProviderConfig_Response, error) {
+	var resp *tfplugin6.ValidateProviderConfig_Response
+	err := c.runner.Call(ctx, "ValidateProviderConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateProviderConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateResourceConfig(ctx context.Context, in *tfplugin6.ValidateResourceConfig_Request, opts ...grpc.CallOption) (*tfplugin6.ValidateResourceConfig_Response, error) {
+	var resp *tfplugin6.ValidateResourceConfig_Response
+	err := c.runner.Call(ctx, "ValidateResourceConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateResourceConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateDataResourceConfig(ctx context.Context, in *tfplugin6.ValidateDataResourceConfig_Request, opts ...grpc.CallOption) (*tfplugin6.ValidateDataResourceConfig_Response, error) {
+	var resp *tfplugin6.ValidateDataResourceConfig_Response
+	err := c.runner.Call(ctx, "ValidateDataResourceConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateDataResourceConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) UpgradeResourceState(ctx context.Context, in *tfplugin6.UpgradeResourceState_Request, opts ...grpc.CallOption) (*tfplugin6.UpgradeResourceState_Response, error) {
+	var resp *tfplugin6.UpgradeResourceState_Response
+	err := c.runner.Call(ctx, "UpgradeResourceState", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.UpgradeResourceState(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) UpgradeResourceIdentity(ctx context.Context, in *tfplugin6.UpgradeResourceIdentity_Request, opts ...grpc.CallOption) (*tfplugin6.UpgradeResourceIdentity_Response, error) {
+	var resp *tfplugin6.UpgradeResourceIdentity_Response
+	err := c.runner.Call(ctx, "UpgradeResourceIdentity", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.UpgradeResourceIdentity(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ConfigureProvider(ctx context.Context, in *tfplugin6.ConfigureProvider_Request, opts ...grpc.CallOption) (*tfplugin6.ConfigureProvider_Response, error) {
+	var resp *tfplugin6.ConfigureProvider_Response
+	err := c.runner.Call(ctx, "ConfigureProvider", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ConfigureProvider(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ReadResource(ctx context.Context, in *tfplugin6.ReadResource_Request, opts ...grpc.CallOption) (*tfplugin6.ReadResource_Response, error) {
+	var resp *tfplugin6.ReadResource_Response
+	err := c.runner.Call(ctx, "ReadResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ReadResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) PlanResourceChange(ctx context.Context, in *tfplugin6.PlanResourceChange_Request, opts ...grpc.CallOption) (*tfplugin6.PlanResourceChange_Response, error) {
+	var resp *tfplugin6.PlanResourceChange_Response
+	err := c.runner.Call(ctx, "PlanResourceChange", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.PlanResourceChange(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ApplyResourceChange(ctx context.Context, in *tfplugin6.ApplyResourceChange_Request, opts ...grpc.CallOption) (*tfplugin6.ApplyResourceChange_Response, error) {
+	var resp *tfplugin6.ApplyResourceChange_Response
+	err := c.runner.Call(ctx, "ApplyResourceChange", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ApplyResourceChange(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ImportResourceState(ctx context.Context, in *tfplugin6.ImportResourceState_Request, opts ...grpc.CallOption) (*tfplugin6.ImportResourceState_Response, error) {
+	var resp *tfplugin6.ImportResourceState_Response
+	err := c.runner.Call(ctx, "ImportResourceState", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ImportResourceState(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) MoveResourceState(ctx context.Context, in *tfplugin6.MoveResourceState_Request, opts ...grpc.CallOption) (*tfplugin6.MoveResourceState_Response, error) {
+	var resp *tfplugin6.MoveResourceState_Response
+	err := c.runner.Call(ctx, "MoveResourceState", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.MoveResourceState(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ReadDataSource(ctx context.Context, in *tfplugin6.ReadDataSource_Request, opts ...grpc.CallOption) (*tfplugin6.ReadDataSource_Response, error) {
+	var resp *tfplugin6.ReadDataSource_Response
+	err := c.runner.Call(ctx, "ReadDataSource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ReadDataSource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateEphemeralResourceConfig(ctx context.Context, in *tfplugin6.ValidateEphemeralResourceConfig_Request, opts ...grpc.CallOption) (*tfplugin6.ValidateEphemeralResourceConfig_Response, error) {
+	var resp *tfplugin6.ValidateEphemeralResourceConfig_Response
+	err := c.runner.Call(ctx, "ValidateEphemeralResourceConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateEphemeralResourceConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) OpenEphemeralResource(ctx context.Context, in *tfplugin6.OpenEphemeralResource_Request, opts ...grpc.CallOption) (*tfplugin6.OpenEphemeralResource_Response, error) {
+	var resp *tfplugin6.OpenEphemeralResource_Response
+	err := c.runner.Call(ctx, "OpenEphemeralResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.OpenEphemeralResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) RenewEphemeralResource(ctx context.Context, in *tfplugin6.RenewEphemeralResource_Request, opts ...grpc.CallOption) (*tfplugin6.RenewEphemeralResource_Response, error) {
+	var resp *tfplugin6.RenewEphemeralResource_Response
+	err := c.runner.Call(ctx, "RenewEphemeralResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.RenewEphemeralResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) CloseEphemeralResource(ctx context.Context, in *tfplugin6.CloseEphemeralResource_Request, opts ...grpc.CallOption) (*tfplugin6.CloseEphemeralResource_Response, error) {
+	var resp *tfplugin6.CloseEphemeralResource_Response
+	err := c.runner.Call(ctx, "CloseEphemeralResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.CloseEphemeralResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateListResourceConfig(ctx context.Context, in *tfplugin6.ValidateListResourceConfig_Request, opts ...grpc.CallOption) (*tfplugin6.ValidateListResourceConfig_Response, error) {
+	var resp *tfplugin6.ValidateListResourceConfig_Response
+	err := c.runner.Call(ctx, "ValidateListResourceConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateListResourceConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) GetFunctions(ctx context.Context, in *tfplugin6.GetFunctions_Request, opts ...grpc.CallOption) (*tfplugin6.GetFunctions_Response, error) {
+	var resp *tfplugin6.GetFunctions_Response
+	err := c.runner.Call(ctx, "GetFunctions", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.GetFunctions(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) CallFunction(ctx context.Context, in *tfplugin6.CallFunction_Request, opts ...grpc.CallOption) (*tfplugin6.CallFunction_Response, error) {
+	var resp *tfplugin6.CallFunction_Response
+	err := c.runner.Call(ctx, "CallFunction", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.CallFunction(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ValidateActionConfig(ctx context.Context, in *tfplugin6.ValidateActionConfig_Request, opts ...grpc.CallOption) (*tfplugin6.ValidateActionConfig_Response, error) {
+	var resp *tfplugin6.ValidateActionConfig_Response
+	err := c.runner.Call(ctx, "ValidateActionConfig", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.ValidateActionConfig(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) PlanAction(ctx context.Context, in *tfplugin6.PlanAction_Request, opts ...grpc.CallOption) (*tfplugin6.PlanAction_Response, error) {
+	var resp *tfplugin6.PlanAction_Response
+	err := c.runner.Call(ctx, "PlanAction", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.PlanAction(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) StopProvider(ctx context.Context, in *tfplugin6.StopProvider_Request, opts ...grpc.CallOption) (*tfplugin6.StopProvider_Response, error) {
+	var resp *tfplugin6.StopProvider_Response
+	err := c.runner.Call(ctx, "StopProvider", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		resp, err = c.client.StopProvider(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	return resp, err
+}
+
+func (c *policyClient) ListResource(ctx context.Context, in *tfplugin6.ListResource_Request, opts ...grpc.CallOption) (tfplugin6.Provider_ListResourceClient, error) {
+	var stream tfplugin6.Provider_ListResourceClient
+	err := c.runner.Stream(ctx, "ListResource", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		stream, err = c.client.ListResource(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &policyListResourceClient{stream, c.runner}, nil
+}
+
+// policyListResourceClient passes errors from receiving on the stream
+// through the runner of the policyClient that opened it.
+type policyListResourceClient struct {
+	tfplugin6.Provider_ListResourceClient
+	runner *common.CallRunner
+}
+
+func (s *policyListResourceClient) Recv() (*tfplugin6.ListResource_Event, error) {
+	ev, err := s.Provider_ListResourceClient.Recv()
+	return ev, s.runner.StreamError("ListResource", err)
+}
+
+func (c *policyClient) InvokeAction(ctx context.Context, in *tfplugin6.InvokeAction_Request, opts ...grpc.CallOption) (tfplugin6.Provider_InvokeActionClient, error) {
+	var stream tfplugin6.Provider_InvokeActionClient
+	err := c.runner.Stream(ctx, "InvokeAction", func(ctx context.Context, callOpts []grpc.CallOption) (err error) {
+		stream, err = c.client.InvokeAction(ctx, in, append(callOpts, opts...)...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &policyInvokeActionClient{stream, c.runner}, nil
+}
+
+// policyInvokeActionClient passes errors from receiving on the stream
+// through the runner of the policyClient that opened it.
+type policyInvokeActionClient struct {
+	tfplugin6.Provider_InvokeActionClient
+	runner *common.CallRunner
+}
+
+func (s *policyInvokeActionClient) Recv() (*tfplugin6.InvokeAction_Event, error) {
+	ev, err := s.Provider_InvokeActionClient.Recv()
+	return ev, s.runner.StreamError("InvokeAction", err)
+}
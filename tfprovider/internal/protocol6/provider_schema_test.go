@@ -0,0 +1,218 @@
+package protocol6
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+)
+
+// fakeSchemaClient is a provider client that implements only the RPCs
+// needed to retrieve a schema, counting the calls to retrieve it. Calling
+// any other RPC panics.
+type fakeSchemaClient struct {
+	tfplugin6.ProviderClient
+
+	metadata *tfplugin6.GetMetadata_Response
+	schema   *tfplugin6.GetProviderSchema_Response
+
+	schemaCalls int
+}
+
+func (c *fakeSchemaClient) GetMetadata(ctx context.Context, req *tfplugin6.GetMetadata_Request, opts ...grpc.CallOption) (*tfplugin6.GetMetadata_Response, error) {
+	if c.metadata == nil {
+		return nil, grpcStatus.Error(codes.Unimplemented, "no GetMetadata")
+	}
+	return c.metadata, nil
+}
+
+func (c *fakeSchemaClient) GetProviderSchema(ctx context.Context, req *tfplugin6.GetProviderSchema_Request, opts ...grpc.CallOption) (*tfplugin6.GetProviderSchema_Response, error) {
+	c.schemaCalls++
+	return c.schema, nil
+}
+
+func (c *fakeSchemaClient) GetResourceIdentitySchemas(ctx context.Context, req *tfplugin6.GetResourceIdentitySchemas_Request, opts ...grpc.CallOption) (*tfplugin6.GetResourceIdentitySchemas_Response, error) {
+	return nil, grpcStatus.Error(codes.Unimplemented, "no GetResourceIdentitySchemas")
+}
+
+func testSchemaResponse() *tfplugin6.GetProviderSchema_Response {
+	return &tfplugin6.GetProviderSchema_Response{
+		Provider: &tfplugin6.Schema{
+			Block: &tfplugin6.Schema_Block{},
+		},
+		ResourceSchemas: map[string]*tfplugin6.Schema{
+			"test_thing": {
+				Version: 2,
+				Block: &tfplugin6.Schema_Block{
+					Attributes: []*tfplugin6.Schema_Attribute{
+						{Name: "id", Type: []byte(`"string"`), Computed: true},
+					},
+				},
+			},
+		},
+		DataSourceSchemas: map[string]*tfplugin6.Schema{
+			"test_lookup": {
+				Block: &tfplugin6.Schema_Block{},
+			},
+		},
+	}
+}
+
+func TestLoadSchemaOptional(t *testing.T) {
+	client := &fakeSchemaClient{
+		metadata: &tfplugin6.GetMetadata_Response{
+			ServerCapabilities: &tfplugin6.ServerCapabilities{
+				GetProviderSchemaOptional: true,
+			},
+			Resources: []*tfplugin6.GetMetadata_ResourceMetadata{
+				{TypeName: "test_thing"},
+			},
+			DataSources: []*tfplugin6.GetMetadata_DataSourceMetadata{
+				{TypeName: "test_lookup"},
+			},
+		},
+		schema: testSchemaResponse(),
+	}
+	ctx := context.Background()
+
+	s, caps, err := loadSchema(ctx, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !caps.GetProviderSchemaOptional {
+		t.Error("GetProviderSchemaOptional capability not set")
+	}
+	if client.schemaCalls != 0 {
+		t.Fatalf("schema retrieved %d times during load; want 0", client.schemaCalls)
+	}
+
+	// The type names from GetMetadata are enough to know that a type
+	// doesn't exist, without retrieving the schema.
+	rts, diags := s.managedResourceType(ctx, "test_nonexist")
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if rts != nil {
+		t.Errorf("unexpected schema for nonexistent type: %#v", rts)
+	}
+	if client.schemaCalls != 0 {
+		t.Fatalf("schema retrieved %d times for nonexistent type; want 0", client.schemaCalls)
+	}
+
+	rts, diags = s.managedResourceType(ctx, "test_thing")
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if rts == nil {
+		t.Fatal("no schema for test_thing")
+	}
+	if rts.Version != 2 {
+		t.Errorf("wrong version %d; want 2", rts.Version)
+	}
+	if _, ok := rts.Content.Attributes["id"]; !ok {
+		t.Error("test_thing schema has no id attribute")
+	}
+	if s.complete {
+		t.Error("whole schema decoded for just one type")
+	}
+
+	all, diags := s.all(ctx)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if !all.HasDataResourceType("test_lookup") {
+		t.Error("full schema has no test_lookup data resource type")
+	}
+	if all.ManagedResourceTypes["test_thing"] != rts {
+		t.Error("full schema decoded test_thing again")
+	}
+	if client.schemaCalls != 1 {
+		t.Errorf("schema retrieved %d times; want 1", client.schemaCalls)
+	}
+}
+
+func TestLoadSchemaRequired(t *testing.T) {
+	client := &fakeSchemaClient{
+		schema: testSchemaResponse(),
+	}
+	ctx := context.Background()
+
+	s, caps, err := loadSchema(ctx, client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if caps.GetProviderSchemaOptional {
+		t.Error("GetProviderSchemaOptional capability set")
+	}
+	if client.schemaCalls != 1 {
+		t.Fatalf("schema retrieved %d times during load; want 1", client.schemaCalls)
+	}
+
+	rts, diags := s.dataResourceType(ctx, "test_lookup")
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if rts == nil {
+		t.Fatal("no schema for test_lookup")
+	}
+	if client.schemaCalls != 1 {
+		t.Errorf("schema retrieved %d times; want 1", client.schemaCalls)
+	}
+}
+
+func TestLoadSchemaDiagnostics(t *testing.T) {
+	schemaResp := &tfplugin6.GetProviderSchema_Response{
+		Diagnostics: []*tfplugin6.Diagnostic{
+			{
+				Severity: tfplugin6.Diagnostic_ERROR,
+				Summary:  "Missing credentials",
+				Detail:   "The provider needs credentials to build its schema.",
+			},
+		},
+	}
+
+	t.Run("required", func(t *testing.T) {
+		client := &fakeSchemaClient{
+			schema: schemaResp,
+		}
+		_, _, err := loadSchema(context.Background(), client)
+		if err == nil {
+			t.Fatal("unexpected success")
+		}
+		if !strings.Contains(err.Error(), "Missing credentials") {
+			t.Errorf("error doesn't include the provider's diagnostic: %s", err)
+		}
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		client := &fakeSchemaClient{
+			metadata: &tfplugin6.GetMetadata_Response{
+				ServerCapabilities: &tfplugin6.ServerCapabilities{
+					GetProviderSchemaOptional: true,
+				},
+				Resources: []*tfplugin6.GetMetadata_ResourceMetadata{
+					{TypeName: "test_thing"},
+				},
+			},
+			schema: schemaResp,
+		}
+		ctx := context.Background()
+		s, _, err := loadSchema(ctx, client)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		_, diags := s.managedResourceType(ctx, "test_thing")
+		if !diags.HasErrors() {
+			t.Fatal("unexpected success")
+		}
+		if got, want := diags[0].Summary, "Missing credentials"; got != want {
+			t.Errorf("wrong summary %q; want %q", got, want)
+		}
+	})
+}
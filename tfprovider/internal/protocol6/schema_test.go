@@ -0,0 +1,85 @@
+package protocol6
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/internal/tfplugin6"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+func TestDecodeDynamicValue(t *testing.T) {
+	schema := &tfschema.Block{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	want := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a"),
+	})
+
+	msgpack, diags := encodeDynamicValue(want, schema)
+	if diags.HasErrors() {
+		t.Fatalf("failed to encode: %#v", diags)
+	}
+	tests := map[string]*tfplugin6.DynamicValue{
+		"msgpack": msgpack,
+		"json":    {Json: []byte(`{"name":"a"}`)},
+	}
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, diags := decodeDynamicValue(raw, schema)
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %#v", diags)
+			}
+			if !got.RawEquals(want) {
+				t.Errorf("wrong value %#v; want %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestDecodeDynamicValueInvalid(t *testing.T) {
+	schema := &tfschema.Block{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+
+	_, diags := decodeDynamicValue(&tfplugin6.DynamicValue{Json: []byte(`{"name":["a"]}`)}, schema)
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success")
+	}
+	if got, want := common.FormatCtyPath(diags[0].Attribute), ".name"; got != want {
+		t.Errorf("wrong attribute %q; want %q", got, want)
+	}
+	if !errors.Is(diags.Err(), common.ErrInvalidResponse) {
+		t.Errorf("wrong error %v; want ErrInvalidResponse", diags.Err())
+	}
+}
+
+func TestEncodeDynamicValueMarked(t *testing.T) {
+	schema := &tfschema.Block{
+		Attributes: map[string]*tfschema.Attribute{
+			"name": {Type: cty.String, Required: true},
+		},
+	}
+	val := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("a").Mark("sensitive"),
+	})
+
+	raw, diags := encodeDynamicValue(val, schema)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	got, diags := decodeDynamicValue(raw, schema)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	if want, _ := val.UnmarkDeep(); !got.RawEquals(want) {
+		t.Errorf("wrong value %#v; want %#v", got, want)
+	}
+}
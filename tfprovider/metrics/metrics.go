@@ -0,0 +1,140 @@
+// Package metrics records counters, latency histograms and gauges about
+// the operations on providers and their child processes, using a hook from
+// package tfprovider.
+//
+// Measurements go to a Sink, which is an interface so that callers can send
+// them to any metrics system. This package also includes Registry, a Sink
+// that retains measurements in memory and can expose them to Prometheus.
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+// Label is a single dimension of a measurement.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sink receives measurements from a Collector.
+//
+// Each metric name is always used with the same method and with the same
+// label names in the same order, so implementations can rely on that to
+// decide the type and shape of each metric.
+type Sink interface {
+	// AddCounter adds the given non-negative delta to a counter.
+	AddCounter(name string, labels []Label, delta float64)
+
+	// AddGauge adds the given delta, which may be negative, to a gauge.
+	AddGauge(name string, labels []Label, delta float64)
+
+	// SetGauge sets a gauge to the given value.
+	SetGauge(name string, labels []Label, value float64)
+
+	// ObserveHistogram records a single observation in a histogram.
+	ObserveHistogram(name string, labels []Label, value float64)
+}
+
+// The following are the names of the metrics a Collector records.
+const (
+	// OperationsTotal is a counter of operations, labelled by provider,
+	// operation, rpc, type_name and outcome. The outcome is either
+	// "success" or "error".
+	OperationsTotal = "tfprovider_operations_total"
+
+	// OperationDurationSeconds is a histogram of the duration of
+	// operations, labelled by provider, operation, rpc and type_name.
+	OperationDurationSeconds = "tfprovider_operation_duration_seconds"
+
+	// ProcessStartsTotal is a counter of provider child processes
+	// successfully started, labelled by provider.
+	ProcessStartsTotal = "tfprovider_process_starts_total"
+
+	// ProcessRestartsTotal is a counter of provider child processes
+	// started for a provider that had already been started at least once
+	// before, labelled by provider.
+	ProcessRestartsTotal = "tfprovider_process_restarts_total"
+
+	// LiveProcesses is a gauge of the number of provider child processes
+	// that have been started and not yet closed, labelled by provider.
+	LiveProcesses = "tfprovider_live_processes"
+
+	// SchemaTypes is a gauge of the number of types in the provider's
+	// schema, labelled by provider and kind. The kind is either "managed"
+	// or "data".
+	SchemaTypes = "tfprovider_schema_types"
+)
+
+// Collector records measurements about provider operations into a Sink.
+type Collector struct {
+	sink Sink
+
+	mu      sync.Mutex
+	started map[string]bool
+}
+
+// NewCollector returns a collector that sends its measurements to the given
+// sink.
+func NewCollector(sink Sink) *Collector {
+	return &Collector{
+		sink:    sink,
+		started: make(map[string]bool),
+	}
+}
+
+// Hook returns a hook that records measurements about a provider, which are
+// labelled with the given provider name. The name is usually the provider's
+// source address.
+//
+// Use a separate hook for each provider, but use the same name for each
+// instance of the same provider so that restarts are counted correctly.
+func (c *Collector) Hook(provider string) tfprovider.Hook {
+	return func(ctx context.Context, op *tfprovider.Operation, next func(ctx context.Context)) {
+		next(ctx)
+
+		outcome := "success"
+		if op.Err != nil || op.Diagnostics.HasErrors() {
+			outcome = "error"
+		}
+		labels := []Label{
+			{Name: "provider", Value: provider},
+			{Name: "operation", Value: string(op.Name)},
+			{Name: "rpc", Value: op.RPC},
+			{Name: "type_name", Value: op.TypeName},
+		}
+		c.sink.AddCounter(OperationsTotal, append(labels, Label{Name: "outcome", Value: outcome}), 1)
+		c.sink.ObserveHistogram(OperationDurationSeconds, labels, op.Duration.Seconds())
+
+		providerLabels := []Label{
+			{Name: "provider", Value: provider},
+		}
+		switch op.Name {
+		case tfprovider.OpStart:
+			if op.Err != nil {
+				break
+			}
+			c.sink.AddCounter(ProcessStartsTotal, providerLabels, 1)
+			c.mu.Lock()
+			restart := c.started[provider]
+			c.started[provider] = true
+			c.mu.Unlock()
+			if restart {
+				c.sink.AddCounter(ProcessRestartsTotal, providerLabels, 1)
+			}
+			c.sink.AddGauge(LiveProcesses, providerLabels, 1)
+		case tfprovider.OpClose:
+			c.sink.AddGauge(LiveProcesses, providerLabels, -1)
+		case tfprovider.OpSchema:
+			schema, _ := op.Response.(*tfprovider.Schema)
+			if schema == nil {
+				break
+			}
+			c.sink.SetGauge(SchemaTypes, append(providerLabels, Label{Name: "kind", Value: "managed"}), float64(len(schema.ManagedResourceTypes)))
+			c.sink.SetGauge(SchemaTypes, append(providerLabels, Label{Name: "kind", Value: "data"}), float64(len(schema.DataResourceTypes)))
+		}
+	}
+}
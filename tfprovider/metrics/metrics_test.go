@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+func TestCollector(t *testing.T) {
+	reg := &Registry{Buckets: []float64{0.1, 1}}
+	c := NewCollector(reg)
+	hook := c.Hook("example.com/test/test")
+	run := func(op *tfprovider.Operation) {
+		hook(context.Background(), op, func(ctx context.Context) {})
+	}
+
+	run(&tfprovider.Operation{Name: tfprovider.OpStart, Duration: 500 * time.Millisecond})
+	run(&tfprovider.Operation{
+		Name: tfprovider.OpSchema,
+		RPC:  "GetProviderSchema",
+		Response: &tfprovider.Schema{
+			ManagedResourceTypes: map[string]*tfprovider.ManagedResourceTypeSchema{"test_a": {}, "test_b": {}},
+			DataResourceTypes:    map[string]*tfprovider.DataResourceTypeSchema{"test_c": {}},
+		},
+	})
+	run(&tfprovider.Operation{
+		Name:     tfprovider.OpReadManagedResource,
+		RPC:      "ReadResource",
+		TypeName: "test_a",
+		Duration: 50 * time.Millisecond,
+		Diagnostics: tfprovider.Diagnostics{
+			{Severity: tfprovider.Error, Summary: "Read failed"},
+		},
+	})
+	run(&tfprovider.Operation{Name: tfprovider.OpClose})
+	run(&tfprovider.Operation{Name: tfprovider.OpStart})
+	run(&tfprovider.Operation{Name: tfprovider.OpStart, Err: errors.New("exec failed")})
+
+	rec := httptest.NewRecorder()
+	reg.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := ioutil.ReadAll(rec.Body)
+	got := string(body)
+
+	wantLines := []string{
+		`# TYPE tfprovider_operations_total counter`,
+		`tfprovider_operations_total{provider="example.com/test/test",operation="ReadManagedResource",rpc="ReadResource",type_name="test_a",outcome="error"} 1`,
+		`tfprovider_operations_total{provider="example.com/test/test",operation="Start",rpc="",type_name="",outcome="success"} 2`,
+		`tfprovider_operations_total{provider="example.com/test/test",operation="Start",rpc="",type_name="",outcome="error"} 1`,
+		`# TYPE tfprovider_operation_duration_seconds histogram`,
+		`tfprovider_operation_duration_seconds_bucket{provider="example.com/test/test",operation="Start",rpc="",type_name="",le="0.1"} 2`,
+		`tfprovider_operation_duration_seconds_bucket{provider="example.com/test/test",operation="Start",rpc="",type_name="",le="1"} 3`,
+		`tfprovider_operation_duration_seconds_bucket{provider="example.com/test/test",operation="Start",rpc="",type_name="",le="+Inf"} 3`,
+		`tfprovider_operation_duration_seconds_sum{provider="example.com/test/test",operation="Start",rpc="",type_name=""} 0.5`,
+		`tfprovider_operation_duration_seconds_count{provider="example.com/test/test",operation="Start",rpc="",type_name=""} 3`,
+		`tfprovider_process_starts_total{provider="example.com/test/test"} 2`,
+		`tfprovider_process_restarts_total{provider="example.com/test/test"} 1`,
+		`# TYPE tfprovider_live_processes gauge`,
+		`tfprovider_live_processes{provider="example.com/test/test"} 1`,
+		`tfprovider_schema_types{provider="example.com/test/test",kind="managed"} 2`,
+		`tfprovider_schema_types{provider="example.com/test/test",kind="data"} 1`,
+	}
+	for _, want := range wantLines {
+		if !strings.Contains(got, want+"\n") {
+			t.Errorf("missing line %s", want)
+		}
+	}
+	if t.Failed() {
+		t.Logf("full output:\n%s", got)
+	}
+	if got, want := rec.Header().Get("Content-Type"), "text/plain; version=0.0.4; charset=utf-8"; got != want {
+		t.Errorf("wrong content type %q; want %q", got, want)
+	}
+}
+
+func TestFormatLabels(t *testing.T) {
+	got := formatLabels([]Label{
+		{Name: "a", Value: `say "hi"`},
+		{Name: "b", Value: "back\\slash\nnewline"},
+	})
+	if want := `{a="say \"hi\"",b="back\\slash\nnewline"}`; got != want {
+		t.Errorf("wrong result\ngot:  %s\nwant: %s", got, want)
+	}
+	if got := formatLabels(nil); got != "" {
+		t.Errorf("wrong result %q for no labels", got)
+	}
+}
@@ -0,0 +1,231 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the upper bounds of the histogram buckets that a
+// Registry uses when none are specified. They range from five milliseconds
+// to five minutes, since provider operations that make calls to remote APIs
+// can be slow.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+
+// Registry is a Sink that retains the current value of each metric in
+// memory, and can expose them in the Prometheus text exposition format
+// using the handler returned by Handler.
+//
+// The zero value of Registry is ready to use, with DefaultBuckets.
+type Registry struct {
+	// Buckets are the upper bounds of the histogram buckets, in increasing
+	// order. If nil, the registry uses DefaultBuckets. Buckets must not be
+	// changed once the registry is in use.
+	Buckets []float64
+
+	mu      sync.Mutex
+	metrics map[string]*family
+}
+
+var _ Sink = (*Registry)(nil)
+
+type metricKind int
+
+const (
+	counterKind metricKind = iota
+	gaugeKind
+	histogramKind
+)
+
+type family struct {
+	kind   metricKind
+	series map[string]*series
+}
+
+type series struct {
+	labels []Label
+
+	// value is the value of a counter or gauge, or the sum of the
+	// observations of a histogram.
+	value float64
+
+	// count and buckets are used only for histograms.
+	count   uint64
+	buckets []uint64
+}
+
+var metricHelp = map[string]string{
+	OperationsTotal:          "Number of provider operations.",
+	OperationDurationSeconds: "Duration of provider operations, in seconds.",
+	ProcessStartsTotal:       "Number of provider plugin processes started.",
+	ProcessRestartsTotal:     "Number of provider plugin processes started for a provider that was started before.",
+	LiveProcesses:            "Number of provider plugin processes currently running.",
+	SchemaTypes:              "Number of types in the provider schema.",
+}
+
+func (r *Registry) AddCounter(name string, labels []Label, delta float64) {
+	r.mu.Lock()
+	r.series(name, counterKind, labels).value += delta
+	r.mu.Unlock()
+}
+
+func (r *Registry) AddGauge(name string, labels []Label, delta float64) {
+	r.mu.Lock()
+	r.series(name, gaugeKind, labels).value += delta
+	r.mu.Unlock()
+}
+
+func (r *Registry) SetGauge(name string, labels []Label, value float64) {
+	r.mu.Lock()
+	r.series(name, gaugeKind, labels).value = value
+	r.mu.Unlock()
+}
+
+func (r *Registry) ObserveHistogram(name string, labels []Label, value float64) {
+	r.mu.Lock()
+	s := r.series(name, histogramKind, labels)
+	s.value += value
+	s.count++
+	for i, bound := range r.buckets() {
+		if value <= bound {
+			s.buckets[i]++
+		}
+	}
+	r.mu.Unlock()
+}
+
+func (r *Registry) buckets() []float64 {
+	if r.Buckets == nil {
+		return DefaultBuckets
+	}
+	return r.Buckets
+}
+
+// series returns the series with the given name and labels, creating it if
+// necessary. The caller must hold r.mu.
+func (r *Registry) series(name string, kind metricKind, labels []Label) *series {
+	if r.metrics == nil {
+		r.metrics = make(map[string]*family)
+	}
+	f, ok := r.metrics[name]
+	if !ok {
+		f = &family{
+			kind:   kind,
+			series: make(map[string]*series),
+		}
+		r.metrics[name] = f
+	}
+	key := formatLabels(labels)
+	s, ok := f.series[key]
+	if !ok {
+		s = &series{
+			labels: append([]Label(nil), labels...),
+		}
+		if kind == histogramKind {
+			s.buckets = make([]uint64, len(r.buckets()))
+		}
+		f.series[key] = s
+	}
+	return s
+}
+
+// Handler returns an HTTP handler that responds with the current value of
+// all of the metrics in the registry, in the Prometheus text exposition
+// format.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		bw := bufio.NewWriter(w)
+		r.writeText(bw)
+		bw.Flush()
+	})
+}
+
+func (r *Registry) writeText(w *bufio.Writer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		f := r.metrics[name]
+		if help, ok := metricHelp[name]; ok {
+			fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+		}
+		switch f.kind {
+		case counterKind:
+			fmt.Fprintf(w, "# TYPE %s counter\n", name)
+		case gaugeKind:
+			fmt.Fprintf(w, "# TYPE %s gauge\n", name)
+		case histogramKind:
+			fmt.Fprintf(w, "# TYPE %s histogram\n", name)
+		}
+
+		keys := make([]string, 0, len(f.series))
+		for key := range f.series {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			s := f.series[key]
+			if f.kind != histogramKind {
+				fmt.Fprintf(w, "%s%s %s\n", name, key, formatValue(s.value))
+				continue
+			}
+			// Prometheus histogram buckets are cumulative, and our bucket
+			// counts already include all smaller observations.
+			for i, bound := range r.buckets() {
+				le := Label{Name: "le", Value: formatValue(bound)}
+				fmt.Fprintf(w, "%s_bucket%s %d\n", name, formatLabels(append(s.labels, le)), s.buckets[i])
+			}
+			inf := Label{Name: "le", Value: "+Inf"}
+			fmt.Fprintf(w, "%s_bucket%s %d\n", name, formatLabels(append(s.labels, inf)), s.count)
+			fmt.Fprintf(w, "%s_sum%s %s\n", name, key, formatValue(s.value))
+			fmt.Fprintf(w, "%s_count%s %d\n", name, key, s.count)
+		}
+	}
+}
+
+func formatLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	var buf strings.Builder
+	buf.WriteByte('{')
+	for i, label := range labels {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(label.Name)
+		buf.WriteString(`="`)
+		buf.WriteString(labelValueEscaper.Replace(label.Value))
+		buf.WriteByte('"')
+	}
+	buf.WriteByte('}')
+	return buf.String()
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
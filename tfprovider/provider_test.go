@@ -0,0 +1,161 @@
+package tfprovider
+
+import (
+	"context"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// fakeProvider is a Provider for testing the wrappers and helpers in this
+// package without launching a provider plugin. It embeds the Provider
+// interface so that calling any method it doesn't override panics.
+type fakeProvider struct {
+	Provider
+
+	schema *Schema
+
+	// schemaCalls counts the calls to Schema, which decodes the whole
+	// schema in a real provider.
+	schemaCalls int
+
+	managed   map[string]ManagedResourceType
+	ephemeral map[string]EphemeralResourceType
+
+	// closeErr is the error to return from Close.
+	closeErr error
+
+	// calls records the names of the methods called, in order.
+	calls []string
+}
+
+func (p *fakeProvider) Schema(ctx context.Context) (*Schema, Diagnostics) {
+	p.schemaCalls++
+	return p.schema, nil
+}
+
+func (p *fakeProvider) PrepareConfig(ctx context.Context, config cty.Value) (Config, Diagnostics) {
+	p.calls = append(p.calls, "PrepareConfig")
+	return Config{Value: config}, nil
+}
+
+func (p *fakeProvider) Configure(ctx context.Context, config Config) Diagnostics {
+	p.calls = append(p.calls, "Configure")
+	return nil
+}
+
+func (p *fakeProvider) ValidateManagedResourceConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics {
+	p.calls = append(p.calls, "ValidateManagedResourceConfig")
+	return nil
+}
+
+func (p *fakeProvider) ManagedResourceType(typeName string) ManagedResourceType {
+	if rt, ok := p.managed[typeName]; ok {
+		return rt
+	}
+	return nil
+}
+
+func (p *fakeProvider) EphemeralResourceType(typeName string) EphemeralResourceType {
+	if rt, ok := p.ephemeral[typeName]; ok {
+		return rt
+	}
+	return nil
+}
+
+func (p *fakeProvider) Close() error {
+	p.calls = append(p.calls, "Close")
+	return p.closeErr
+}
+
+// lazyFakeProvider is a fakeProvider that also implements
+// typeSchemaProvider, like the real providers do.
+type lazyFakeProvider struct {
+	fakeProvider
+
+	// typeSchemaCalls counts the calls that look up a single type.
+	typeSchemaCalls int
+
+	// schemaDiags, if set, are returned from every schema lookup instead of
+	// the schema itself, as if the schema couldn't be retrieved.
+	schemaDiags Diagnostics
+}
+
+var _ typeSchemaProvider = (*lazyFakeProvider)(nil)
+
+func (p *lazyFakeProvider) ProviderConfigSchema(ctx context.Context) (*tfschema.Block, Diagnostics) {
+	p.typeSchemaCalls++
+	if p.schemaDiags != nil {
+		return nil, p.schemaDiags
+	}
+	return p.schema.ProviderConfig, nil
+}
+
+func (p *lazyFakeProvider) ManagedResourceTypeSchema(ctx context.Context, typeName string) (*ManagedResourceTypeSchema, Diagnostics) {
+	p.typeSchemaCalls++
+	if p.schemaDiags != nil {
+		return nil, p.schemaDiags
+	}
+	return p.schema.ManagedResourceTypes[typeName], nil
+}
+
+func (p *lazyFakeProvider) DataResourceTypeSchema(ctx context.Context, typeName string) (*DataResourceTypeSchema, Diagnostics) {
+	p.typeSchemaCalls++
+	if p.schemaDiags != nil {
+		return nil, p.schemaDiags
+	}
+	return p.schema.DataResourceTypes[typeName], nil
+}
+
+func (p *lazyFakeProvider) EphemeralResourceTypeSchema(ctx context.Context, typeName string) (*EphemeralResourceTypeSchema, Diagnostics) {
+	p.typeSchemaCalls++
+	if p.schemaDiags != nil {
+		return nil, p.schemaDiags
+	}
+	return p.schema.EphemeralResourceTypes[typeName], nil
+}
+
+func (p *lazyFakeProvider) ListResourceTypeSchema(ctx context.Context, typeName string) (*ListResourceTypeSchema, Diagnostics) {
+	p.typeSchemaCalls++
+	if p.schemaDiags != nil {
+		return nil, p.schemaDiags
+	}
+	return p.schema.ListResourceTypes[typeName], nil
+}
+
+func (p *lazyFakeProvider) ActionTypeSchema(ctx context.Context, typeName string) (*ActionTypeSchema, Diagnostics) {
+	p.typeSchemaCalls++
+	if p.schemaDiags != nil {
+		return nil, p.schemaDiags
+	}
+	return p.schema.ActionTypes[typeName], nil
+}
+
+func testProviderSchema() *Schema {
+	return &Schema{
+		ProviderConfig: &tfschema.Block{
+			Attributes: map[string]*tfschema.Attribute{
+				"token": {Type: cty.String, Optional: true, Sensitive: true},
+			},
+		},
+		ManagedResourceTypes: map[string]*ManagedResourceTypeSchema{
+			"test_thing": {
+				Content: &tfschema.Block{
+					Attributes: map[string]*tfschema.Attribute{
+						"id":   {Type: cty.String, Computed: true},
+						"name": {Type: cty.String, Required: true},
+					},
+				},
+			},
+		},
+		EphemeralResourceTypes: map[string]*EphemeralResourceTypeSchema{
+			"test_secret": {
+				Content: &tfschema.Block{
+					Attributes: map[string]*tfschema.Attribute{
+						"value": {Type: cty.String, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
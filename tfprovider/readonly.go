@@ -0,0 +1,244 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/common"
+)
+
+// ReadOnly returns a wrapper around the given provider that refuses all
+// operations that could change remote objects, returning an error diagnostic
+// instead of calling the provider.
+//
+// The refused operations are ManagedResourceType.Apply,
+// ManagedResourceType.Import and ActionType.Invoke. Import doesn't itself
+// change any remote objects, but its purpose is to bring them under
+// management so that later operations can change them. All other
+// operations, including Plan, still work as normal, because they only read
+// remote objects.
+//
+// This package doesn't support provisioners, so there is no way to run
+// them through any Provider.
+//
+// As new operations are added to this package in future, the wrapper will
+// refuse any that can change remote objects.
+func ReadOnly(provider Provider) Provider {
+	return &readOnlyProvider{provider: provider}
+}
+
+// readOnlyProvider is the implementation of [ReadOnly].
+//
+// It deliberately implements every method of Provider explicitly, rather
+// than embedding the wrapped provider, so that any new methods added to the
+// interface in future must be considered here before the package compiles.
+type readOnlyProvider struct {
+	provider Provider
+}
+
+var _ Provider = (*readOnlyProvider)(nil)
+var _ typeSchemaProvider = (*readOnlyProvider)(nil)
+
+func (p *readOnlyProvider) Schema(ctx context.Context) (*Schema, Diagnostics) {
+	return p.provider.Schema(ctx)
+}
+
+func (p *readOnlyProvider) ProviderConfigSchema(ctx context.Context) (*tfschema.Block, Diagnostics) {
+	return providerConfigSchema(ctx, p.provider)
+}
+
+func (p *readOnlyProvider) ManagedResourceTypeSchema(ctx context.Context, typeName string) (*ManagedResourceTypeSchema, Diagnostics) {
+	return managedResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *readOnlyProvider) DataResourceTypeSchema(ctx context.Context, typeName string) (*DataResourceTypeSchema, Diagnostics) {
+	return dataResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *readOnlyProvider) EphemeralResourceTypeSchema(ctx context.Context, typeName string) (*EphemeralResourceTypeSchema, Diagnostics) {
+	return ephemeralResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *readOnlyProvider) ListResourceTypeSchema(ctx context.Context, typeName string) (*ListResourceTypeSchema, Diagnostics) {
+	return listResourceTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *readOnlyProvider) ActionTypeSchema(ctx context.Context, typeName string) (*ActionTypeSchema, Diagnostics) {
+	return actionTypeSchema(ctx, p.provider, typeName)
+}
+
+func (p *readOnlyProvider) PrepareConfig(ctx context.Context, config cty.Value) (Config, Diagnostics) {
+	return p.provider.PrepareConfig(ctx, config)
+}
+
+func (p *readOnlyProvider) Configure(ctx context.Context, config Config) Diagnostics {
+	return p.provider.Configure(ctx, config)
+}
+
+func (p *readOnlyProvider) ValidateManagedResourceConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics {
+	return p.provider.ValidateManagedResourceConfig(ctx, typeName, config)
+}
+
+func (p *readOnlyProvider) ValidateDataResourceConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics {
+	return p.provider.ValidateDataResourceConfig(ctx, typeName, config)
+}
+
+func (p *readOnlyProvider) ManagedResourceType(typeName string) ManagedResourceType {
+	rt := p.provider.ManagedResourceType(typeName)
+	if rt == nil {
+		return nil
+	}
+	return &readOnlyManagedResourceType{
+		rt:       rt,
+		typeName: typeName,
+	}
+}
+
+func (p *readOnlyProvider) DataResourceType(typeName string) DataResourceType {
+	return p.provider.DataResourceType(typeName)
+}
+
+func (p *readOnlyProvider) EphemeralResourceType(typeName string) EphemeralResourceType {
+	// Ephemeral resources are never saved, so opening one doesn't bring any
+	// remote object under management. They are often how short-lived
+	// credentials for reading other objects are obtained.
+	return p.provider.EphemeralResourceType(typeName)
+}
+
+func (p *readOnlyProvider) ValidateListResourceConfig(ctx context.Context, typeName string, req ListResourceValidateRequest) Diagnostics {
+	return p.provider.ValidateListResourceConfig(ctx, typeName, req)
+}
+
+func (p *readOnlyProvider) ListResourceType(typeName string) ListResourceType {
+	// Listing only finds existing remote objects, without bringing them
+	// under management.
+	return p.provider.ListResourceType(typeName)
+}
+
+func (p *readOnlyProvider) ValidateActionConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics {
+	return p.provider.ValidateActionConfig(ctx, typeName, config)
+}
+
+func (p *readOnlyProvider) ActionType(typeName string) ActionType {
+	at := p.provider.ActionType(typeName)
+	if at == nil {
+		return nil
+	}
+	return &readOnlyActionType{
+		at:       at,
+		typeName: typeName,
+	}
+}
+
+func (p *readOnlyProvider) OnClose(fn func()) (cancel func()) {
+	return onClose(p.provider, fn)
+}
+
+func (p *readOnlyProvider) CallFunction(ctx context.Context, name string, args []cty.Value) (cty.Value, Diagnostics) {
+	// Provider-defined functions can't change remote objects.
+	return p.provider.CallFunction(ctx, name, args)
+}
+
+func (p *readOnlyProvider) Capabilities() Capabilities {
+	return p.provider.Capabilities()
+}
+
+func (p *readOnlyProvider) Supports(feature Feature) bool {
+	if feature == FeatureManagedResourceImport || feature == FeatureActions {
+		// Import and actions are always refused in read-only mode.
+		return false
+	}
+	return p.provider.Supports(feature)
+}
+
+func (p *readOnlyProvider) Stop(ctx context.Context) Diagnostics {
+	return p.provider.Stop(ctx)
+}
+
+func (p *readOnlyProvider) Close() error {
+	return p.provider.Close()
+}
+
+func (p *readOnlyProvider) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+type readOnlyManagedResourceType struct {
+	rt       ManagedResourceType
+	typeName string
+}
+
+var _ ManagedResourceType = (*readOnlyManagedResourceType)(nil)
+
+func (rt *readOnlyManagedResourceType) Read(ctx context.Context, req ManagedResourceReadRequest) (ManagedResourceReadResponse, Diagnostics) {
+	return rt.rt.Read(ctx, req)
+}
+
+func (rt *readOnlyManagedResourceType) Plan(ctx context.Context, req ManagedResourcePlanRequest) (ManagedResourcePlanResponse, Diagnostics) {
+	return rt.rt.Plan(ctx, req)
+}
+
+func (rt *readOnlyManagedResourceType) Apply(ctx context.Context, req ManagedResourceApplyRequest) (ManagedResourceApplyResponse, Diagnostics) {
+	return ManagedResourceApplyResponse{}, readOnlyDiagnostics("apply changes to", rt.typeName)
+}
+
+func (rt *readOnlyManagedResourceType) Import(ctx context.Context, req ManagedResourceImportRequest) (ManagedResourceImportResponse, Diagnostics) {
+	return ManagedResourceImportResponse{}, readOnlyDiagnostics("import", rt.typeName)
+}
+
+func (rt *readOnlyManagedResourceType) MoveState(ctx context.Context, req ManagedResourceMoveStateRequest) (ManagedResourceMoveStateResponse, Diagnostics) {
+	// Moving only converts an object that is already saved in state, and
+	// doesn't change the remote object itself.
+	return rt.rt.MoveState(ctx, req)
+}
+
+func (rt *readOnlyManagedResourceType) UpgradeIdentity(ctx context.Context, req ManagedResourceUpgradeIdentityRequest) (ManagedResourceUpgradeIdentityResponse, Diagnostics) {
+	// Upgrading only converts an identity that is already saved in state.
+	return rt.rt.UpgradeIdentity(ctx, req)
+}
+
+func (rt *readOnlyManagedResourceType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+// readOnlyActionType is a wrapper around another ActionType that refuses
+// to invoke it.
+type readOnlyActionType struct {
+	at       ActionType
+	typeName string
+}
+
+var _ ActionType = (*readOnlyActionType)(nil)
+
+func (at *readOnlyActionType) Plan(ctx context.Context, req ActionPlanRequest) (ActionPlanResponse, Diagnostics) {
+	return at.at.Plan(ctx, req)
+}
+
+func (at *readOnlyActionType) Invoke(ctx context.Context, req ActionInvokeRequest) (ActionInvocation, Diagnostics) {
+	// Actions exist to have side-effects, so we can't assume that any of
+	// them are safe.
+	return nil, Diagnostics{
+		{
+			Severity: Error,
+			Summary:  "Provider is read-only",
+			Detail:   fmt.Sprintf("Cannot invoke action %s, because this provider is in read-only mode.", at.typeName),
+		},
+	}
+}
+
+func (at *readOnlyActionType) Sealed() common.Sealed {
+	return common.Sealed{}
+}
+
+func readOnlyDiagnostics(action, typeName string) Diagnostics {
+	return Diagnostics{
+		{
+			Severity: Error,
+			Summary:  "Provider is read-only",
+			Detail:   fmt.Sprintf("Cannot %s %s objects, because this provider is in read-only mode.", action, typeName),
+		},
+	}
+}
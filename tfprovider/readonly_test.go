@@ -0,0 +1,126 @@
+package tfprovider
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// recordingManagedResourceType is a ManagedResourceType that records the
+// names of the methods called on it.
+type recordingManagedResourceType struct {
+	ManagedResourceType
+	calls []string
+}
+
+func (rt *recordingManagedResourceType) Plan(ctx context.Context, req ManagedResourcePlanRequest) (ManagedResourcePlanResponse, Diagnostics) {
+	rt.calls = append(rt.calls, "Plan")
+	return ManagedResourcePlanResponse{}, nil
+}
+
+func (rt *recordingManagedResourceType) Apply(ctx context.Context, req ManagedResourceApplyRequest) (ManagedResourceApplyResponse, Diagnostics) {
+	rt.calls = append(rt.calls, "Apply")
+	return ManagedResourceApplyResponse{}, nil
+}
+
+func (rt *recordingManagedResourceType) Import(ctx context.Context, req ManagedResourceImportRequest) (ManagedResourceImportResponse, Diagnostics) {
+	rt.calls = append(rt.calls, "Import")
+	return ManagedResourceImportResponse{}, nil
+}
+
+// recordingActionType is an ActionType that records the names of the
+// methods called on it.
+type recordingActionType struct {
+	ActionType
+	calls []string
+}
+
+func (at *recordingActionType) Plan(ctx context.Context, req ActionPlanRequest) (ActionPlanResponse, Diagnostics) {
+	at.calls = append(at.calls, "Plan")
+	return ActionPlanResponse{}, nil
+}
+
+func (at *recordingActionType) Invoke(ctx context.Context, req ActionInvokeRequest) (ActionInvocation, Diagnostics) {
+	at.calls = append(at.calls, "Invoke")
+	return nil, nil
+}
+
+// actionProvider is a fakeProvider that also has action types and
+// supports every feature.
+type actionProvider struct {
+	fakeProvider
+	actions map[string]ActionType
+}
+
+func (p *actionProvider) ActionType(typeName string) ActionType {
+	if at, ok := p.actions[typeName]; ok {
+		return at
+	}
+	return nil
+}
+
+func (p *actionProvider) Supports(feature Feature) bool {
+	return true
+}
+
+func TestReadOnly(t *testing.T) {
+	rt := &recordingManagedResourceType{}
+	at := &recordingActionType{}
+	p := ReadOnly(&actionProvider{
+		fakeProvider: fakeProvider{
+			managed: map[string]ManagedResourceType{"test_thing": rt},
+		},
+		actions: map[string]ActionType{"test_action": at},
+	})
+	ctx := context.Background()
+
+	mrt := p.ManagedResourceType("test_thing")
+	if _, diags := mrt.Plan(ctx, ManagedResourcePlanRequest{}); diags.HasErrors() {
+		t.Errorf("unexpected errors from Plan: %s", diags.Err())
+	}
+	if _, diags := mrt.Apply(ctx, ManagedResourceApplyRequest{}); !diags.HasErrors() {
+		t.Error("Apply succeeded")
+	} else if got, want := diags[0].Detail, "Cannot apply changes to test_thing objects, because this provider is in read-only mode."; got != want {
+		t.Errorf("wrong detail\ngot:  %s\nwant: %s", got, want)
+	}
+	if _, diags := mrt.Import(ctx, ManagedResourceImportRequest{}); !diags.HasErrors() {
+		t.Error("Import succeeded")
+	}
+	if got, want := strings.Join(rt.calls, ","), "Plan"; got != want {
+		t.Errorf("wrong calls to managed resource type %q; want %q", got, want)
+	}
+
+	pat := p.ActionType("test_action")
+	if _, diags := pat.Plan(ctx, ActionPlanRequest{}); diags.HasErrors() {
+		t.Errorf("unexpected errors from Plan: %s", diags.Err())
+	}
+	if _, diags := pat.Invoke(ctx, ActionInvokeRequest{}); !diags.HasErrors() {
+		t.Error("Invoke succeeded")
+	}
+	if got, want := strings.Join(at.calls, ","), "Plan"; got != want {
+		t.Errorf("wrong calls to action type %q; want %q", got, want)
+	}
+
+	if p.ManagedResourceType("test_nonexist") != nil {
+		t.Error("wrapper returned a managed resource type that doesn't exist")
+	}
+	if p.ActionType("test_nonexist") != nil {
+		t.Error("wrapper returned an action type that doesn't exist")
+	}
+}
+
+func TestReadOnlySupports(t *testing.T) {
+	p := ReadOnly(&actionProvider{})
+	tests := map[Feature]bool{
+		FeatureManagedResourceImport:    false,
+		FeatureActions:                  false,
+		FeatureManagedResourceMoveState: true,
+		FeatureEphemeralResources:       true,
+		FeatureListResources:            true,
+	}
+	for feature, want := range tests {
+		if got := p.Supports(feature); got != want {
+			t.Errorf("wrong result for %s: got %t, want %t", feature, got, want)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package tfprovider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeResolver determines which of a set of providers owns a particular
+// resource type or data source, in the same way that Terraform infers the
+// provider for a resource block that has no explicit provider argument.
+//
+// Only the providers' schemas are used for resolution, so the providers
+// don't need to be configured.
+type TypeResolver struct {
+	schemas map[string]*Schema
+}
+
+// NewTypeResolver creates a resolver for the given providers, which are keyed
+// by their source addresses, such as "registry.terraform.io/hashicorp/aws".
+//
+// The resolver retains only the providers' schemas, and so the providers
+// can be closed once NewTypeResolver returns.
+func NewTypeResolver(ctx context.Context, providers map[string]Provider) (*TypeResolver, Diagnostics) {
+	var diags Diagnostics
+	schemas := make(map[string]*Schema, len(providers))
+	for source, provider := range providers {
+		schema, moreDiags := provider.Schema(ctx)
+		diags = append(diags, moreDiags...)
+		if moreDiags.HasErrors() {
+			continue
+		}
+		schemas[source] = schema
+	}
+	return &TypeResolver{schemas: schemas}, diags
+}
+
+// ManagedResourceTypeProvider returns the source address of the provider
+// that owns the given managed resource type.
+func (r *TypeResolver) ManagedResourceTypeProvider(typeName string) (string, Diagnostics) {
+	return r.resolve(typeName, "resource type", (*Schema).HasManagedResourceType)
+}
+
+// DataResourceTypeProvider returns the source address of the provider that
+// owns the given data source.
+func (r *TypeResolver) DataResourceTypeProvider(typeName string) (string, Diagnostics) {
+	return r.resolve(typeName, "data source", (*Schema).HasDataResourceType)
+}
+
+func (r *TypeResolver) resolve(typeName string, noun string, has func(*Schema, string) bool) (string, Diagnostics) {
+	var candidates []string
+	for source, schema := range r.schemas {
+		if has(schema, typeName) {
+			candidates = append(candidates, source)
+		}
+	}
+	sort.Strings(candidates)
+
+	implied := impliedProviderType(typeName)
+	switch len(candidates) {
+	case 0:
+		return "", Diagnostics{
+			{
+				Severity: Error,
+				Summary:  fmt.Sprintf("Unsupported %s", noun),
+				Detail:   fmt.Sprintf("None of the available providers has a %s named %q.", noun, typeName),
+				Cause:    ErrUnknownType,
+			},
+		}
+	case 1:
+		return candidates[0], nil
+	}
+
+	// If more than one provider has a type of the given name then we use
+	// the same rule as Terraform: the provider whose type matches the
+	// type name's prefix wins.
+	var preferred []string
+	for _, source := range candidates {
+		if providerTypeFromSource(source) == implied {
+			preferred = append(preferred, source)
+		}
+	}
+	if len(preferred) == 1 {
+		return preferred[0], nil
+	}
+	return "", Diagnostics{
+		{
+			Severity: Error,
+			Summary:  fmt.Sprintf("Ambiguous %s", noun),
+			Detail: fmt.Sprintf(
+				"The %s %q is available in more than one provider: %s.",
+				noun, typeName, strings.Join(candidates, ", "),
+			),
+		},
+	}
+}
+
+// impliedProviderType returns the provider type implied by the prefix of the
+// given resource type name, such as "aws" for "aws_instance".
+func impliedProviderType(typeName string) string {
+	if i := strings.IndexByte(typeName, '_'); i >= 0 {
+		return typeName[:i]
+	}
+	return typeName
+}
+
+// providerTypeFromSource returns the type portion of a provider source
+// address, which is its final slash-separated segment.
+func providerTypeFromSource(source string) string {
+	if i := strings.LastIndexByte(source, '/'); i >= 0 {
+		return source[i+1:]
+	}
+	return source
+}
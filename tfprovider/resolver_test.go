@@ -0,0 +1,79 @@
+package tfprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestTypeResolver(t *testing.T) {
+	schema := func(types ...string) *Schema {
+		ret := &Schema{
+			ManagedResourceTypes: map[string]*ManagedResourceTypeSchema{},
+			DataResourceTypes:    map[string]*DataResourceTypeSchema{},
+		}
+		for _, typeName := range types {
+			ret.ManagedResourceTypes[typeName] = &ManagedResourceTypeSchema{}
+			ret.DataResourceTypes[typeName] = &DataResourceTypeSchema{}
+		}
+		return ret
+	}
+	r, diags := NewTypeResolver(context.Background(), map[string]Provider{
+		"registry.terraform.io/hashicorp/aws":     &fakeProvider{schema: schema("aws_instance", "aws_vpc", "common_thing")},
+		"registry.terraform.io/example/awsextras": &fakeProvider{schema: schema("aws_vpc", "common_thing")},
+		"registry.terraform.io/example/other":     &fakeProvider{schema: schema("other_thing", "common_thing")},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+
+	tests := map[string]struct {
+		want        string
+		wantSummary string
+	}{
+		"aws_instance": {
+			want: "registry.terraform.io/hashicorp/aws",
+		},
+		"aws_vpc": {
+			// Both providers have the type, but the name prefix matches
+			// only one of them.
+			want: "registry.terraform.io/hashicorp/aws",
+		},
+		"other_thing": {
+			want: "registry.terraform.io/example/other",
+		},
+		"common_thing": {
+			wantSummary: "Ambiguous resource type",
+		},
+		"nonexist_thing": {
+			wantSummary: "Unsupported resource type",
+		},
+	}
+	for typeName, test := range tests {
+		t.Run(typeName, func(t *testing.T) {
+			got, diags := r.ManagedResourceTypeProvider(typeName)
+			if test.wantSummary != "" {
+				if !diags.HasErrors() {
+					t.Fatalf("unexpected success with %q", got)
+				}
+				if diags[0].Summary != test.wantSummary {
+					t.Errorf("wrong summary %q; want %q", diags[0].Summary, test.wantSummary)
+				}
+				return
+			}
+			if diags.HasErrors() {
+				t.Fatalf("unexpected errors: %s", diags.Err())
+			}
+			if got != test.want {
+				t.Errorf("wrong provider %q; want %q", got, test.want)
+			}
+		})
+	}
+
+	if _, diags := r.DataResourceTypeProvider("nonexist_thing"); !errors.Is(diags.Err(), ErrUnknownType) {
+		t.Errorf("wrong error %v; want ErrUnknownType", diags.Err())
+	}
+	if got, diags := r.DataResourceTypeProvider("aws_vpc"); diags.HasErrors() || got != "registry.terraform.io/hashicorp/aws" {
+		t.Errorf("wrong result %q for data source: %v", got, diags.Err())
+	}
+}
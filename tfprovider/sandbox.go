@@ -0,0 +1,45 @@
+package tfprovider
+
+import (
+	"time"
+)
+
+// Sandbox describes restrictions to apply to a provider plugin's child
+// process, for use with [StartOptions].
+//
+// Sandboxing is currently supported only on Linux, where it requires the
+// bubblewrap program "bwrap" and, if any resource limits are set, the
+// "prlimit" program from util-linux. The child process runs in new user,
+// PID, IPC and UTS namespaces, with a read-only view of the host's root
+// filesystem and a private, empty /tmp.
+//
+// The provider's temporary directory is set to a new directory that is
+// shared with the host, because the plugin handshake uses a Unix socket
+// created there. That directory is removed when the provider is closed.
+type Sandbox struct {
+	// CPUTime is the maximum CPU time the provider process may use, rounded
+	// up to a whole number of seconds. Zero means no limit.
+	CPUTime time.Duration
+
+	// MaxMemory is the maximum size of the provider process's virtual
+	// address space, in bytes. Zero means no limit.
+	//
+	// Providers written in Go reserve much more address space than they
+	// use, so this limit must be generous.
+	MaxMemory uint64
+
+	// MaxOpenFiles is the maximum number of file descriptors the provider
+	// process may have open at once. Zero means no limit.
+	MaxOpenFiles uint64
+
+	// DisableNetwork, if set, runs the provider in a new network namespace
+	// with no interfaces other than loopback. Provider operations that call
+	// remote APIs will fail, but schema extraction and validation usually
+	// still work.
+	DisableNetwork bool
+
+	// SeccompFilter, if not empty, is a compiled seccomp BPF program to
+	// apply to the provider process, such as one produced by
+	// seccomp_export_bpf from libseccomp.
+	SeccompFilter []byte
+}
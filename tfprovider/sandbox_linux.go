@@ -0,0 +1,122 @@
+//go:build linux
+// +build linux
+
+package tfprovider
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// command returns a command that runs the given provider executable inside
+// the sandbox, along with a function that releases the resources associated
+// with the sandbox once the child process has exited.
+func (s *Sandbox) command(exe string, args []string) (*exec.Cmd, func(), error) {
+	bwrap, err := exec.LookPath("bwrap")
+	if err != nil {
+		return nil, nil, fmt.Errorf("sandboxed launch requires bubblewrap (bwrap): %s", err)
+	}
+	var limits []string
+	if s.CPUTime > 0 {
+		secs := int64((s.CPUTime + time.Second - 1) / time.Second)
+		limits = append(limits, "--cpu="+strconv.FormatInt(secs, 10))
+	}
+	if s.MaxMemory > 0 {
+		limits = append(limits, "--as="+strconv.FormatUint(s.MaxMemory, 10))
+	}
+	if s.MaxOpenFiles > 0 {
+		limits = append(limits, "--nofile="+strconv.FormatUint(s.MaxOpenFiles, 10))
+	}
+	var prlimit string
+	if len(limits) > 0 {
+		prlimit, err = exec.LookPath("prlimit")
+		if err != nil {
+			return nil, nil, fmt.Errorf("sandboxed launch with resource limits requires prlimit: %s", err)
+		}
+	}
+
+	// The provider executable must be given by absolute path, because
+	// the sandbox might not have the same working directory.
+	exe, err = exec.LookPath(exe)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find provider executable: %s", err)
+	}
+	exe, err = filepath.Abs(exe)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find provider executable: %s", err)
+	}
+
+	dir, err := ioutil.TempDir("", "tfprovider-sandbox")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create sandbox directory: %s", err)
+	}
+	var extraFiles []*os.File
+	cleanup := func() {
+		for _, f := range extraFiles {
+			f.Close()
+		}
+		os.RemoveAll(dir)
+	}
+
+	argv := []string{
+		bwrap,
+		"--ro-bind", "/", "/",
+		"--dev", "/dev",
+		"--proc", "/proc",
+		"--tmpfs", "/tmp",
+		"--bind", dir, dir,
+		"--unshare-user-try",
+		"--unshare-pid",
+		"--unshare-ipc",
+		"--unshare-uts",
+		"--unshare-cgroup-try",
+		"--die-with-parent",
+		"--new-session",
+	}
+	if s.DisableNetwork {
+		argv = append(argv, "--unshare-net")
+	}
+	if len(s.SeccompFilter) > 0 {
+		// bwrap reads the filter from a file descriptor. We use an unlinked
+		// temporary file rather than a pipe so that we needn't write to it
+		// concurrently with the child reading it.
+		f, err := ioutil.TempFile("", "tfprovider-seccomp")
+		if err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("failed to write seccomp filter: %s", err)
+		}
+		os.Remove(f.Name())
+		extraFiles = append(extraFiles, f)
+		if _, err := f.Write(s.SeccompFilter); err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("failed to write seccomp filter: %s", err)
+		}
+		if _, err := f.Seek(0, 0); err != nil {
+			cleanup()
+			return nil, nil, fmt.Errorf("failed to write seccomp filter: %s", err)
+		}
+		// The first of ExtraFiles is always file descriptor 3 in the child.
+		argv = append(argv, "--seccomp", "3")
+	}
+	argv = append(argv, "--", exe)
+	argv = append(argv, args...)
+	if prlimit != "" {
+		argv = append(append(append([]string{prlimit}, limits...), "--"), argv...)
+	}
+
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.ExtraFiles = extraFiles
+	cmd.Env = append(os.Environ(),
+		// The plugin server creates its Unix socket in the temporary
+		// directory, or in PLUGIN_UNIX_SOCKET_DIR for newer versions of
+		// the plugin SDK, so it must be one that we can also see.
+		"TMPDIR="+dir,
+		"PLUGIN_UNIX_SOCKET_DIR="+dir,
+	)
+	return cmd, cleanup, nil
+}
@@ -0,0 +1,118 @@
+//go:build linux
+// +build linux
+
+package tfprovider
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// withFakePath sets PATH to a new directory containing an executable file
+// for each of the given names, returning the directory and a function that
+// restores the original PATH and removes the directory.
+func withFakePath(t *testing.T, names ...string) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "tfprovider-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	oldPath := os.Getenv("PATH")
+	os.Setenv("PATH", dir)
+	return dir, func() {
+		os.Setenv("PATH", oldPath)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestSandboxCommand(t *testing.T) {
+	dir, restore := withFakePath(t, "bwrap", "prlimit", "terraform-provider-test")
+	defer restore()
+	s := &Sandbox{
+		CPUTime:        1500 * time.Millisecond,
+		MaxMemory:      1 << 30,
+		MaxOpenFiles:   256,
+		DisableNetwork: true,
+		SeccompFilter:  []byte{0x20, 0x00},
+	}
+
+	cmd, cleanup, err := s.command("terraform-provider-test", []string{"-debug"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer cleanup()
+
+	args := cmd.Args
+	wantPrefix := []string{
+		filepath.Join(dir, "prlimit"), "--cpu=2", "--as=1073741824", "--nofile=256", "--",
+		filepath.Join(dir, "bwrap"),
+	}
+	if got := args[:len(wantPrefix)]; strings.Join(got, " ") != strings.Join(wantPrefix, " ") {
+		t.Errorf("wrong command prefix\ngot:  %q\nwant: %q", got, wantPrefix)
+	}
+	wantSuffix := []string{"--unshare-net", "--seccomp", "3", "--", filepath.Join(dir, "terraform-provider-test"), "-debug"}
+	if got := args[len(args)-len(wantSuffix):]; strings.Join(got, " ") != strings.Join(wantSuffix, " ") {
+		t.Errorf("wrong command suffix\ngot:  %q\nwant: %q", got, wantSuffix)
+	}
+
+	if len(cmd.ExtraFiles) != 1 {
+		t.Fatalf("wrong number of extra files %d; want 1", len(cmd.ExtraFiles))
+	}
+	filter, err := ioutil.ReadAll(cmd.ExtraFiles[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(filter) != string(s.SeccompFilter) {
+		t.Errorf("wrong seccomp filter %x", filter)
+	}
+
+	// The child's temporary directory is shared with the host, and is
+	// removed by the cleanup function.
+	var tmpDir string
+	for _, env := range cmd.Env {
+		if strings.HasPrefix(env, "TMPDIR=") {
+			tmpDir = strings.TrimPrefix(env, "TMPDIR=")
+		}
+	}
+	if tmpDir == "" {
+		t.Fatal("no TMPDIR in the child's environment")
+	}
+	if !strings.Contains(strings.Join(args, " "), "--bind "+tmpDir+" "+tmpDir) {
+		t.Errorf("temporary directory %s isn't bound into the sandbox", tmpDir)
+	}
+	cleanup()
+	if _, err := os.Stat(tmpDir); !os.IsNotExist(err) {
+		t.Errorf("temporary directory %s not removed", tmpDir)
+	}
+}
+
+func TestSandboxCommandMissingPrograms(t *testing.T) {
+	_, restore := withFakePath(t, "terraform-provider-test")
+	if _, _, err := (&Sandbox{}).command("terraform-provider-test", nil); err == nil || !strings.Contains(err.Error(), "bwrap") {
+		t.Errorf("wrong error %v for missing bwrap", err)
+	}
+
+	restore()
+	_, restore = withFakePath(t, "bwrap", "terraform-provider-test")
+	defer restore()
+	cmd, cleanup, err := (&Sandbox{}).command("terraform-provider-test", nil)
+	if err != nil {
+		t.Fatalf("unexpected error without resource limits: %s", err)
+	}
+	cleanup()
+	if filepath.Base(cmd.Args[0]) != "bwrap" {
+		t.Errorf("wrong program %s without resource limits", cmd.Args[0])
+	}
+	if _, _, err := (&Sandbox{MaxOpenFiles: 10}).command("terraform-provider-test", nil); err == nil || !strings.Contains(err.Error(), "prlimit") {
+		t.Errorf("wrong error %v for missing prlimit", err)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+// +build !linux
+
+package tfprovider
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+func (s *Sandbox) command(exe string, args []string) (*exec.Cmd, func(), error) {
+	return nil, nil, fmt.Errorf("sandboxed launch is supported only on Linux")
+}
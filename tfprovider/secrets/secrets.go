@@ -0,0 +1,355 @@
+// Package secrets resolves references to secrets in provider configuration
+// values, using a hook from package tfprovider.
+//
+// A reference is a string of the form secret://<source>/<path>, where source
+// selects one of the sources registered with the Resolver and path is
+// interpreted by that source. For example, with the sources returned by
+// DefaultSources, secret://env/DB_PASSWORD resolves to the value of the
+// DB_PASSWORD environment variable.
+//
+// References are resolved only in the attributes that the provider's schema
+// marks as sensitive, so that secrets are never sent to the provider in
+// attributes that it might show to users. The resolved values carry the
+// Sensitive mark, and are removed from the text of any diagnostics the
+// provider returns.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+// ReferencePrefix is the prefix of all secret references.
+const ReferencePrefix = "secret://"
+
+// Placeholder is the string that replaces resolved secret values in
+// diagnostics.
+const Placeholder = "(sensitive value)"
+
+// Sensitive is the cty value mark that ResolveValue places on each secret
+// value that it substitutes for a reference, so that code handling the
+// result can tell which values must not be shown or stored. Use
+// cty.Value.HasMark to check for it.
+const Sensitive = sensitiveMark("sensitive")
+
+// sensitiveMark is the type of Sensitive. It is unexported so that no other
+// package can make a mark equal to Sensitive.
+type sensitiveMark string
+
+// MinScrubLength is the length in bytes of the shortest secret that is
+// removed from the text of diagnostics. Shorter secrets are left in place,
+// because replacing every occurrence of a string such as "1" or "on" would
+// mangle unrelated text without hiding anything.
+const MinScrubLength = 4
+
+// Source retrieves secrets from a particular kind of storage.
+type Source interface {
+	// Secret returns the secret with the given path, whose meaning depends
+	// on the source.
+	Secret(ctx context.Context, path string) (string, error)
+}
+
+// SourceFunc is an adapter to allow the use of an ordinary function as a
+// Source.
+type SourceFunc func(ctx context.Context, path string) (string, error)
+
+func (f SourceFunc) Secret(ctx context.Context, path string) (string, error) {
+	return f(ctx, path)
+}
+
+// Resolver resolves secret references using a set of named sources.
+//
+// A Resolver remembers each of the secret values it has resolved, so that it
+// can remove them from diagnostics.
+type Resolver struct {
+	sources map[string]Source
+
+	mu       sync.Mutex
+	resolved map[string]struct{}
+}
+
+// NewResolver returns a resolver that uses the given sources, keyed by the
+// source name used in references.
+func NewResolver(sources map[string]Source) *Resolver {
+	return &Resolver{
+		sources:  sources,
+		resolved: make(map[string]struct{}),
+	}
+}
+
+// Hook returns a hook that resolves the secret references in the
+// configuration given to PrepareConfig and Configure, and removes the
+// resolved values from the diagnostics of all operations.
+//
+// The prepared configuration returned from PrepareConfig has each resolved
+// value replaced by its reference again, as long as the provider returned
+// the value unchanged, so that it can be kept without keeping the secrets.
+// Configure then resolves the references again. If the provider normalized
+// a resolved value then the prepared configuration carries the normalized
+// value, because there is no reference for it.
+//
+// The hook should usually be the last in the chain, so that other hooks see
+// only the references. Hooks that run after this one see the resolved
+// values in the requests for PrepareConfig and Configure, marked with
+// Sensitive. The provider itself receives the values without the marks,
+// because the plugin protocol can't represent them.
+func (r *Resolver) Hook() tfprovider.Hook {
+	return func(ctx context.Context, op *tfprovider.Operation, next func(ctx context.Context)) {
+		switch op.Name {
+		case tfprovider.OpPrepareConfig:
+			val, subs, diags := r.resolveValue(ctx, op.Request.(cty.Value), op.Schema)
+			if diags.HasErrors() {
+				op.Response = tfprovider.Config{}
+				op.Diagnostics = diags
+				return
+			}
+			op.Request = val
+			next(ctx)
+			if config, ok := op.Response.(tfprovider.Config); ok {
+				config.Value = subs.restore(config.Value)
+				op.Response = config
+			}
+			op.Diagnostics = append(diags, op.Diagnostics...)
+		case tfprovider.OpConfigure:
+			// A prepared configuration normally has its references resolved
+			// already, but we'll also resolve any that the caller bypassed
+			// PrepareConfig to include.
+			config := op.Request.(tfprovider.Config)
+			val, diags := r.ResolveValue(ctx, config.Value, op.Schema)
+			if diags.HasErrors() {
+				op.Diagnostics = diags
+				return
+			}
+			config.Value = val
+			op.Request = config
+			next(ctx)
+			op.Diagnostics = append(diags, op.Diagnostics...)
+		default:
+			next(ctx)
+		}
+		op.Diagnostics = r.Scrub(op.Diagnostics)
+	}
+}
+
+// ResolveValue returns a copy of the given object value with each secret
+// reference in its sensitive attributes replaced by the value of the secret,
+// using the given schema to find the sensitive attributes. Each of the
+// secret values is marked with Sensitive.
+//
+// If any reference can't be resolved, ResolveValue returns error diagnostics
+// and the given value unchanged.
+func (r *Resolver) ResolveValue(ctx context.Context, val cty.Value, schema *tfschema.Block) (cty.Value, tfprovider.Diagnostics) {
+	ret, _, diags := r.resolveValue(ctx, val, schema)
+	return ret, diags
+}
+
+// substitution records that a secret reference at a particular path was
+// replaced by the secret's value.
+type substitution struct {
+	path   cty.Path
+	ref    string
+	secret string
+}
+
+type substitutions []substitution
+
+// restore returns a copy of the given value with each of the substituted
+// secret values replaced by its reference again, if the value at the same
+// path is still the secret.
+func (subs substitutions) restore(val cty.Value) cty.Value {
+	if len(subs) == 0 {
+		return val
+	}
+	ret, _ := cty.Transform(val, func(path cty.Path, v cty.Value) (cty.Value, error) {
+		// The value still has the Sensitive mark if it came back through
+		// the hook chain without reaching the provider.
+		uv, _ := v.Unmark()
+		if uv.IsNull() || !uv.IsKnown() || uv.Type() != cty.String {
+			return v, nil
+		}
+		for _, sub := range subs {
+			if path.Equals(sub.path) && uv.AsString() == sub.secret {
+				return cty.StringVal(sub.ref), nil
+			}
+		}
+		return v, nil
+	})
+	return ret
+}
+
+// resolveValue is like ResolveValue, but also returns the substitutions it
+// made.
+func (r *Resolver) resolveValue(ctx context.Context, val cty.Value, schema *tfschema.Block) (cty.Value, substitutions, tfprovider.Diagnostics) {
+	var diags tfprovider.Diagnostics
+	var subs substitutions
+	if schema == nil {
+		return val, nil, diags
+	}
+
+	ret, _ := cty.Transform(val, func(path cty.Path, v cty.Value) (cty.Value, error) {
+		// A marked value is one that has been resolved already, or that the
+		// caller otherwise doesn't want us to inspect.
+		if v.IsMarked() || v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+			return v, nil
+		}
+		ref := v.AsString()
+		if !strings.HasPrefix(ref, ReferencePrefix) || !sensitivePath(schema, path) {
+			return v, nil
+		}
+		secret, err := r.Resolve(ctx, ref)
+		if err != nil {
+			diags = append(diags, tfprovider.Diagnostic{
+				Severity:  tfprovider.Error,
+				Summary:   "Failed to resolve secret",
+				Detail:    fmt.Sprintf("Cannot resolve the secret reference %q: %s.", ref, err),
+				Attribute: path.Copy(),
+			})
+			return v, nil
+		}
+		subs = append(subs, substitution{
+			path:   path.Copy(),
+			ref:    ref,
+			secret: secret,
+		})
+		return cty.StringVal(secret).Mark(Sensitive), nil
+	})
+	if diags.HasErrors() {
+		return val, nil, diags
+	}
+	return ret, subs, diags
+}
+
+// Resolve returns the value of the secret with the given reference.
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	if !strings.HasPrefix(ref, ReferencePrefix) {
+		return "", fmt.Errorf("secret references must start with %q", ReferencePrefix)
+	}
+	rest := ref[len(ReferencePrefix):]
+	slash := strings.IndexByte(rest, '/')
+	if slash < 1 {
+		return "", fmt.Errorf("secret references must have the form %s<source>/<path>", ReferencePrefix)
+	}
+	sourceName, path := rest[:slash], rest[slash+1:]
+
+	source, ok := r.sources[sourceName]
+	if !ok {
+		return "", fmt.Errorf("there is no secret source named %q", sourceName)
+	}
+	secret, err := source.Secret(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	if secret != "" {
+		r.mu.Lock()
+		r.resolved[secret] = struct{}{}
+		r.mu.Unlock()
+	}
+	return secret, nil
+}
+
+// Scrub returns a copy of the given diagnostics with any secret values that
+// the resolver has resolved removed from their summaries and details, and
+// from the messages of their causes. Secrets shorter than MinScrubLength
+// are not removed.
+//
+// A cause whose message contained a secret is replaced by an error with the
+// scrubbed message. The replacement still matches the same errors as the
+// original when used with errors.Is, but errors.As can't reach the original.
+func (r *Resolver) Scrub(diags tfprovider.Diagnostics) tfprovider.Diagnostics {
+	if len(diags) == 0 {
+		return diags
+	}
+	r.mu.Lock()
+	secrets := make([]string, 0, len(r.resolved))
+	for secret := range r.resolved {
+		if len(secret) >= MinScrubLength {
+			secrets = append(secrets, secret)
+		}
+	}
+	r.mu.Unlock()
+	if len(secrets) == 0 {
+		return diags
+	}
+
+	// We replace longer secrets first so that a secret that contains
+	// another secret is still removed entirely.
+	sort.Slice(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+	args := make([]string, 0, len(secrets)*2)
+	for _, secret := range secrets {
+		args = append(args, secret, Placeholder)
+	}
+	replacer := strings.NewReplacer(args...)
+
+	ret := make(tfprovider.Diagnostics, len(diags))
+	for i, diag := range diags {
+		diag.Summary = replacer.Replace(diag.Summary)
+		diag.Detail = replacer.Replace(diag.Detail)
+		if diag.Cause != nil {
+			msg := diag.Cause.Error()
+			if scrubbed := replacer.Replace(msg); scrubbed != msg {
+				diag.Cause = &scrubbedError{msg: scrubbed, err: diag.Cause}
+			}
+		}
+		ret[i] = diag
+	}
+	return ret
+}
+
+// scrubbedError replaces an error whose message contained a secret.
+//
+// It deliberately has no Unwrap method, so that the original error and its
+// message can't be recovered from it.
+type scrubbedError struct {
+	msg string
+	err error
+}
+
+func (e *scrubbedError) Error() string {
+	return e.msg
+}
+
+func (e *scrubbedError) Is(target error) bool {
+	return errors.Is(e.err, target)
+}
+
+// sensitivePath returns true if the given path is within an attribute that
+// the given schema marks as sensitive.
+func sensitivePath(schema *tfschema.Block, path cty.Path) bool {
+	for len(path) > 0 {
+		step, ok := path[0].(cty.GetAttrStep)
+		if !ok {
+			return false
+		}
+		if attrS, ok := schema.Attributes[step.Name]; ok {
+			return attrS.Sensitive
+		}
+		blockS, ok := schema.BlockTypes[step.Name]
+		if !ok {
+			return false
+		}
+		path = path[1:]
+		switch blockS.Nesting {
+		case tfschema.NestingSingle, tfschema.NestingGroup:
+			// The block's attributes follow directly.
+		default:
+			// Skip the index step selecting one of the blocks.
+			if len(path) == 0 {
+				return false
+			}
+			path = path[1:]
+		}
+		schema = &blockS.Block
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+func testResolver() *Resolver {
+	return NewResolver(map[string]Source{
+		"test": SourceFunc(func(ctx context.Context, path string) (string, error) {
+			switch path {
+			case "token":
+				return "hunter2", nil
+			case "flag":
+				return "on", nil
+			default:
+				return "", errors.New("no such secret")
+			}
+		}),
+	})
+}
+
+var testConfigSchema = &tfschema.Block{
+	Attributes: map[string]*tfschema.Attribute{
+		"token":    {Type: cty.String, Optional: true, Sensitive: true},
+		"endpoint": {Type: cty.String, Optional: true},
+	},
+	BlockTypes: map[string]*tfschema.NestedBlock{
+		"assume_role": {
+			Nesting: tfschema.NestingList,
+			Block: tfschema.Block{
+				Attributes: map[string]*tfschema.Attribute{
+					"external_id": {Type: cty.String, Optional: true, Sensitive: true},
+				},
+			},
+		},
+	},
+}
+
+func TestResolveValue(t *testing.T) {
+	r := testResolver()
+	val := cty.ObjectVal(map[string]cty.Value{
+		"token":    cty.StringVal("secret://test/token"),
+		"endpoint": cty.StringVal("secret://test/token"),
+		"assume_role": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"external_id": cty.StringVal("secret://test/token"),
+			}),
+		}),
+	})
+
+	got, diags := r.ResolveValue(context.Background(), val, testConfigSchema)
+	if diags.HasErrors() {
+		t.Fatalf("unexpected errors: %s", diags.Err())
+	}
+	want := cty.ObjectVal(map[string]cty.Value{
+		"token": cty.StringVal("hunter2").Mark(Sensitive),
+		// Not resolved, because the attribute isn't sensitive.
+		"endpoint": cty.StringVal("secret://test/token"),
+		"assume_role": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"external_id": cty.StringVal("hunter2").Mark(Sensitive),
+			}),
+		}),
+	})
+	if !got.RawEquals(want) {
+		t.Errorf("wrong result\ngot:  %#v\nwant: %#v", got, want)
+	}
+}
+
+func TestResolveValueError(t *testing.T) {
+	r := testResolver()
+	val := cty.ObjectVal(map[string]cty.Value{
+		"token":    cty.StringVal("secret://test/missing"),
+		"endpoint": cty.NullVal(cty.String),
+		"assume_role": cty.ListValEmpty(cty.Object(map[string]cty.Type{
+			"external_id": cty.String,
+		})),
+	})
+
+	got, diags := r.ResolveValue(context.Background(), val, testConfigSchema)
+	if !diags.HasErrors() {
+		t.Fatal("unexpected success")
+	}
+	if !got.RawEquals(val) {
+		t.Errorf("value changed despite error: %#v", got)
+	}
+	if got, want := diags[0].Attribute, cty.GetAttrPath("token"); !got.Equals(want) {
+		t.Errorf("wrong attribute path %#v; want %#v", got, want)
+	}
+}
+
+func TestResolverHookPrepareConfig(t *testing.T) {
+	r := testResolver()
+	hook := r.Hook()
+
+	op := &tfprovider.Operation{
+		Name:   tfprovider.OpPrepareConfig,
+		Schema: testConfigSchema,
+		Request: cty.ObjectVal(map[string]cty.Value{
+			"token":    cty.StringVal("secret://test/token"),
+			"endpoint": cty.StringVal("https://example.com"),
+			"assume_role": cty.ListValEmpty(cty.Object(map[string]cty.Type{
+				"external_id": cty.String,
+			})),
+		}),
+	}
+	var sent cty.Value
+	hook(context.Background(), op, func(ctx context.Context) {
+		sent = op.Request.(cty.Value)
+		op.Response = tfprovider.Config{Value: sent}
+		op.Diagnostics = tfprovider.Diagnostics{
+			{
+				Severity: tfprovider.Warning,
+				Summary:  "Token hunter2 is deprecated",
+			},
+		}
+	})
+
+	if got := sent.GetAttr("token"); !got.RawEquals(cty.StringVal("hunter2").Mark(Sensitive)) {
+		t.Errorf("provider got token %#v; want the resolved secret", got)
+	}
+	config := op.Response.(tfprovider.Config)
+	if got := config.Value.GetAttr("token"); !got.RawEquals(cty.StringVal("secret://test/token")) {
+		t.Errorf("prepared config has token %#v; want the reference", got)
+	}
+	if len(op.Diagnostics) != 1 {
+		t.Fatalf("wrong number of diagnostics %d; want 1", len(op.Diagnostics))
+	}
+	if got := op.Diagnostics[0].Summary; strings.Contains(got, "hunter2") {
+		t.Errorf("secret not scrubbed from diagnostic: %s", got)
+	}
+}
+
+func TestResolverHookConfigureError(t *testing.T) {
+	r := testResolver()
+	hook := r.Hook()
+
+	op := &tfprovider.Operation{
+		Name:   tfprovider.OpConfigure,
+		Schema: testConfigSchema,
+		Request: tfprovider.Config{
+			Value: cty.ObjectVal(map[string]cty.Value{
+				"token":    cty.StringVal("secret://nope/token"),
+				"endpoint": cty.NullVal(cty.String),
+				"assume_role": cty.ListValEmpty(cty.Object(map[string]cty.Type{
+					"external_id": cty.String,
+				})),
+			}),
+		},
+	}
+	hook(context.Background(), op, func(ctx context.Context) {
+		t.Error("provider called despite unresolvable reference")
+	})
+	if !op.Diagnostics.HasErrors() {
+		t.Fatal("unexpected success")
+	}
+}
+
+func TestScrub(t *testing.T) {
+	r := testResolver()
+	for _, ref := range []string{"secret://test/token", "secret://test/flag"} {
+		if _, err := r.Resolve(context.Background(), ref); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	cause := fmt.Errorf("bad token hunter2: %w", tfprovider.ErrInvalidResponse)
+	diags := r.Scrub(tfprovider.Diagnostics{
+		{
+			Severity: tfprovider.Error,
+			Summary:  "Invalid token hunter2",
+			Detail:   "Logging is on.",
+			Cause:    cause,
+		},
+	})
+	if got, want := diags[0].Summary, "Invalid token "+Placeholder; got != want {
+		t.Errorf("wrong summary %q; want %q", got, want)
+	}
+	// The secret "on" is too short to scrub.
+	if got, want := diags[0].Detail, "Logging is on."; got != want {
+		t.Errorf("wrong detail %q; want %q", got, want)
+	}
+	if got, want := diags[0].Cause.Error(), "bad token "+Placeholder+": "+tfprovider.ErrInvalidResponse.Error(); got != want {
+		t.Errorf("wrong cause message %q; want %q", got, want)
+	}
+	if !errors.Is(diags.Err(), tfprovider.ErrInvalidResponse) {
+		t.Errorf("scrubbed cause no longer matches ErrInvalidResponse")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	r := testResolver()
+	tests := map[string]string{
+		"secret://test/token":   "",
+		"secret://test/missing": "no such secret",
+		"secret://other/token":  `there is no secret source named "other"`,
+		"secret://token":        "secret references must have the form",
+		"test/token":            "secret references must start with",
+	}
+	for ref, wantErr := range tests {
+		t.Run(ref, func(t *testing.T) {
+			got, err := r.Resolve(context.Background(), ref)
+			if wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if got != "hunter2" {
+					t.Errorf("wrong secret %q", got)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), wantErr) {
+				t.Errorf("wrong error %v; want %q", err, wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultSources returns the built-in sources, which are "env" using
+// EnvSource and "file" using FileSource with the given base directory. If
+// fileDir is empty then there is no "file" source, because a file source
+// without a base directory would allow configuration to read any file that
+// this process can read. The result can be extended with other sources
+// before passing it to NewResolver.
+func DefaultSources(fileDir string) map[string]Source {
+	ret := map[string]Source{
+		"env": EnvSource(),
+	}
+	if fileDir != "" {
+		ret["file"] = FileSource(fileDir)
+	}
+	return ret
+}
+
+// EnvSource returns a source whose paths are the names of environment
+// variables. It fails if the variable isn't set.
+func EnvSource() Source {
+	return SourceFunc(func(ctx context.Context, name string) (string, error) {
+		val, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not set", name)
+		}
+		return val, nil
+	})
+}
+
+// FileSource returns a source whose paths are the names of files containing
+// secrets. A single trailing newline is removed from the file contents.
+//
+// If dir is not empty then paths are relative to that directory, and paths
+// that would refer to files outside of it are rejected. Otherwise, paths are
+// used as given, so that secret://file//run/secrets/token refers to the
+// absolute path /run/secrets/token. An empty dir is therefore appropriate
+// only when the configuration is as trusted as this process.
+func FileSource(dir string) Source {
+	return SourceFunc(func(ctx context.Context, path string) (string, error) {
+		if dir != "" {
+			clean := filepath.Clean(filepath.FromSlash(path))
+			if filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+				return "", fmt.Errorf("path %q is outside of the secrets directory", path)
+			}
+			path = filepath.Join(dir, clean)
+		}
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read secret file: %s", err)
+		}
+		return trimNewline(string(src)), nil
+	})
+}
+
+// CommandSource returns a source whose paths are the names of the given
+// commands, each of which is a program followed by its arguments. The secret
+// is the command's output, with a single trailing newline removed.
+//
+// Commands must be registered in advance rather than given in references
+// so that configuration values cannot run arbitrary programs.
+func CommandSource(commands map[string][]string) Source {
+	return SourceFunc(func(ctx context.Context, name string) (string, error) {
+		argv, ok := commands[name]
+		if !ok || len(argv) == 0 {
+			return "", fmt.Errorf("there is no secret command named %q", name)
+		}
+		cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+		var stdout bytes.Buffer
+		cmd.Stdout = &stdout
+		// We intentionally don't capture stderr in our error message, in
+		// case the command writes part of the secret there.
+		if err := cmd.Run(); err != nil {
+			return "", fmt.Errorf("secret command %q failed: %s", name, err)
+		}
+		return trimNewline(stdout.String()), nil
+	})
+}
+
+func trimNewline(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.TrimSuffix(s, "\r")
+}
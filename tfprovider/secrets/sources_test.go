@@ -0,0 +1,39 @@
+package secrets
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDefaultSourcesFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "token"), []byte("hunter2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewResolver(DefaultSources(dir))
+	got, err := r.Resolve(context.Background(), "secret://file/token")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "hunter2" {
+		t.Errorf("wrong secret %q", got)
+	}
+	for _, ref := range []string{"secret://file/../token", "secret://file//etc/passwd"} {
+		if _, err := r.Resolve(context.Background(), ref); err == nil || !strings.Contains(err.Error(), "outside of the secrets directory") {
+			t.Errorf("wrong error for %s: %v", ref, err)
+		}
+	}
+
+	if _, ok := DefaultSources("")["file"]; ok {
+		t.Error("default sources include a file source without a base directory")
+	}
+}
@@ -0,0 +1,45 @@
+package tfprovider
+
+import (
+	"fmt"
+
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// MarshalState returns the JSON representation of an object of the managed
+// resource type with the given schema, in the format that Terraform uses to
+// save objects in state.
+//
+// Any write-only attributes are set to null first, so that their values are
+// never saved. Callers that save objects should always use MarshalState or
+// StateValue rather than encoding values directly.
+func MarshalState(schema *ManagedResourceTypeSchema, val cty.Value) ([]byte, error) {
+	ty := schema.Content.ImpliedType()
+	raw, err := ctyjson.Marshal(StateValue(schema, val), ty)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object: %s", err)
+	}
+	return raw, nil
+}
+
+// UnmarshalState decodes an object of the managed resource type with the
+// given schema from the JSON representation returned by MarshalState.
+func UnmarshalState(schema *ManagedResourceTypeSchema, raw []byte) (cty.Value, error) {
+	ty := schema.Content.ImpliedType()
+	val, err := ctyjson.Unmarshal(raw, ty)
+	if err != nil {
+		return cty.DynamicVal, fmt.Errorf("invalid state: %s", err)
+	}
+	return schema.WriteOnlyAttributes.Strip(val), nil
+}
+
+// StateValue returns the given object of the managed resource type with the
+// given schema with all of its write-only attributes set to null, ready to
+// be saved.
+//
+// The results of ManagedResourceType.Apply already have their write-only
+// attributes set to null, but other values, such as configuration, may not.
+func StateValue(schema *ManagedResourceTypeSchema, val cty.Value) cty.Value {
+	return schema.WriteOnlyAttributes.Strip(val)
+}
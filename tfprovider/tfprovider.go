@@ -50,6 +50,14 @@ type Provider interface {
 	// for a particular managed resource type.
 	ValidateDataResourceConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics
 
+	// ValidateListResourceConfig runs the provider's validation logic
+	// for a particular list resource type.
+	ValidateListResourceConfig(ctx context.Context, typeName string, req ListResourceValidateRequest) Diagnostics
+
+	// ValidateActionConfig runs the provider's validation logic for a
+	// particular action type.
+	ValidateActionConfig(ctx context.Context, typeName string, config cty.Value) Diagnostics
+
 	// ManagedResourceType returns an object representing the managed resource
 	// type with the given name, or nil if the provider has no such managed
 	// resource type.
@@ -58,6 +66,68 @@ type Provider interface {
 	// method. An unconfigured provider always returns nil.
 	ManagedResourceType(name string) ManagedResourceType
 
+	// DataResourceType returns an object representing the data resource
+	// type with the given name, or nil if the provider has no such data
+	// resource type.
+	//
+	// The provider must be configured using [Configure] before calling this
+	// method. An unconfigured provider always returns nil.
+	DataResourceType(name string) DataResourceType
+
+	// EphemeralResourceType returns an object representing the ephemeral
+	// resource type with the given name, or nil if the provider has no such
+	// ephemeral resource type.
+	//
+	// The provider must be configured using [Configure] before calling this
+	// method. An unconfigured provider always returns nil.
+	//
+	// Use [OpenEphemeralResource] to open an ephemeral resource that is
+	// renewed and closed automatically.
+	EphemeralResourceType(name string) EphemeralResourceType
+
+	// ListResourceType returns an object representing the list resource
+	// type with the given name, or nil if the provider has no such list
+	// resource type or no managed resource type of the same name.
+	//
+	// The provider must be configured using [Configure] before calling this
+	// method. An unconfigured provider always returns nil.
+	ListResourceType(name string) ListResourceType
+
+	// ActionType returns an object representing the action type with the
+	// given name, or nil if the provider has no such action type.
+	//
+	// The provider must be configured using [Configure] before calling this
+	// method. An unconfigured provider always returns nil.
+	ActionType(name string) ActionType
+
+	// CallFunction calls the provider-defined function with the given name,
+	// which must be one of the functions in the provider's schema, and
+	// returns its result.
+	//
+	// Functions don't require the provider to be configured first. If the
+	// function returns an error relating to one of its arguments, the
+	// Attribute of the resulting diagnostic is a path with a single index
+	// step giving the index of that argument.
+	CallFunction(ctx context.Context, name string, args []cty.Value) (cty.Value, Diagnostics)
+
+	// Capabilities returns the plugin protocol version that the provider
+	// uses and the optional protocol behaviors it has opted in to.
+	Capabilities() Capabilities
+
+	// Supports returns true if the provider supports the given feature.
+	//
+	// For features that depend on RPCs that older providers don't implement,
+	// the result is true until a call to such an RPC fails because the
+	// provider doesn't implement it, after which further calls fail
+	// immediately with a diagnostic explaining that the operation isn't
+	// supported by this provider version.
+	Supports(feature Feature) bool
+
+	// Stop asks the provider to gracefully abort any operations that are
+	// currently in progress. It returns once the provider has acknowledged
+	// the request, which may be before the other operations have returned.
+	Stop(ctx context.Context) Diagnostics
+
 	// Close kills the child process for this provider plugin, rendering the
 	// reciever unusable. Any further calls on the object after Close returns
 	// cause undefined behavior.
@@ -91,35 +161,176 @@ type Provider interface {
 // "terraform-provider-", because that is the prefix Terraform itself looks
 // for in order to discover them automatically.
 func Start(ctx context.Context, exe string, args ...string) (Provider, error) {
+	return StartWithOptions(ctx, nil, exe, args...)
+}
+
+// StartOptions represents optional settings for [StartWithOptions].
+//
+// The zero value of StartOptions represents the default settings used by
+// [Start].
+type StartOptions struct {
+	// CallPolicy, if not nil, controls timeouts, retries and concurrency
+	// limits for the calls made to the provider plugin.
+	CallPolicy *CallPolicy
+
+	// Connection, if not nil, sets gRPC message size limits for the calls
+	// made to the provider plugin, and can require the connection that the
+	// plugin chose to use a particular transport or TLS. Start fails if the
+	// connection doesn't meet those requirements, before any configuration
+	// is sent to the provider.
+	Connection *ConnectionPolicy
+
+	// Hooks is a chain of functions that can observe or modify each of the
+	// operations on the provider, regardless of which plugin protocol
+	// version it uses. The first hook in the chain is the outermost, and so
+	// it is the first to see each request and the last to see each
+	// response.
+	Hooks []Hook
+
+	// ReadOnly, if set, makes the returned provider refuse all operations
+	// that could change remote objects, as described for [ReadOnly]. The
+	// refused operations never reach the hooks.
+	ReadOnly bool
+
+	// Sandbox, if not nil, runs the provider plugin's child process with
+	// the restrictions it describes.
+	Sandbox *Sandbox
+}
+
+// StartWithOptions is like [Start] but allows the caller to customize the
+// behavior of the provider client. A nil opts is equivalent to a pointer to
+// a zero-value StartOptions.
+func StartWithOptions(ctx context.Context, opts *StartOptions, exe string, args ...string) (Provider, error) {
+	if opts == nil {
+		opts = &StartOptions{}
+	}
+	provider, err := startWithHooks(ctx, opts, exe, args)
+	if err != nil {
+		return nil, err
+	}
+	if opts.ReadOnly {
+		provider = ReadOnly(provider)
+	}
+	return provider, nil
+}
+
+// startWithHooks launches the provider plugin, passing the launch and all of
+// the later operations on the provider through the hook chain in opts.
+func startWithHooks(ctx context.Context, opts *StartOptions, exe string, args []string) (Provider, error) {
+	if len(opts.Hooks) == 0 {
+		provider, _, err := startPlugin(ctx, opts, exe, args)
+		return provider, err
+	}
+
+	hooks := hookChain(opts.Hooks)
+	op := &Operation{
+		Name: OpStart,
+		Request: StartRequest{
+			Executable: exe,
+			Args:       args,
+		},
+	}
+	hooks.run(ctx, op, func(ctx context.Context, op *Operation) {
+		req := op.Request.(StartRequest)
+		op.Response, op.ProtocolVersion, op.Err = startPlugin(ctx, opts, req.Executable, req.Args)
+	})
+	if op.Err != nil {
+		return nil, op.Err
+	}
+	provider, _ := op.Response.(Provider)
+	if provider == nil {
+		return nil, fmt.Errorf("provider start hook returned no provider")
+	}
+	return &hookedProvider{
+		provider:        provider,
+		protocolVersion: op.ProtocolVersion,
+		hooks:           hooks,
+	}, nil
+}
+
+// startPlugin launches the provider plugin and returns an object representing
+// it along with the major protocol version it selected.
+func startPlugin(ctx context.Context, opts *StartOptions, exe string, args []string) (Provider, int, error) {
+	cmd := exec.Command(exe, args...)
+	cleanup := func() {}
+	if opts.Sandbox != nil {
+		var err error
+		cmd, cleanup, err = opts.Sandbox.command(exe, args)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	// launchErr adds some extra context to errors from the plugin launch
+	// and handshake, because the sandbox can cause them in ways that are
+	// otherwise hard to diagnose.
+	launchErr := func(msg string, err error) error {
+		if opts.Sandbox != nil {
+			return fmt.Errorf("%s: %s; the provider sandbox may be preventing the provider from starting or completing its handshake, so check its resource limits, network access and seccomp filter", msg, err)
+		}
+		return fmt.Errorf("%s: %s", msg, err)
+	}
+
 	plugin, err := rpcplugin.New(ctx, &rpcplugin.ClientConfig{
 		Handshake: rpcplugin.HandshakeConfig{
 			CookieKey:   "TF_PLUGIN_MAGIC_COOKIE",
 			CookieValue: "d602bf8f470bc67ca7faa0386276bbdd4330efaf76d1a219cb4d6991ca9872b2",
 		},
-		Cmd: exec.Command(exe, args...),
+		Cmd: cmd,
 		ProtoVersions: map[int]rpcplugin.ClientVersion{
-			5: protocol5.PluginClient{},
-			6: protocol6.PluginClient{},
+			5: protocol5.PluginClient{Policy: opts.CallPolicy, Connection: opts.Connection},
+			6: protocol6.PluginClient{Policy: opts.CallPolicy, Connection: opts.Connection},
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to launch provider plugin: %s", err)
+		cleanup()
+		return nil, 0, launchErr("failed to launch provider plugin", err)
+	}
+	closer := &pluginCloser{
+		plugin:  plugin,
+		cleanup: cleanup,
 	}
 
 	protoVersion, clientProxy, err := plugin.Client(ctx)
 	if err != nil {
-		plugin.Close()
-		return nil, fmt.Errorf("failed to create plugin client: %s", err)
+		closer.Close()
+		return nil, 0, launchErr("failed to create plugin client", err)
 	}
 
+	// We must be careful not to return a typed nil pointer as a non-nil
+	// Provider when the protocol-specific constructors fail.
+	var provider Provider
 	switch protoVersion {
 	case 5:
-		return protocol5.NewProvider(ctx, plugin, clientProxy)
+		p, err := protocol5.NewProvider(ctx, closer, clientProxy)
+		if err != nil {
+			closer.Close()
+			return nil, 0, err
+		}
+		provider = p
 	case 6:
-		return protocol6.NewProvider(ctx, plugin, clientProxy)
+		p, err := protocol6.NewProvider(ctx, closer, clientProxy)
+		if err != nil {
+			closer.Close()
+			return nil, 0, err
+		}
+		provider = p
 	default:
 		// Should not be possible to get here because the above cases cover
 		// all of the versions we listed in ProtoVersions; rpcplugin bug?
 		panic(fmt.Sprintf("unsupported protocol version %d", protoVersion))
 	}
+	return provider, protoVersion, nil
+}
+
+// pluginCloser closes a plugin and then releases any other resources
+// associated with its child process, such as a sandbox directory.
+type pluginCloser struct {
+	plugin  *rpcplugin.Plugin
+	cleanup func()
+}
+
+func (c *pluginCloser) Close() error {
+	err := c.plugin.Close()
+	c.cleanup()
+	return err
 }
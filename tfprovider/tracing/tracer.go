@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+)
+
+// SpanData is a snapshot of a completed span, as passed to an Exporter.
+type SpanData struct {
+	Name        string
+	SpanContext SpanContext
+
+	// Parent is the span context of the span's parent, or the zero value
+	// if the span has no parent.
+	Parent SpanContext
+
+	StartTime time.Time
+	EndTime   time.Time
+
+	Attributes        map[string]interface{}
+	StatusCode        StatusCode
+	StatusDescription string
+}
+
+// Exporter receives each span created by a tracer returned from NewTracer
+// once it is complete.
+type Exporter interface {
+	ExportSpan(span SpanData)
+}
+
+// NewTracer returns a minimal tracer that sends each completed span to the
+// given exporter. All of the spans it creates are sampled.
+func NewTracer(exporter Exporter) Tracer {
+	return &tracer{exporter: exporter}
+}
+
+type tracer struct {
+	exporter Exporter
+}
+
+type spanContextKey struct{}
+
+func (t *tracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &span{
+		exporter: t.exporter,
+		data: SpanData{
+			Name:       name,
+			StartTime:  time.Now(),
+			Attributes: make(map[string]interface{}),
+		},
+	}
+	if parent, ok := ctx.Value(spanContextKey{}).(SpanContext); ok {
+		s.data.Parent = parent
+		s.data.SpanContext.TraceID = parent.TraceID
+	} else {
+		rand.Read(s.data.SpanContext.TraceID[:])
+	}
+	rand.Read(s.data.SpanContext.SpanID[:])
+	s.data.SpanContext.Sampled = true
+
+	return context.WithValue(ctx, spanContextKey{}, s.data.SpanContext), s
+}
+
+type span struct {
+	mu       sync.Mutex
+	exporter Exporter
+	data     SpanData
+	ended    bool
+}
+
+func (s *span) SpanContext() SpanContext {
+	return s.data.SpanContext
+}
+
+func (s *span) SetAttributes(attrs ...Attribute) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	for _, attr := range attrs {
+		s.data.Attributes[attr.Key] = attr.Value
+	}
+}
+
+func (s *span) SetStatus(code StatusCode, description string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	s.data.StatusCode = code
+	s.data.StatusDescription = description
+}
+
+func (s *span) End() {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = time.Now()
+	data := s.data
+	s.mu.Unlock()
+
+	s.exporter.ExportSpan(data)
+}
+
+// InMemoryExporter is an Exporter that retains all of the spans it receives
+// in memory, which is useful for testing.
+type InMemoryExporter struct {
+	mu    sync.Mutex
+	spans []SpanData
+}
+
+var _ Exporter = (*InMemoryExporter)(nil)
+
+func (e *InMemoryExporter) ExportSpan(span SpanData) {
+	e.mu.Lock()
+	e.spans = append(e.spans, span)
+	e.mu.Unlock()
+}
+
+// Spans returns all of the spans the exporter has received, in the order
+// they were completed.
+func (e *InMemoryExporter) Spans() []SpanData {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ret := make([]SpanData, len(e.spans))
+	copy(ret, e.spans)
+	return ret
+}
+
+// Reset discards all of the spans the exporter has received so far.
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	e.spans = nil
+	e.mu.Unlock()
+}
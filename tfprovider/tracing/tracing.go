@@ -0,0 +1,187 @@
+// Package tracing produces a trace span for each operation on a provider,
+// using a hook from package tfprovider.
+//
+// The interfaces in this package are deliberately small subsets of those
+// in the OpenTelemetry API, and the spans use the OpenTelemetry semantic
+// conventions for RPC calls, so that a thin adapter can send them to any
+// OpenTelemetry-compatible tracing system. The package also includes a
+// minimal tracer implementation with an in-memory exporter, which is
+// sufficient for testing.
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+// Tracer creates spans.
+type Tracer interface {
+	// Start creates a new span with the given name, which is a child of
+	// the span in the given context, if any. It returns a context derived
+	// from the given context that contains the new span.
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span represents a single operation within a trace.
+type Span interface {
+	// SpanContext returns the identifiers of the span, for propagation to
+	// other processes.
+	SpanContext() SpanContext
+
+	// SetAttributes sets attributes on the span, replacing any existing
+	// attributes with the same keys.
+	SetAttributes(attrs ...Attribute)
+
+	// SetStatus sets the outcome of the span.
+	SetStatus(code StatusCode, description string)
+
+	// End marks the span as complete. Calls to other methods after End
+	// have no effect.
+	End()
+}
+
+// SpanContext contains the identifiers of a span that are propagated across
+// process boundaries.
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Sampled bool
+}
+
+// IsValid returns true if the span context has non-zero identifiers.
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != [16]byte{} && sc.SpanID != [8]byte{}
+}
+
+// TraceParent returns the span context in the format of the W3C Trace
+// Context "traceparent" header.
+func (sc SpanContext) TraceParent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]), flags)
+}
+
+// Attribute is a key/value pair describing a span. The value is always a
+// string, an int64 or a bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// StatusCode is the outcome of a span.
+type StatusCode int
+
+const (
+	StatusUnset StatusCode = iota
+	StatusOK
+	StatusError
+)
+
+func (c StatusCode) String() string {
+	switch c {
+	case StatusOK:
+		return "Ok"
+	case StatusError:
+		return "Error"
+	default:
+		return "Unset"
+	}
+}
+
+// The following are the attribute keys used for spans created by Hook.
+const (
+	AttrRPCSystem       = "rpc.system"
+	AttrRPCService      = "rpc.service"
+	AttrRPCMethod       = "rpc.method"
+	AttrOperation       = "tfprovider.operation"
+	AttrTypeName        = "tfprovider.type_name"
+	AttrProtocolVersion = "tfprovider.protocol_version"
+	AttrOutcome         = "tfprovider.outcome"
+	AttrErrorCount      = "tfprovider.diagnostics.errors"
+	AttrWarningCount    = "tfprovider.diagnostics.warnings"
+)
+
+// traceParentKey is the gRPC metadata key used to propagate the span context
+// to the provider plugin, as defined by the W3C Trace Context specification.
+const traceParentKey = "traceparent"
+
+// Hook returns a hook that creates a span for each operation on a provider,
+// using the given tracer.
+//
+// The span context of each operation is also sent to the provider plugin
+// as gRPC metadata, so that providers that support tracing can continue
+// the trace.
+func Hook(tracer Tracer) tfprovider.Hook {
+	return func(ctx context.Context, op *tfprovider.Operation, next func(ctx context.Context)) {
+		ctx, span := tracer.Start(ctx, "tfprovider/"+string(op.Name))
+		defer span.End()
+
+		if sc := span.SpanContext(); sc.IsValid() {
+			ctx = metadata.AppendToOutgoingContext(ctx, traceParentKey, sc.TraceParent())
+		}
+
+		next(ctx)
+
+		// For OpStart, the protocol version isn't known until after the
+		// operation completes, so we set all of the attributes afterwards.
+		attrs := []Attribute{
+			{Key: AttrOperation, Value: string(op.Name)},
+		}
+		if op.TypeName != "" {
+			attrs = append(attrs, Attribute{Key: AttrTypeName, Value: op.TypeName})
+		}
+		if op.ProtocolVersion != 0 {
+			attrs = append(attrs, Attribute{Key: AttrProtocolVersion, Value: int64(op.ProtocolVersion)})
+		}
+		if op.RPC != "" {
+			attrs = append(attrs,
+				Attribute{Key: AttrRPCSystem, Value: "grpc"},
+				Attribute{Key: AttrRPCService, Value: fmt.Sprintf("tfplugin%d.Provider", op.ProtocolVersion)},
+				Attribute{Key: AttrRPCMethod, Value: op.RPC},
+			)
+		}
+
+		var errs, warnings int64
+		for _, diag := range op.Diagnostics {
+			switch diag.Severity {
+			case tfprovider.Error:
+				errs++
+			case tfprovider.Warning:
+				warnings++
+			}
+		}
+		attrs = append(attrs,
+			Attribute{Key: AttrErrorCount, Value: errs},
+			Attribute{Key: AttrWarningCount, Value: warnings},
+		)
+
+		switch {
+		case op.Err != nil:
+			attrs = append(attrs, Attribute{Key: AttrOutcome, Value: "error"})
+			span.SetStatus(StatusError, op.Err.Error())
+		case errs > 0:
+			attrs = append(attrs, Attribute{Key: AttrOutcome, Value: "error"})
+			span.SetStatus(StatusError, op.Diagnostics[firstError(op.Diagnostics)].Summary)
+		default:
+			attrs = append(attrs, Attribute{Key: AttrOutcome, Value: "success"})
+			span.SetStatus(StatusOK, "")
+		}
+		span.SetAttributes(attrs...)
+	}
+}
+
+func firstError(diags tfprovider.Diagnostics) int {
+	for i, diag := range diags {
+		if diag.Severity == tfprovider.Error {
+			return i
+		}
+	}
+	return -1
+}
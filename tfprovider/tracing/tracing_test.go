@@ -0,0 +1,115 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider"
+)
+
+func TestHook(t *testing.T) {
+	exporter := &InMemoryExporter{}
+	tracer := NewTracer(exporter)
+	hook := Hook(tracer)
+
+	ctx, parent := tracer.Start(context.Background(), "plan")
+	op := &tfprovider.Operation{
+		Name:            tfprovider.OpReadManagedResource,
+		TypeName:        "test_thing",
+		RPC:             "ReadResource",
+		ProtocolVersion: 6,
+	}
+	var traceParent []string
+	hook(ctx, op, func(ctx context.Context) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		traceParent = md.Get("traceparent")
+		op.Diagnostics = tfprovider.Diagnostics{
+			{Severity: tfprovider.Warning, Summary: "Deprecated"},
+			{Severity: tfprovider.Error, Summary: "Read failed"},
+		}
+	})
+	parent.End()
+
+	spans := exporter.Spans()
+	if len(spans) != 2 {
+		t.Fatalf("wrong number of spans %d; want 2", len(spans))
+	}
+	span := spans[0]
+	if got, want := span.Name, "tfprovider/ReadManagedResource"; got != want {
+		t.Errorf("wrong name %q; want %q", got, want)
+	}
+	if span.Parent != parent.SpanContext() {
+		t.Error("span isn't a child of the span in the context")
+	}
+	if span.SpanContext.TraceID != parent.SpanContext().TraceID {
+		t.Error("span isn't in the same trace as its parent")
+	}
+	if len(traceParent) != 1 || traceParent[0] != span.SpanContext.TraceParent() {
+		t.Errorf("wrong traceparent metadata %q; want %q", traceParent, span.SpanContext.TraceParent())
+	}
+	if span.StatusCode != StatusError || span.StatusDescription != "Read failed" {
+		t.Errorf("wrong status %s %q", span.StatusCode, span.StatusDescription)
+	}
+
+	wantAttrs := map[string]interface{}{
+		AttrOperation:       "ReadManagedResource",
+		AttrTypeName:        "test_thing",
+		AttrProtocolVersion: int64(6),
+		AttrRPCSystem:       "grpc",
+		AttrRPCService:      "tfplugin6.Provider",
+		AttrRPCMethod:       "ReadResource",
+		AttrErrorCount:      int64(1),
+		AttrWarningCount:    int64(1),
+		AttrOutcome:         "error",
+	}
+	for key, want := range wantAttrs {
+		if got := span.Attributes[key]; got != want {
+			t.Errorf("wrong value for %s: got %#v, want %#v", key, got, want)
+		}
+	}
+	if len(span.Attributes) != len(wantAttrs) {
+		t.Errorf("wrong attributes %#v", span.Attributes)
+	}
+}
+
+func TestHookSuccess(t *testing.T) {
+	exporter := &InMemoryExporter{}
+	hook := Hook(NewTracer(exporter))
+	hook(context.Background(), &tfprovider.Operation{Name: tfprovider.OpClose}, func(ctx context.Context) {})
+
+	spans := exporter.Spans()
+	if len(spans) != 1 {
+		t.Fatalf("wrong number of spans %d; want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Parent.IsValid() {
+		t.Error("root span has a parent")
+	}
+	if span.StatusCode != StatusOK {
+		t.Errorf("wrong status %s", span.StatusCode)
+	}
+	if got := span.Attributes[AttrOutcome]; got != "success" {
+		t.Errorf("wrong outcome %#v", got)
+	}
+	if _, ok := span.Attributes[AttrRPCMethod]; ok {
+		t.Error("span for operation without an RPC has an RPC method")
+	}
+}
+
+func TestSpanContextTraceParent(t *testing.T) {
+	sc := SpanContext{Sampled: true}
+	for i := range sc.TraceID {
+		sc.TraceID[i] = byte(i)
+	}
+	for i := range sc.SpanID {
+		sc.SpanID[i] = byte(0xf0 + i)
+	}
+	if got, want := sc.TraceParent(), "00-000102030405060708090a0b0c0d0e0f-f0f1f2f3f4f5f6f7-01"; got != want {
+		t.Errorf("wrong traceparent %q; want %q", got, want)
+	}
+	if (SpanContext{}).IsValid() {
+		t.Error("zero span context is valid")
+	}
+}
@@ -0,0 +1,103 @@
+package tfprovider
+
+import (
+	"context"
+
+	"github.com/apparentlymart/terraform-schema-go/tfschema"
+
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/protocol5"
+	"github.com/apparentlymart/terraform-provider/tfprovider/internal/protocol6"
+)
+
+// typeSchemaProvider is implemented by providers that can return the schema
+// of a single type without decoding the provider's whole schema, which is
+// the main cost of using a provider that has thousands of types.
+//
+// The functions below use it when the provider implements it, and otherwise
+// fall back on looking up the type in the provider's whole schema. Wrappers
+// around other providers implement it by calling those functions, so that
+// the wrapped provider's lazy decoding survives wrapping.
+type typeSchemaProvider interface {
+	ProviderConfigSchema(ctx context.Context) (*tfschema.Block, Diagnostics)
+	ManagedResourceTypeSchema(ctx context.Context, typeName string) (*ManagedResourceTypeSchema, Diagnostics)
+	DataResourceTypeSchema(ctx context.Context, typeName string) (*DataResourceTypeSchema, Diagnostics)
+	EphemeralResourceTypeSchema(ctx context.Context, typeName string) (*EphemeralResourceTypeSchema, Diagnostics)
+	ListResourceTypeSchema(ctx context.Context, typeName string) (*ListResourceTypeSchema, Diagnostics)
+	ActionTypeSchema(ctx context.Context, typeName string) (*ActionTypeSchema, Diagnostics)
+}
+
+var (
+	_ typeSchemaProvider = (*protocol5.Provider)(nil)
+	_ typeSchemaProvider = (*protocol6.Provider)(nil)
+)
+
+// providerConfigSchema returns the schema of the given provider's
+// configuration.
+func providerConfigSchema(ctx context.Context, provider Provider) (*tfschema.Block, Diagnostics) {
+	if p, ok := provider.(typeSchemaProvider); ok {
+		return p.ProviderConfigSchema(ctx)
+	}
+	schema, diags := provider.Schema(ctx)
+	if schema == nil {
+		return nil, diags
+	}
+	return schema.ProviderConfig, diags
+}
+
+// managedResourceTypeSchema returns the schema of the managed resource type
+// with the given name, or nil if the given provider has no such type. The
+// functions for the other kinds of type follow the same pattern.
+func managedResourceTypeSchema(ctx context.Context, provider Provider, typeName string) (*ManagedResourceTypeSchema, Diagnostics) {
+	if p, ok := provider.(typeSchemaProvider); ok {
+		return p.ManagedResourceTypeSchema(ctx, typeName)
+	}
+	schema, diags := provider.Schema(ctx)
+	if schema == nil {
+		return nil, diags
+	}
+	return schema.ManagedResourceTypes[typeName], diags
+}
+
+func dataResourceTypeSchema(ctx context.Context, provider Provider, typeName string) (*DataResourceTypeSchema, Diagnostics) {
+	if p, ok := provider.(typeSchemaProvider); ok {
+		return p.DataResourceTypeSchema(ctx, typeName)
+	}
+	schema, diags := provider.Schema(ctx)
+	if schema == nil {
+		return nil, diags
+	}
+	return schema.DataResourceTypes[typeName], diags
+}
+
+func ephemeralResourceTypeSchema(ctx context.Context, provider Provider, typeName string) (*EphemeralResourceTypeSchema, Diagnostics) {
+	if p, ok := provider.(typeSchemaProvider); ok {
+		return p.EphemeralResourceTypeSchema(ctx, typeName)
+	}
+	schema, diags := provider.Schema(ctx)
+	if schema == nil {
+		return nil, diags
+	}
+	return schema.EphemeralResourceTypes[typeName], diags
+}
+
+func listResourceTypeSchema(ctx context.Context, provider Provider, typeName string) (*ListResourceTypeSchema, Diagnostics) {
+	if p, ok := provider.(typeSchemaProvider); ok {
+		return p.ListResourceTypeSchema(ctx, typeName)
+	}
+	schema, diags := provider.Schema(ctx)
+	if schema == nil {
+		return nil, diags
+	}
+	return schema.ListResourceTypes[typeName], diags
+}
+
+func actionTypeSchema(ctx context.Context, provider Provider, typeName string) (*ActionTypeSchema, Diagnostics) {
+	if p, ok := provider.(typeSchemaProvider); ok {
+		return p.ActionTypeSchema(ctx, typeName)
+	}
+	schema, diags := provider.Schema(ctx)
+	if schema == nil {
+		return nil, diags
+	}
+	return schema.ActionTypes[typeName], diags
+}